@@ -0,0 +1,238 @@
+// Copyright 2024 The Chromium Authors
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package analyzer
+
+import (
+	"fmt"
+	"sort"
+
+	"infra/monitoring/messages"
+)
+
+// AlertGroup is a set of alerts likely caused by the same change.
+type AlertGroup struct {
+	// Title describes what the alerts have in common.
+	Title string
+	// Alerts are the alerts of the group, sorted by key.
+	Alerts []*messages.Alert
+}
+
+// revisionRange is the regression range of a builder, from the latest passing
+// commit position (exclusive) to the first failing one (inclusive).
+type revisionRange struct {
+	host, repo, branch string
+	start, end         int
+}
+
+func (r revisionRange) overlaps(o revisionRange) bool {
+	return r.host == o.host && r.repo == o.repo && r.branch == o.branch && r.start < o.end && o.start < r.end
+}
+
+// alertFeatures are the properties of an alert used to relate it to others.
+type alertFeatures struct {
+	// culprits maps the keys of the suspected culprits to their descriptions.
+	culprits map[string]string
+	// failures maps the keys of the failing tests, or of the failing step if
+	// there are no failing tests, to their descriptions.
+	failures map[string]string
+	ranges   []revisionRange
+}
+
+// GroupAlerts clusters the build failure alerts likely caused by the same
+// change.
+//
+// Two alerts are related if LUCI Bisection or Findit found a common culprit
+// for them, or if their regression ranges overlap and they have a common
+// failing test, or a common failing step for steps without test results.
+// Groups are formed by the transitive closure of this relation, and alerts
+// without related alerts are left out.
+func GroupAlerts(alerts []*messages.Alert) []*AlertGroup {
+	sorted := append([]*messages.Alert{}, alerts...)
+	sort.Sort(messages.Alerts(sorted))
+
+	features := make([]*alertFeatures, len(sorted))
+	parent := make([]int, len(sorted))
+	for i, a := range sorted {
+		features[i] = getAlertFeatures(a)
+		parent[i] = i
+	}
+	var find func(int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+	union := func(i, j int) {
+		i, j = find(i), find(j)
+		// Keep the smallest index as the root, so that groups are ordered by
+		// their first alert.
+		if i < j {
+			parent[j] = i
+		} else {
+			parent[i] = j
+		}
+	}
+
+	byCulprit := map[string]int{}
+	byFailure := map[string][]int{}
+	for i, f := range features {
+		if f == nil {
+			continue
+		}
+		for c := range f.culprits {
+			if j, ok := byCulprit[c]; ok {
+				union(i, j)
+			} else {
+				byCulprit[c] = i
+			}
+		}
+		for k := range f.failures {
+			for _, j := range byFailure[k] {
+				if rangesOverlap(f.ranges, features[j].ranges) {
+					union(i, j)
+				}
+			}
+			byFailure[k] = append(byFailure[k], i)
+		}
+	}
+
+	members := map[int][]int{}
+	var roots []int
+	for i := range sorted {
+		r := find(i)
+		if _, ok := members[r]; !ok {
+			roots = append(roots, r)
+		}
+		members[r] = append(members[r], i)
+	}
+	var groups []*AlertGroup
+	for _, r := range roots {
+		if len(members[r]) < 2 {
+			continue
+		}
+		g := &AlertGroup{}
+		for _, i := range members[r] {
+			g.Alerts = append(g.Alerts, sorted[i])
+		}
+		g.Title = groupTitle(features, members[r])
+		groups = append(groups, g)
+	}
+	return groups
+}
+
+// getAlertFeatures returns the features of a build failure alert, or nil for
+// other alerts.
+func getAlertFeatures(a *messages.Alert) *alertFeatures {
+	var bf *messages.BuildFailure
+	switch ext := a.Extension.(type) {
+	case *messages.BuildFailure:
+		bf = ext
+	case messages.BuildFailure:
+		bf = &ext
+	default:
+		return nil
+	}
+
+	f := &alertFeatures{
+		culprits: map[string]string{},
+		failures: map[string]string{},
+	}
+	for _, b := range bf.Builders {
+		if b.LatestPassingRev == nil || b.FirstFailingRev == nil || b.LatestPassingRev.Position == 0 || b.FirstFailingRev.Position == 0 {
+			continue
+		}
+		f.ranges = append(f.ranges, revisionRange{
+			host:   b.FirstFailingRev.Host,
+			repo:   b.FirstFailingRev.Repo,
+			branch: b.FirstFailingRev.Branch,
+			start:  b.LatestPassingRev.Position,
+			end:    b.FirstFailingRev.Position,
+		})
+	}
+
+	for _, c := range bf.Culprits {
+		if c.Commit != nil && c.Commit.ID != "" {
+			f.culprits[commitKey(c.Commit.Host, c.Commit.Project, c.Commit.ID)] = c.Commit.ID
+		}
+	}
+	if bf.LuciBisectionResult != nil {
+		for _, c := range bf.LuciBisectionResult.Analysis.GetCulprits() {
+			if commit := c.GetCommit(); commit.GetId() != "" {
+				f.culprits[commitKey(commit.Host, commit.Project, commit.Id)] = culpritDescription(c.GetReviewTitle(), commit.Id)
+			}
+		}
+	}
+
+	if bf.Reason != nil {
+		if raw, ok := bf.Reason.Raw.(*BqFailure); ok {
+			for _, t := range raw.Tests {
+				if t.TestID != "" {
+					f.failures["test:"+t.TestID] = fmt.Sprintf("Test %q failing", t.TestName)
+				}
+				if t.LUCIBisectionResult == nil {
+					continue
+				}
+				c := t.LUCIBisectionResult.Culprit
+				if commit := c.GetCommit(); commit.GetId() != "" {
+					f.culprits[commitKey(commit.Host, commit.Project, commit.Id)] = culpritDescription(c.GetReviewTitle(), commit.Id)
+				}
+			}
+		}
+	}
+	if len(f.failures) == 0 && bf.StepAtFault != nil && bf.StepAtFault.Step != nil {
+		name := bf.StepAtFault.Step.Name
+		f.failures["step:"+name] = fmt.Sprintf("Step %q failing", name)
+	}
+	return f
+}
+
+func commitKey(host, project, id string) string {
+	return fmt.Sprintf("%s/%s/+/%s", host, project, id)
+}
+
+func culpritDescription(reviewTitle, id string) string {
+	if reviewTitle != "" {
+		return reviewTitle
+	}
+	return id
+}
+
+func rangesOverlap(a, b []revisionRange) bool {
+	for _, ra := range a {
+		for _, rb := range b {
+			if ra.overlaps(rb) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// groupTitle describes the most common culprit of the alerts of a group, or
+// their most common failure if they have no culprit.
+func groupTitle(features []*alertFeatures, members []int) string {
+	mostCommon := func(get func(*alertFeatures) map[string]string) string {
+		counts := map[string]int{}
+		descriptions := map[string]string{}
+		for _, i := range members {
+			for k, d := range get(features[i]) {
+				counts[k]++
+				descriptions[k] = d
+			}
+		}
+		best := ""
+		for k, n := range counts {
+			if best == "" || n > counts[best] || (n == counts[best] && k < best) {
+				best = k
+			}
+		}
+		return descriptions[best]
+	}
+	if c := mostCommon(func(f *alertFeatures) map[string]string { return f.culprits }); c != "" {
+		return fmt.Sprintf("Suspected culprit: %s", c)
+	}
+	return mostCommon(func(f *alertFeatures) map[string]string { return f.failures })
+}
@@ -0,0 +1,141 @@
+// Copyright 2024 The Chromium Authors
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package analyzer
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	bisectionpb "go.chromium.org/luci/bisection/proto/v1"
+	buildbucketpb "go.chromium.org/luci/buildbucket/proto"
+
+	"infra/appengine/sheriff-o-matic/som/analyzer/step"
+	"infra/monitoring/messages"
+)
+
+func TestGroupAlerts(t *testing.T) {
+	Convey("GroupAlerts", t, func() {
+		alert := func(key, stepName string, start, end int, tests ...step.TestWithResult) *messages.Alert {
+			kind := "basic"
+			if len(tests) > 0 {
+				kind = "test"
+			}
+			return &messages.Alert{
+				Key: key,
+				Extension: &messages.BuildFailure{
+					Builders: []*messages.AlertedBuilder{
+						{
+							Name:             key,
+							LatestPassingRev: &messages.RevisionSummary{Position: start, Branch: "refs/heads/main", Host: "chromium.googlesource.com", Repo: "chromium/src"},
+							FirstFailingRev:  &messages.RevisionSummary{Position: end, Branch: "refs/heads/main", Host: "chromium.googlesource.com", Repo: "chromium/src"},
+						},
+					},
+					StepAtFault: &messages.BuildStep{
+						Step: &messages.Step{Name: stepName},
+					},
+					Reason: &messages.Reason{
+						Raw: (&BqFailure{Name: stepName, Tests: tests}).WithKind(kind),
+					},
+				},
+			}
+		}
+		test := func(id string) step.TestWithResult {
+			return step.TestWithResult{TestID: id, TestName: id}
+		}
+		keys := func(groups []*AlertGroup) [][]string {
+			ret := [][]string{}
+			for _, g := range groups {
+				k := []string{}
+				for _, a := range g.Alerts {
+					k = append(k, a.Key)
+				}
+				ret = append(ret, k)
+			}
+			return ret
+		}
+
+		Convey("no related alerts", func() {
+			groups := GroupAlerts([]*messages.Alert{
+				alert("a", "compile", 100, 110),
+				alert("b", "unit_tests", 100, 110, test("test1")),
+				alert("c", "browser_tests", 100, 110, test("test2")),
+			})
+			So(groups, ShouldBeEmpty)
+		})
+
+		Convey("same step with overlapping ranges", func() {
+			groups := GroupAlerts([]*messages.Alert{
+				alert("c", "compile", 105, 120),
+				alert("a", "compile", 100, 110),
+				alert("b", "compile", 110, 115),
+				alert("d", "compile", 120, 130),
+			})
+			So(keys(groups), ShouldResemble, [][]string{{"a", "b", "c"}})
+			So(groups[0].Title, ShouldEqual, `Step "compile" failing`)
+		})
+
+		Convey("shared failing tests", func() {
+			groups := GroupAlerts([]*messages.Alert{
+				alert("a", "unit_tests", 100, 110, test("test1"), test("test2")),
+				alert("b", "unit_tests", 105, 115, test("test2")),
+				alert("c", "unit_tests", 105, 115, test("test3")),
+				alert("d", "unit_tests", 200, 210, test("test2")),
+			})
+			So(keys(groups), ShouldResemble, [][]string{{"a", "b"}})
+			So(groups[0].Title, ShouldEqual, `Test "test2" failing`)
+		})
+
+		Convey("ranges on different branches", func() {
+			b := alert("b", "compile", 100, 110)
+			b.Extension.(*messages.BuildFailure).Builders[0].FirstFailingRev.Branch = "refs/branch-heads/1234"
+			groups := GroupAlerts([]*messages.Alert{alert("a", "compile", 100, 110), b})
+			So(groups, ShouldBeEmpty)
+		})
+
+		Convey("common culprit", func() {
+			commit := &buildbucketpb.GitilesCommit{
+				Host:    "chromium.googlesource.com",
+				Project: "chromium/src",
+				Id:      "deadbeef",
+			}
+			t := test("test1")
+			t.LUCIBisectionResult = &step.LUCIBisectionTestAnalysis{
+				Culprit: &bisectionpb.TestCulprit{Commit: commit, ReviewTitle: "Break things"},
+			}
+			compile := alert("a", "compile", 100, 110)
+			compile.Extension.(*messages.BuildFailure).LuciBisectionResult = &messages.LuciBisectionResult{
+				Analysis: &bisectionpb.Analysis{
+					Culprits: []*bisectionpb.Culprit{{Commit: commit}},
+				},
+			}
+			groups := GroupAlerts([]*messages.Alert{
+				compile,
+				alert("b", "unit_tests", 300, 310, t),
+				alert("c", "unit_tests", 300, 310, test("test2")),
+			})
+			So(keys(groups), ShouldResemble, [][]string{{"a", "b"}})
+			So(groups[0].Title, ShouldEqual, "Suspected culprit: Break things")
+		})
+
+		Convey("transitive", func() {
+			groups := GroupAlerts([]*messages.Alert{
+				alert("a", "unit_tests", 100, 110, test("test1")),
+				alert("b", "unit_tests", 105, 115, test("test1"), test("test2")),
+				alert("c", "unit_tests", 112, 120, test("test2")),
+				alert("d", "compile", 100, 110),
+				alert("e", "compile", 100, 110),
+			})
+			So(keys(groups), ShouldResemble, [][]string{{"a", "b", "c"}, {"d", "e"}})
+		})
+
+		Convey("alerts without regression ranges", func() {
+			a := alert("a", "compile", 100, 110)
+			a.Extension.(*messages.BuildFailure).Builders[0].LatestPassingRev = nil
+			groups := GroupAlerts([]*messages.Alert{a, alert("b", "compile", 100, 110)})
+			So(groups, ShouldBeEmpty)
+		})
+	})
+}
@@ -0,0 +1,107 @@
+// Copyright 2024 The Chromium Authors
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package handler
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/gae/service/datastore"
+
+	"infra/appengine/sheriff-o-matic/som/analyzer"
+	"infra/appengine/sheriff-o-matic/som/model"
+	"infra/monitoring/messages"
+)
+
+// updateAlertGroups groups the alerts of a tree likely caused by the same
+// change, as clustered by analyzer.GroupAlerts, using group annotations like
+// the ones sheriffs create in the UI.
+//
+// Ungrouped alerts join the group most of their related alerts are in, so
+// groups are kept as new builds land, and a new group is created for related
+// alerts not in any group. Alerts already in a group or removed from a group
+// by a sheriff are left alone.
+func updateAlertGroups(c context.Context, tree string, alerts []*messages.Alert) error {
+	treeKey := datastore.MakeKey(c, "Tree", tree)
+	now := clock.Now(c).UTC()
+	var changed []*model.Annotation
+	for _, g := range analyzer.GroupAlerts(alerts) {
+		annotations := make([]*model.Annotation, len(g.Alerts))
+		for i, a := range g.Alerts {
+			annotations[i] = &model.Annotation{
+				Tree:      treeKey,
+				KeyDigest: model.GenerateKeyDigest(a.Key),
+				Key:       a.Key,
+			}
+		}
+		if err := datastoreGetAnnotations(c, annotations); err != nil {
+			return errors.Annotate(err, "getting annotations of group %q", g.Title).Err()
+		}
+
+		var ungrouped []*model.Annotation
+		for _, a := range annotations {
+			if a.GroupID == "" && !a.Ungrouped {
+				ungrouped = append(ungrouped, a)
+			}
+		}
+		groupID := mostCommonGroupID(annotations)
+		if len(ungrouped) == 0 || (groupID == "" && len(ungrouped) < 2) {
+			continue
+		}
+		if groupID == "" {
+			groupID = uuid.New().String()
+			logging.Infof(c, "Creating group %q for %d alerts", g.Title, len(ungrouped))
+		}
+
+		// The group annotation holds the name of the group. Touch it even if it
+		// exists, so that it does not expire before the alerts joining it.
+		group := &model.Annotation{
+			Tree:      treeKey,
+			KeyDigest: model.GenerateKeyDigest(groupID),
+			Key:       groupID,
+		}
+		if err := datastoreGetAnnotations(c, []*model.Annotation{group}); err != nil {
+			return errors.Annotate(err, "getting group %q", groupID).Err()
+		}
+		if group.GroupID == "" {
+			group.GroupID = g.Title
+		}
+		group.ModificationTime = now
+		changed = append(changed, group)
+		for _, a := range ungrouped {
+			a.GroupID = groupID
+			a.ModificationTime = now
+			changed = append(changed, a)
+		}
+	}
+	if len(changed) == 0 {
+		return nil
+	}
+	if err := datastorePutAnnotations(c, changed); err != nil {
+		return errors.Annotate(err, "storing group annotations").Err()
+	}
+	return nil
+}
+
+// mostCommonGroupID returns the group most of the annotations are in, or an
+// empty string if none is in a group.
+func mostCommonGroupID(annotations []*model.Annotation) string {
+	counts := map[string]int{}
+	best := ""
+	for _, a := range annotations {
+		if a.GroupID == "" {
+			continue
+		}
+		counts[a.GroupID]++
+		if n := counts[a.GroupID]; best == "" || n > counts[best] || (n == counts[best] && a.GroupID < best) {
+			best = a.GroupID
+		}
+	}
+	return best
+}
@@ -0,0 +1,112 @@
+// Copyright 2024 The Chromium Authors
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package handler
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"go.chromium.org/luci/gae/service/datastore"
+
+	"infra/appengine/sheriff-o-matic/som/model"
+	"infra/monitoring/messages"
+)
+
+func TestUpdateAlertGroups(t *testing.T) {
+	Convey("updateAlertGroups", t, func() {
+		c := newTestContext()
+		treeKey := datastore.MakeKey(c, "Tree", "chromium")
+		alert := func(key string) *messages.Alert {
+			return &messages.Alert{
+				Key: key,
+				Extension: &messages.BuildFailure{
+					Builders: []*messages.AlertedBuilder{
+						{
+							Name:             key,
+							LatestPassingRev: &messages.RevisionSummary{Position: 100, Branch: "refs/heads/main"},
+							FirstFailingRev:  &messages.RevisionSummary{Position: 110, Branch: "refs/heads/main"},
+						},
+					},
+					StepAtFault: &messages.BuildStep{
+						Step: &messages.Step{Name: "compile"},
+					},
+				},
+			}
+		}
+		getAnnotation := func(key string) *model.Annotation {
+			ann := &model.Annotation{
+				Tree:      treeKey,
+				KeyDigest: model.GenerateKeyDigest(key),
+				Key:       key,
+			}
+			So(datastoreGetAnnotation(c, ann), ShouldBeNil)
+			return ann
+		}
+		alerts := []*messages.Alert{alert("a"), alert("b"), alert("c")}
+
+		Convey("creates a group", func() {
+			So(updateAlertGroups(c, "chromium", alerts), ShouldBeNil)
+
+			groupID := getAnnotation("a").GroupID
+			So(groupID, ShouldNotEqual, "")
+			So(getAnnotation("b").GroupID, ShouldEqual, groupID)
+			So(getAnnotation("c").GroupID, ShouldEqual, groupID)
+			group := getAnnotation(groupID)
+			So(group.IsGroupAnnotation(), ShouldBeTrue)
+			So(group.GroupID, ShouldEqual, `Step "compile" failing`)
+
+			Convey("and keeps it", func() {
+				alerts = append(alerts, alert("d"))
+				So(updateAlertGroups(c, "chromium", alerts), ShouldBeNil)
+
+				So(getAnnotation("d").GroupID, ShouldEqual, groupID)
+				So(getAnnotation(groupID).GroupID, ShouldEqual, `Step "compile" failing`)
+			})
+		})
+
+		Convey("joins an existing group", func() {
+			So(datastorePutAnnotations(c, []*model.Annotation{
+				{
+					Tree:      treeKey,
+					KeyDigest: model.GenerateKeyDigest("group"),
+					Key:       "group",
+					GroupID:   "Compile broken",
+				},
+				{
+					Tree:      treeKey,
+					KeyDigest: model.GenerateKeyDigest("a"),
+					Key:       "a",
+					GroupID:   "group",
+				},
+			}), ShouldBeNil)
+
+			So(updateAlertGroups(c, "chromium", alerts), ShouldBeNil)
+
+			So(getAnnotation("b").GroupID, ShouldEqual, "group")
+			So(getAnnotation("c").GroupID, ShouldEqual, "group")
+			So(getAnnotation("group").GroupID, ShouldEqual, "Compile broken")
+		})
+
+		Convey("leaves alerts removed from a group alone", func() {
+			So(datastorePutAnnotation(c, &model.Annotation{
+				Tree:      treeKey,
+				KeyDigest: model.GenerateKeyDigest("a"),
+				Key:       "a",
+				Ungrouped: true,
+			}), ShouldBeNil)
+
+			So(updateAlertGroups(c, "chromium", alerts[:2]), ShouldBeNil)
+
+			So(getAnnotation("a").GroupID, ShouldEqual, "")
+			ann := &model.Annotation{
+				Tree:      treeKey,
+				KeyDigest: model.GenerateKeyDigest("b"),
+				Key:       "b",
+			}
+			So(datastoreGetAnnotation(c, ann), ShouldEqual, datastore.ErrNoSuchEntity)
+		})
+	})
+}
@@ -195,6 +195,11 @@ func generateBigQueryAlerts(c context.Context, a *analyzer.Analyzer, tree string
 		return nil, err
 	}
 
+	if err := updateAlertGroups(c, tree, alertsSummary.Alerts); err != nil {
+		// It is not critical, so log and continue
+		logging.Errorf(c, "error grouping alerts: %v", err)
+	}
+
 	return alertsSummary, nil
 }
 
@@ -95,6 +95,27 @@ func datastoreGetAnnotation(c context.Context, annotation *model.Annotation) err
 	return nil
 }
 
+// datastoreGetAnnotations gets multiple annotations, leaving the ones not in
+// the datastore unchanged.
+func datastoreGetAnnotations(c context.Context, annotations []*model.Annotation) error {
+	annotationsNonGrouping := convertAnnotationsToAnnotationsNonGrouping(annotations)
+	if err := datastore.Get(c, annotationsNonGrouping); err != nil {
+		me, ok := err.(errors.MultiError)
+		if !ok {
+			return err
+		}
+		for _, e := range me {
+			if e != nil && e != datastore.ErrNoSuchEntity {
+				return err
+			}
+		}
+	}
+	for i, annotationNonGrouping := range annotationsNonGrouping {
+		*annotations[i] = model.Annotation(*annotationNonGrouping)
+	}
+	return nil
+}
+
 func datastorePutAnnotation(c context.Context, annotation *model.Annotation) error {
 	annotations := []*model.Annotation{annotation}
 	return datastorePutAnnotations(c, annotations)
@@ -108,6 +108,9 @@ type Annotation struct {
 	SnoozeTime       int            `json:"snoozeTime"`
 	GroupID          string         `gae:",noindex" json:"group_id"`
 	ModificationTime time.Time
+	// Ungrouped is set when a sheriff removes the alert from its group, so
+	// that it is not grouped automatically again.
+	Ungrouped bool `gae:",noindex" json:"-"`
 }
 
 // AnnotationNonGrouping is any information sheriffs want to annotate an alert with. For
@@ -121,6 +124,9 @@ type AnnotationNonGrouping struct {
 	SnoozeTime       int            `json:"snoozeTime"`
 	GroupID          string         `gae:",noindex" json:"group_id"`
 	ModificationTime time.Time
+	// Ungrouped is set when a sheriff removes the alert from its group, so
+	// that it is not grouped automatically again.
+	Ungrouped bool `gae:",noindex" json:"-"`
 }
 
 // MonorailBug stores data to differentiate bugs by projects.
@@ -305,6 +311,7 @@ func (a *Annotation) Remove(c context.Context, r io.Reader) (bool, error) {
 
 	if change.GroupID {
 		a.GroupID = ""
+		a.Ungrouped = true
 		modified = true
 	}
 
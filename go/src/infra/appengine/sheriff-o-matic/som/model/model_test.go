@@ -173,6 +173,17 @@ func TestAnnotation(t *testing.T) {
 					})
 				})
 
+				Convey("group", func() {
+					ann.GroupID = "group"
+					needRefresh, err := ann.Remove(c, strings.NewReader(`{"group_id":true}`))
+
+					So(err, ShouldBeNil)
+					So(needRefresh, ShouldBeFalse)
+					So(ann.GroupID, ShouldEqual, "")
+					So(ann.Ungrouped, ShouldBeTrue)
+					So(ann.ModificationTime, ShouldResemble, cl.Now())
+				})
+
 				Convey("comments", func() {
 					Convey("basic", func() {
 						changeString := `{"comments":[1]}`
@@ -116,6 +116,11 @@ type Options struct {
 
 	// Limits specifies resource limits.
 	Limits Limits
+
+	// StepDurations is historical durations of steps, keyed by
+	// the first output of the step (i.e. StepMetric.Output).
+	// If it is not nil, ready steps are scheduled by critical path.
+	StepDurations map[string]time.Duration
 }
 
 var experiments Experiments
@@ -143,6 +148,9 @@ type Builder struct {
 	plan  *plan
 	stats *stats
 
+	// status keeps recent steps for Status.
+	status buildStatus
+
 	// record phony targets (clean->false, dirty->true).
 	phony sync.Map
 
@@ -169,7 +177,12 @@ type Builder struct {
 	reCacheEnableRead bool
 	// TODO(b/266518906): enable reCacheEnableWrite option for read-only client.
 	// reCacheEnableWrite bool
-	reapiclient *reapi.Client
+
+	// reCacheWriteLocal is true when the reapi server provides
+	// cache only. Pure steps run locally and upload their results
+	// to the cache.
+	reCacheWriteLocal bool
+	reapiclient       *reapi.Client
 
 	reproxySema *semaphore.Semaphore
 	reproxyExec *reproxyexec.REProxyExec
@@ -205,6 +218,8 @@ type Builder struct {
 	keepRSP bool
 
 	rebuildManifest string
+
+	stepDurations map[string]time.Duration
 }
 
 // New creates new builder.
@@ -243,10 +258,19 @@ func New(ctx context.Context, graph Graph, opts Options) (*Builder, error) {
 	var le localexec.LocalExec
 	var re *remoteexec.RemoteExec
 	var pe *reproxyexec.REProxyExec
-	if opts.REAPIClient != nil {
+	var reCacheWriteLocal bool
+	switch {
+	case opts.REAPIClient != nil && opts.REAPIClient.CacheOnly():
+		// e.g. `siso cache serve`.
+		logger.Warningf("disable remote exec: local server provides cache only. upload local results to the cache")
+		reCacheWriteLocal = true
+	case opts.REAPIClient != nil:
+		if !opts.REAPIClient.ExecEnabled() {
+			logger.Warningf("reapi server doesn't report exec capability. enable remote exec anyway")
+		}
 		logger.Infof("enable remote exec")
 		re = remoteexec.New(ctx, opts.REAPIClient)
-	} else {
+	default:
 		logger.Infof("disable remote exec")
 	}
 	pe = reproxyexec.New(ctx, opts.ReproxyAddr)
@@ -294,10 +318,11 @@ func New(ctx context.Context, graph Graph, opts Options) (*Builder, error) {
 		remoteExec:        re,
 		reCacheEnableRead: opts.RECacheEnableRead,
 		// reCacheEnableWrite: opts.RECacheEnableWrite,
-		reproxyExec: pe,
-		reproxySema: semaphore.New("reproxyexec", opts.Limits.Remote),
-		actionSalt:  opts.ActionSalt,
-		reapiclient: opts.REAPIClient,
+		reCacheWriteLocal: reCacheWriteLocal,
+		reproxyExec:       pe,
+		reproxySema:       semaphore.New("reproxyexec", opts.Limits.Remote),
+		actionSalt:        opts.ActionSalt,
+		reapiclient:       opts.REAPIClient,
 
 		outputLocal:          opts.OutputLocal,
 		cacheSema:            semaphore.New("cache", opts.Limits.Cache),
@@ -321,6 +346,7 @@ func New(ctx context.Context, graph Graph, opts Options) (*Builder, error) {
 		failuresAllowed:      opts.FailuresAllowed,
 		keepRSP:              opts.KeepRSP,
 		rebuildManifest:      opts.RebuildManifest,
+		stepDurations:        opts.StepDurations,
 	}, nil
 }
 
@@ -400,9 +426,10 @@ func (b *Builder) Build(ctx context.Context, name string, args ...string) (err e
 	// scheduling
 	// TODO: run asynchronously?
 	schedOpts := schedulerOption{
-		Path:    b.path,
-		HashFS:  b.hashFS,
-		Prepare: b.prepare,
+		Path:          b.path,
+		HashFS:        b.hashFS,
+		Prepare:       b.prepare,
+		StepDurations: b.stepDurations,
 	}
 	sched := newScheduler(ctx, schedOpts)
 	err = schedule(ctx, sched, b.graph, args...)
@@ -521,6 +548,11 @@ func (b *Builder) Build(ctx context.Context, name string, args ...string) (err e
 		reapi.FileSemaphore,
 		remoteexec.Semaphore,
 	}
+	statusSemas := append([]*semaphore.Semaphore{b.fastLocalSema, b.preprocSema, b.scanDepsSema}, semas...)
+	for _, k := range pools {
+		statusSemas = append(statusSemas, b.poolSemas[k])
+	}
+	b.status.setSemaphores(statusSemas)
 	b.traceEvents.Start(ctx, semas, []*iometrics.IOMetrics{
 		b.hashFS.OS.IOMetrics,
 		b.reapiclient.IOMetrics(),
@@ -191,14 +191,22 @@ func (depsGCC) scandeps(ctx context.Context, b *Builder, step *Step) ([]string,
 		for i := range params.Sysroots {
 			params.Sysroots[i] = b.path.MaybeFromWD(ctx, params.Sysroots[i])
 		}
+		for name, bmi := range params.ModuleFiles {
+			params.ModuleFiles[name] = b.path.MaybeFromWD(ctx, bmi)
+		}
+		for i := range params.ModuleDirs {
+			params.ModuleDirs[i] = b.path.MaybeFromWD(ctx, params.ModuleDirs[i])
+		}
 		req := scandeps.Request{
-			Defines:    params.Defines,
-			Sources:    params.Sources,
-			Includes:   params.Includes,
-			Dirs:       params.Dirs,
-			Frameworks: params.Frameworks,
-			Sysroots:   params.Sysroots,
-			Timeout:    step.cmd.Timeout,
+			Defines:     params.Defines,
+			Sources:     params.Sources,
+			Includes:    params.Includes,
+			Dirs:        params.Dirs,
+			Frameworks:  params.Frameworks,
+			Sysroots:    params.Sysroots,
+			ModuleFiles: params.ModuleFiles,
+			ModuleDirs:  params.ModuleDirs,
+			Timeout:     step.cmd.Timeout,
 		}
 		if experiments.Enabled("no-fallback", "no-fallback has longer timeout for scandeps") {
 			req.Timeout = 2 * req.Timeout
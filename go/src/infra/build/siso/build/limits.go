@@ -126,6 +126,31 @@ func DefaultLimits(ctx context.Context) Limits {
 	return defaultLimits
 }
 
+// WithJobs returns limits for ninja's -j jobs, i.e. run at most
+// jobs steps in parallel. jobs=0 means infinity, so limits are
+// not changed.
+// If remote is false, all steps run locally, so it runs jobs local
+// processes in parallel as ninja does. Otherwise, local and remote
+// limits are capped by jobs.
+// Note that pool depth is capped by local limit in builder, so
+// pool never runs more than jobs steps in parallel.
+func (l Limits) WithJobs(jobs int, remote bool) Limits {
+	if jobs <= 0 {
+		return l
+	}
+	l.Step = jobs
+	l.Preproc = min(l.Preproc, jobs)
+	if remote {
+		l.Local = min(l.Local, jobs)
+	} else {
+		l.Local = jobs
+	}
+	l.FastLocal = min(l.FastLocal, jobs)
+	l.Remote = min(l.Remote, jobs)
+	l.REWrap = min(l.REWrap, jobs)
+	return l
+}
+
 // UnitTestLimits returns limits used in unit tests.
 // It sets 2 for all limits.
 // Otherwise, builder will start many steps, so hard to
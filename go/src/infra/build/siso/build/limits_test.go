@@ -0,0 +1,87 @@
+// Copyright 2024 The Chromium Authors
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package build
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestLimitsWithJobs(t *testing.T) {
+	limits := Limits{
+		Step:      1024,
+		Preproc:   1024,
+		ScanDeps:  4,
+		Local:     2,
+		FastLocal: 1,
+		Remote:    160,
+		REWrap:    80,
+		Cache:     1024,
+	}
+	for _, tc := range []struct {
+		name   string
+		jobs   int
+		remote bool
+		want   Limits
+	}{
+		{
+			name: "infinity",
+			jobs: 0,
+			want: limits,
+		},
+		{
+			name:   "remote",
+			jobs:   100,
+			remote: true,
+			want: Limits{
+				Step:      100,
+				Preproc:   100,
+				ScanDeps:  4,
+				Local:     2,
+				FastLocal: 1,
+				Remote:    100,
+				REWrap:    80,
+				Cache:     1024,
+			},
+		},
+		{
+			name: "local",
+			jobs: 8,
+			want: Limits{
+				Step:      8,
+				Preproc:   8,
+				ScanDeps:  4,
+				Local:     8,
+				FastLocal: 1,
+				Remote:    8,
+				REWrap:    8,
+				Cache:     1024,
+			},
+		},
+		{
+			name:   "j1",
+			jobs:   1,
+			remote: true,
+			want: Limits{
+				Step:      1,
+				Preproc:   1,
+				ScanDeps:  4,
+				Local:     1,
+				FastLocal: 1,
+				Remote:    1,
+				REWrap:    1,
+				Cache:     1024,
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got := limits.WithJobs(tc.jobs, tc.remote)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("limits.WithJobs(%d, %t) diff -want +got:\n%s", tc.jobs, tc.remote, diff)
+			}
+		})
+	}
+}
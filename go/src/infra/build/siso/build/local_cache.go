@@ -8,11 +8,18 @@ import (
 	"bytes"
 	"compress/gzip"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"io/fs"
 	"os"
 	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
 
 	rpb "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
 	"golang.org/x/sync/singleflight"
@@ -26,23 +33,75 @@ import (
 	"infra/build/siso/reapi/digest"
 )
 
+const (
+	// localCacheStatsFile is the file to store cumulative stats
+	// of the local cache, in the cache dir.
+	localCacheStatsFile = "stats.json"
+
+	// localCacheTouchInterval is the interval to update mtime of
+	// cache entries on access.
+	// mtime is used as access time for eviction, since atime
+	// may not be updated (e.g. relatime, noatime).
+	// Not to update mtime on every access, it updates only if
+	// mtime is older than this interval.
+	localCacheTouchInterval = 1 * time.Hour
+
+	// localCacheGCRatio is the ratio of bytes written in the cache
+	// to max size to trigger gc in background.
+	localCacheGCRatio = 20
+
+	// localCacheGCLowWatermark is the percentage of max size
+	// that gc reduces the cache to, so it doesn't need to run
+	// gc soon again.
+	localCacheGCLowWatermark = 90
+)
+
 // LocalCache implements CacheStore interface with local files.
 type LocalCache struct {
 	dir string
 
+	// maxSize is the max size of the cache in bytes.
+	// If it is positive, least recently used entries are evicted
+	// in background when the cache becomes larger than maxSize.
+	maxSize int64
+
 	singleflight singleflight.Group
 	m            *iometrics.IOMetrics
+
+	// hits and misses count action cache lookups.
+	hits   atomic.Int64
+	misses atomic.Int64
+
+	// written is bytes written since the last gc.
+	written      atomic.Int64
+	gcRunning    atomic.Bool
+	evicted      atomic.Int64
+	evictedBytes atomic.Int64
 }
 
 // NewLocalCache returns new local cache.
-func NewLocalCache(dir string) (*LocalCache, error) {
+// If maxSize is positive, the cache will be trimmed to maxSize
+// in background.
+func NewLocalCache(dir string, maxSize int64) (*LocalCache, error) {
 	if dir == "" {
 		return nil, errors.New("local cache is not configured")
 	}
-	return &LocalCache{
-		dir: dir,
-		m:   iometrics.New("local-cache"),
-	}, nil
+	c := &LocalCache{
+		dir:     dir,
+		maxSize: maxSize,
+		m:       iometrics.New("local-cache"),
+	}
+	// run gc on the first write to check the current cache size.
+	c.written.Store(maxSize / localCacheGCRatio)
+	return c, nil
+}
+
+// Dir returns the directory of the local cache.
+func (c *LocalCache) Dir() string {
+	if c == nil {
+		return ""
+	}
+	return c.dir
 }
 
 // IOMetrics returns io metrics of the local cache.
@@ -64,10 +123,22 @@ func (c *LocalCache) contentCacheFilename(d digest.Digest) string {
 }
 
 // GetActionResult gets the action result of the action identified by the digest.
+// It returns NotFound if any output of the action result has been evicted
+// from the cache, as the outputs can't be written without them.
 func (c *LocalCache) GetActionResult(ctx context.Context, d digest.Digest) (*rpb.ActionResult, error) {
 	if c == nil {
 		return nil, status.Error(codes.NotFound, "cache is not configured")
 	}
+	result, err := c.getActionResult(ctx, d)
+	if err != nil {
+		c.misses.Add(1)
+		return nil, err
+	}
+	c.hits.Add(1)
+	return result, nil
+}
+
+func (c *LocalCache) getActionResult(ctx context.Context, d digest.Digest) (*rpb.ActionResult, error) {
 	fname := c.actionCacheFilename(d)
 	b, err := os.ReadFile(fname)
 	c.m.ReadDone(len(b), err)
@@ -77,14 +148,83 @@ func (c *LocalCache) GetActionResult(ctx context.Context, d digest.Digest) (*rpb
 	if err != nil {
 		return nil, err
 	}
+	touchCacheEntry(ctx, fname)
 	result := &rpb.ActionResult{}
 	err = proto.Unmarshal(b, result)
 	if err != nil {
 		return nil, fmt.Errorf("failed to unmarshal %s: %w", fname, err)
 	}
+	err = c.checkOutputs(ctx, result)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "incomplete %s: %v", fname, err)
+	}
 	return result, nil
 }
 
+// checkOutputs checks that the contents of the outputs of the action result
+// exist in the cache, and touches them so that they are not evicted
+// before the action entry.
+func (c *LocalCache) checkOutputs(ctx context.Context, result *rpb.ActionResult) error {
+	check := func(d digest.Digest) error {
+		if d.SizeBytes == 0 {
+			return nil
+		}
+		cname := c.contentCacheFilename(d)
+		_, err := os.Stat(cname)
+		c.m.OpsDone(err)
+		if err != nil {
+			return err
+		}
+		touchCacheEntry(ctx, cname)
+		return nil
+	}
+	checkFiles := func(files []*rpb.FileNode) error {
+		for _, f := range files {
+			if err := check(digest.FromProto(f.GetDigest())); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	for _, f := range result.GetOutputFiles() {
+		if err := check(digest.FromProto(f.GetDigest())); err != nil {
+			return err
+		}
+	}
+	for _, d := range []*rpb.Digest{result.GetStdoutDigest(), result.GetStderrDigest()} {
+		if err := check(digest.FromProto(d)); err != nil {
+			return err
+		}
+	}
+	for _, dir := range result.GetOutputDirectories() {
+		td := digest.FromProto(dir.GetTreeDigest())
+		if err := check(td); err != nil {
+			return err
+		}
+		if td.SizeBytes == 0 {
+			continue
+		}
+		buf, err := c.GetContent(ctx, td, dir.GetPath())
+		if err != nil {
+			return err
+		}
+		tree := &rpb.Tree{}
+		err = proto.Unmarshal(buf, tree)
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal tree %s for %s: %w", td, dir.GetPath(), err)
+		}
+		if err := checkFiles(tree.GetRoot().GetFiles()); err != nil {
+			return err
+		}
+		for _, child := range tree.GetChildren() {
+			if err := checkFiles(child.GetFiles()); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 // GetContent returns content of the fname identified by the digest.
 func (c *LocalCache) GetContent(ctx context.Context, d digest.Digest, _ string) ([]byte, error) {
 	_, span := trace.NewSpan(ctx, "cache-get-content")
@@ -96,6 +236,7 @@ func (c *LocalCache) GetContent(ctx context.Context, d digest.Digest, _ string)
 		return nil, err
 	}
 	defer r.Close()
+	touchCacheEntry(ctx, cname)
 	gr, err := gzip.NewReader(r)
 	if err != nil {
 		c.m.ReadDone(0, err)
@@ -146,6 +287,9 @@ func (c *LocalCache) SetContent(ctx context.Context, d digest.Digest, fname stri
 		return nil, err
 	})
 	clog.Infof(ctx, "write cache content %s for %s shared:%t: %v", d, fname, shared, err)
+	if err == nil && !shared {
+		c.maybeGC(ctx, int64(len(buf)))
+	}
 	return err
 }
 
@@ -204,6 +348,9 @@ func (s dataSource) Open(ctx context.Context) (io.ReadCloser, error) {
 	name := fmt.Sprintf("%s-%d.gz", s.d.Hash, s.d.SizeBytes)
 	cname := filepath.Join(s.c.dir, "contents", name[:2], name[2:])
 	r, err := os.Open(cname)
+	if err == nil {
+		touchCacheEntry(ctx, cname)
+	}
 	if err != nil {
 		var err2 error
 		r, err2 = os.Open(s.fname)
@@ -228,3 +375,288 @@ func (s dataSource) Open(ctx context.Context) (io.ReadCloser, error) {
 func (s dataSource) String() string {
 	return fmt.Sprintf("cache %s for %s", s.d, s.fname)
 }
+
+// touchCacheEntry updates mtime of the cache entry to record
+// the access time, if it is older than localCacheTouchInterval.
+func touchCacheEntry(ctx context.Context, fname string) {
+	fi, err := os.Stat(fname)
+	if err != nil {
+		return
+	}
+	now := time.Now()
+	if now.Sub(fi.ModTime()) < localCacheTouchInterval {
+		return
+	}
+	err = os.Chtimes(fname, now, now)
+	if err != nil {
+		clog.Warningf(ctx, "failed to touch cache entry %s: %v", fname, err)
+	}
+}
+
+// maybeGC runs gc in background if bytes written since the last gc
+// exceeds the threshold.
+func (c *LocalCache) maybeGC(ctx context.Context, n int64) {
+	if c.maxSize <= 0 {
+		return
+	}
+	if c.written.Add(n) < c.maxSize/localCacheGCRatio {
+		return
+	}
+	if !c.gcRunning.CompareAndSwap(false, true) {
+		return
+	}
+	c.written.Store(0)
+	// gc may take long, so don't cancel it by step's context.
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		defer c.gcRunning.Store(false)
+		usage, err := c.GC(ctx, c.maxSize)
+		if err != nil {
+			clog.Warningf(ctx, "local cache gc: %v", err)
+			return
+		}
+		clog.Infof(ctx, "local cache gc: %s", usage)
+	}()
+}
+
+// LocalCacheUsage is disk usage of the local cache.
+type LocalCacheUsage struct {
+	// Files is the number of files in the cache.
+	Files int
+	// Bytes is the total size of the files in the cache.
+	Bytes int64
+
+	// Evicted is the number of files evicted by gc.
+	Evicted int
+	// EvictedBytes is the total size of the files evicted by gc.
+	EvictedBytes int64
+}
+
+func (u LocalCacheUsage) String() string {
+	return fmt.Sprintf("files=%d size=%s evicted=%d evicted_size=%s", u.Files, numBytes(u.Bytes), u.Evicted, numBytes(u.EvictedBytes))
+}
+
+type localCacheEntry struct {
+	fname string
+	size  int64
+	atime time.Time
+}
+
+// scan scans cache entries in the local cache.
+func (c *LocalCache) scan(ctx context.Context) ([]localCacheEntry, error) {
+	var entries []localCacheEntry
+	for _, sub := range []string{"actions", "contents"} {
+		err := filepath.WalkDir(filepath.Join(c.dir, sub), func(pathname string, d fs.DirEntry, err error) error {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if !d.Type().IsRegular() {
+				return nil
+			}
+			fi, err := d.Info()
+			if errors.Is(err, fs.ErrNotExist) {
+				// removed by other process.
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			entries = append(entries, localCacheEntry{
+				fname: pathname,
+				size:  fi.Size(),
+				atime: fi.ModTime(),
+			})
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return entries, nil
+}
+
+// Usage returns the current disk usage of the local cache.
+func (c *LocalCache) Usage(ctx context.Context) (LocalCacheUsage, error) {
+	var usage LocalCacheUsage
+	entries, err := c.scan(ctx)
+	if err != nil {
+		return usage, err
+	}
+	for _, e := range entries {
+		usage.Files++
+		usage.Bytes += e.size
+	}
+	return usage, nil
+}
+
+// GC evicts least recently used entries from the local cache
+// if the cache is larger than maxSize.
+// It evicts entries until the cache becomes smaller than 90% of maxSize,
+// so that it doesn't need to run gc again soon.
+// Action entries and contents are evicted independently, but
+// GetActionResult treats an action entry whose outputs were evicted
+// as a miss.
+// It returns disk usage after gc.
+func (c *LocalCache) GC(ctx context.Context, maxSize int64) (LocalCacheUsage, error) {
+	var usage LocalCacheUsage
+	started := time.Now()
+	entries, err := c.scan(ctx)
+	if err != nil {
+		return usage, err
+	}
+	for _, e := range entries {
+		usage.Files++
+		usage.Bytes += e.size
+	}
+	if usage.Bytes <= maxSize {
+		clog.Infof(ctx, "local cache gc: no need to evict. %s <= %s", numBytes(usage.Bytes), numBytes(maxSize))
+		return usage, nil
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].atime.Before(entries[j].atime)
+	})
+	target := maxSize * localCacheGCLowWatermark / 100
+	for _, e := range entries {
+		if usage.Bytes <= target {
+			break
+		}
+		err := os.Remove(e.fname)
+		if errors.Is(err, fs.ErrNotExist) {
+			// removed by other process.
+			usage.Files--
+			usage.Bytes -= e.size
+			continue
+		}
+		if err != nil {
+			clog.Warningf(ctx, "local cache gc: failed to remove %s: %v", e.fname, err)
+			continue
+		}
+		usage.Files--
+		usage.Bytes -= e.size
+		usage.Evicted++
+		usage.EvictedBytes += e.size
+	}
+	c.evicted.Add(int64(usage.Evicted))
+	c.evictedBytes.Add(usage.EvictedBytes)
+	clog.Infof(ctx, "local cache gc in %s: %s", time.Since(started), usage)
+	return usage, ctx.Err()
+}
+
+// LocalCacheStats is cumulative stats of the local cache,
+// stored in stats.json in the cache dir.
+type LocalCacheStats struct {
+	// Hits is the number of action cache lookups succeeded.
+	Hits int64 `json:"hits"`
+	// Misses is the number of action cache lookups failed,
+	// including the ones whose outputs were evicted.
+	Misses int64 `json:"misses"`
+	// ReadBytes is the total bytes read from the cache.
+	ReadBytes int64 `json:"read_bytes"`
+	// Writes is the number of cache writes.
+	Writes int64 `json:"writes"`
+	// WriteBytes is the total bytes written to the cache.
+	WriteBytes int64 `json:"write_bytes"`
+	// Evicted is the number of files evicted by gc.
+	Evicted int64 `json:"evicted"`
+	// EvictedBytes is the total bytes evicted by gc.
+	EvictedBytes int64 `json:"evicted_bytes"`
+	// Since is the time when stats started to be collected.
+	Since time.Time `json:"since"`
+}
+
+// HitRatio returns cache hit ratio.
+func (s LocalCacheStats) HitRatio() float64 {
+	if s.Hits+s.Misses == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(s.Hits+s.Misses)
+}
+
+// LoadLocalCacheStats loads cumulative stats of the local cache in dir.
+func LoadLocalCacheStats(dir string) (LocalCacheStats, error) {
+	var stats LocalCacheStats
+	buf, err := os.ReadFile(filepath.Join(dir, localCacheStatsFile))
+	if err != nil {
+		return stats, err
+	}
+	err = json.Unmarshal(buf, &stats)
+	if err != nil {
+		return stats, fmt.Errorf("failed to parse %s: %w", filepath.Join(dir, localCacheStatsFile), err)
+	}
+	return stats, nil
+}
+
+// SaveStats adds the action cache lookups and the iometrics of the local
+// cache in this process to the cumulative stats in the cache dir.
+// Concurrent updates by other processes may be lost,
+// since it is only used for reporting.
+func (c *LocalCache) SaveStats(ctx context.Context) error {
+	if c == nil {
+		return nil
+	}
+	stats, err := LoadLocalCacheStats(c.dir)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		clog.Warningf(ctx, "reset local cache stats: %v", err)
+	}
+	if stats.Since.IsZero() {
+		stats.Since = time.Now()
+	}
+	ms := c.m.Stats()
+	stats.Hits += c.hits.Load()
+	stats.Misses += c.misses.Load()
+	stats.ReadBytes += ms.RBytes
+	stats.Writes += ms.WOps
+	stats.WriteBytes += ms.WBytes
+	stats.Evicted += c.evicted.Load()
+	stats.EvictedBytes += c.evictedBytes.Load()
+	buf, err := json.MarshalIndent(stats, "", " ")
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(c.dir, 0755)
+	if err != nil {
+		return err
+	}
+	fname := filepath.Join(c.dir, localCacheStatsFile)
+	tmpname := fname + ".tmp." + strconv.Itoa(os.Getpid())
+	err = os.WriteFile(tmpname, buf, 0644)
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmpname, fname)
+}
+
+// ParseByteSize parses size string with optional unit suffix,
+// e.g. "1024", "512M", "10GiB", "1TB".
+// Units are binary, i.e. 1K = 1024 bytes.
+func ParseByteSize(s string) (int64, error) {
+	str := strings.TrimSpace(s)
+	str = strings.TrimSuffix(strings.TrimSuffix(str, "B"), "i")
+	mul := int64(1)
+	if str != "" {
+		switch str[len(str)-1] {
+		case 'K', 'k':
+			mul = 1 << 10
+		case 'M', 'm':
+			mul = 1 << 20
+		case 'G', 'g':
+			mul = 1 << 30
+		case 'T', 't':
+			mul = 1 << 40
+		}
+		if mul > 1 {
+			str = str[:len(str)-1]
+		}
+	}
+	n, err := strconv.ParseFloat(strings.TrimSpace(str), 64)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("invalid size %q", s)
+	}
+	return int64(n * float64(mul)), nil
+}
@@ -0,0 +1,188 @@
+// Copyright 2024 The Chromium Authors
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package build
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	rpb "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"infra/build/siso/reapi/digest"
+)
+
+func TestLocalCache_GC(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	c, err := NewLocalCache(dir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var digests []digest.Digest
+	for i, content := range []string{"old", "middle", "new"} {
+		// use incompressible data to make the size of each entry similar.
+		buf := make([]byte, 1000)
+		rand.New(rand.NewSource(int64(i))).Read(buf)
+		copy(buf, content)
+		d := digest.FromBytes(content, buf).Digest()
+		err := c.SetContent(ctx, d, content, buf)
+		if err != nil {
+			t.Fatalf("SetContent(ctx, %v, %q, buf)=%v; want nil error", d, content, err)
+		}
+		mtime := time.Now().Add(time.Duration(i-3) * time.Hour)
+		err = os.Chtimes(c.contentCacheFilename(d), mtime, mtime)
+		if err != nil {
+			t.Fatal(err)
+		}
+		digests = append(digests, d)
+	}
+	before, err := c.Usage(ctx)
+	if err != nil {
+		t.Fatalf("Usage(ctx)=%v; want nil error", err)
+	}
+	if before.Files != 3 {
+		t.Errorf("Usage(ctx).Files=%d; want 3", before.Files)
+	}
+
+	// access to "old" makes it recently used.
+	_, err = c.GetContent(ctx, digests[0], "old")
+	if err != nil {
+		t.Fatalf("GetContent(ctx, %v, %q)=%v; want nil error", digests[0], "old", err)
+	}
+
+	maxSize := before.Bytes * 3 / 4
+	usage, err := c.GC(ctx, maxSize)
+	if err != nil {
+		t.Fatalf("GC(ctx, %d)=%v; want nil error", maxSize, err)
+	}
+	if usage.Evicted != 1 || usage.Files != 2 {
+		t.Errorf("GC(ctx, %d)=%v; want evicted=1 files=2", maxSize, usage)
+	}
+	for i, d := range digests {
+		_, err := os.Stat(c.contentCacheFilename(d))
+		if i == 1 {
+			if !errors.Is(err, fs.ErrNotExist) {
+				t.Errorf("stat %v: %v; want %v", d, err, fs.ErrNotExist)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("stat %v: %v; want nil error", d, err)
+		}
+	}
+
+	err = c.SaveStats(ctx)
+	if err != nil {
+		t.Fatalf("SaveStats(ctx)=%v; want nil error", err)
+	}
+	stats, err := LoadLocalCacheStats(dir)
+	if err != nil {
+		t.Fatalf("LoadLocalCacheStats(%q)=%v; want nil error", dir, err)
+	}
+	// GetContent is not an action cache lookup.
+	if stats.Hits != 0 || stats.Misses != 0 || stats.Evicted != 1 || stats.Writes != 3 {
+		t.Errorf("LoadLocalCacheStats(%q)=%#v; want hits=0 misses=0 evicted=1 writes=3", dir, stats)
+	}
+}
+
+func TestLocalCache_GetActionResult(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	c, err := NewLocalCache(dir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := []byte("output")
+	outDigest := digest.FromBytes("out", out).Digest()
+	err = c.SetContent(ctx, outDigest, "out", out)
+	if err != nil {
+		t.Fatalf("SetContent(ctx, %v, %q, buf)=%v; want nil error", outDigest, "out", err)
+	}
+	buf, err := proto.Marshal(&rpb.ActionResult{
+		OutputFiles: []*rpb.OutputFile{
+			{Path: "out", Digest: outDigest.Proto()},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	actionDigest := digest.FromBytes("action", []byte("action")).Digest()
+	fname := c.actionCacheFilename(actionDigest)
+	err = os.MkdirAll(filepath.Dir(fname), 0755)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = os.WriteFile(fname, buf, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = c.GetActionResult(ctx, actionDigest)
+	if err != nil {
+		t.Errorf("GetActionResult(ctx, %v)=%v; want nil error", actionDigest, err)
+	}
+
+	// the action entry is a miss once its output is evicted.
+	err = os.Remove(c.contentCacheFilename(outDigest))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = c.GetActionResult(ctx, actionDigest)
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("GetActionResult(ctx, %v)=%v; want %v", actionDigest, err, codes.NotFound)
+	}
+	_, err = c.GetActionResult(ctx, digest.FromBytes("other", []byte("other")).Digest())
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("GetActionResult(ctx, other)=%v; want %v", err, codes.NotFound)
+	}
+
+	err = c.SaveStats(ctx)
+	if err != nil {
+		t.Fatalf("SaveStats(ctx)=%v; want nil error", err)
+	}
+	stats, err := LoadLocalCacheStats(dir)
+	if err != nil {
+		t.Fatalf("LoadLocalCacheStats(%q)=%v; want nil error", dir, err)
+	}
+	if stats.Hits != 1 || stats.Misses != 2 {
+		t.Errorf("LoadLocalCacheStats(%q)=%#v; want hits=1 misses=2", dir, stats)
+	}
+}
+
+func TestParseByteSize(t *testing.T) {
+	for _, tc := range []struct {
+		input   string
+		want    int64
+		wantErr bool
+	}{
+		{input: "1024", want: 1024},
+		{input: "4K", want: 4 << 10},
+		{input: "512MiB", want: 512 << 20},
+		{input: "10GB", want: 10 << 30},
+		{input: "1.5G", want: 3 << 29},
+		{input: "1T", want: 1 << 40},
+		{input: "", wantErr: true},
+		{input: "abc", wantErr: true},
+		{input: "-1G", wantErr: true},
+	} {
+		got, err := ParseByteSize(tc.input)
+		if (err != nil) != tc.wantErr {
+			t.Errorf("ParseByteSize(%q)=%d, %v; want err=%t", tc.input, got, err, tc.wantErr)
+			continue
+		}
+		if got != tc.want {
+			t.Errorf("ParseByteSize(%q)=%d; want %d", tc.input, got, tc.want)
+		}
+	}
+}
@@ -8,6 +8,10 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
 	"time"
 
 	epb "infra/build/siso/execute/proto"
@@ -168,3 +172,31 @@ func (m *StepMetric) done(ctx context.Context, step *Step) {
 		}
 	}
 }
+
+// LoadStepDurations loads step durations from metrics JSON file
+// (i.e. siso_metrics.json), keyed by the first output of the step.
+// The metric for the full build is ignored.
+func LoadStepDurations(fname string) (map[string]time.Duration, error) {
+	f, err := os.Open(fname)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	d := json.NewDecoder(f)
+	durations := make(map[string]time.Duration)
+	for {
+		var m StepMetric
+		err := d.Decode(&m)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("parse error in %s:%d: %w", fname, d.InputOffset(), err)
+		}
+		if m.StepID == "" || m.Output == "" {
+			continue
+		}
+		durations[m.Output] = time.Duration(m.Duration)
+	}
+	return durations, nil
+}
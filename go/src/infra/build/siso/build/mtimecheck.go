@@ -52,7 +52,7 @@ func (b *Builder) checkUpToDate(ctx context.Context, stepDef StepDef, outputs []
 	generator := stepDef.Binding("generator") != ""
 	cmdline := stepDef.Binding("command")
 	rspfileContent := stepDef.Binding("rspfile_content")
-	stepCmdHash := calculateCmdHash(cmdline, rspfileContent)
+	stepCmdHash := CalculateCmdHash(cmdline, rspfileContent)
 
 	out0, outmtime, cmdhash := outputMtime(ctx, b, outputs, stepDef.Binding("restat") != "")
 	lastIn, inmtime, err := inputMtime(ctx, b, stepDef)
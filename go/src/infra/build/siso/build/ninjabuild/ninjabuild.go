@@ -481,6 +481,91 @@ func (g *Graph) CleanDead(ctx context.Context) (int, int, error) {
 	return len(deads), len(genFiles), err
 }
 
+// Clean removes files generated by the steps to build args.
+// If args is empty, it removes files generated by all steps.
+// Outputs of generator steps (e.g. build.ninja) are kept,
+// same as `ninja -t clean` without -g.
+// It also removes depfile and rspfile of the steps.
+// It returns the number of removed files.
+//
+// https://github.com/ninja-build/ninja/blob/a524bf3f6bacd1b4ad85d719eed2737d8562f27a/src/clean.cc#L100
+func (g *Graph) Clean(ctx context.Context, args []string, dryRun bool) (int, error) {
+	started := time.Now()
+	var edges []*ninjautil.Edge
+	if len(args) == 0 {
+		edges = g.nstate.Edges()
+	} else {
+		nodes, err := g.nstate.Targets(args)
+		if err != nil {
+			return 0, err
+		}
+		seen := make(map[*ninjautil.Edge]bool)
+		var visit func(*ninjautil.Node)
+		visit = func(n *ninjautil.Node) {
+			edge, ok := n.InEdge()
+			if !ok || seen[edge] {
+				return
+			}
+			seen[edge] = true
+			edges = append(edges, edge)
+			for _, in := range edge.Inputs() {
+				visit(in)
+			}
+		}
+		for _, n := range nodes {
+			visit(n)
+		}
+	}
+	dir := filepath.Join(g.globals.path.ExecRoot, g.globals.path.Dir)
+	seen := make(map[string]bool)
+	var removed []string
+	remove := func(fname string) error {
+		if fname == "" || seen[fname] {
+			return nil
+		}
+		seen[fname] = true
+		_, err := g.globals.hashFS.Stat(ctx, dir, fname)
+		if err != nil {
+			return nil
+		}
+		removed = append(removed, fname)
+		if dryRun {
+			return nil
+		}
+		clog.Infof(ctx, "clean %s", fname)
+		return g.globals.hashFS.Remove(ctx, dir, fname)
+	}
+	for _, edge := range edges {
+		if edge.IsPhony() || edge.BindingBool("generator") {
+			continue
+		}
+		for _, out := range edge.Outputs() {
+			err := remove(out.Path())
+			if err != nil {
+				return len(removed), err
+			}
+		}
+		err := remove(edge.UnescapedBinding("depfile"))
+		if err != nil {
+			return len(removed), err
+		}
+		err = remove(edge.UnescapedBinding("rspfile"))
+		if err != nil {
+			return len(removed), err
+		}
+	}
+	var err error
+	if len(removed) > 0 && !dryRun {
+		err = g.globals.hashFS.Flush(ctx, dir, removed)
+	}
+	if err != nil {
+		clog.Warningf(ctx, "clean %d %s: %v", len(removed), time.Since(started), err)
+	} else {
+		clog.Infof(ctx, "clean %d %s", len(removed), time.Since(started))
+	}
+	return len(removed), err
+}
+
 // isDead reports fname is dead generated file or not.
 // i.e. it is considered as dead if one of the following conditions is met.
 //   - it is not used in current ninja build graph.
@@ -5,6 +5,7 @@
 package build
 
 import (
+	"container/heap"
 	"context"
 	"errors"
 	"fmt"
@@ -86,21 +87,66 @@ type plan struct {
 	// marked source target
 	m map[Target]bool
 
-	mu        sync.Mutex
-	q         chan *Step
-	closed    bool
-	ready     []*Step
+	mu     sync.Mutex
+	q      chan *Step
+	closed bool
+	// qlimit is the max number of steps in q.
+	// steps more than qlimit are kept in ready, so that
+	// the step with larger weight in ready will run first.
+	qlimit    int
+	ready     readyQueue
 	waits     map[Target][]*Step
 	outputs   map[Target]struct{}
 	npendings int
 }
 
+// readyQueue is a priority queue of ready steps.
+// Steps are ordered by critical path weight (larger first),
+// and by the order they became ready for the same weight.
+type readyQueue struct {
+	steps []*Step
+	seq   int64
+}
+
+func (q readyQueue) Len() int { return len(q.steps) }
+
+func (q readyQueue) Less(i, j int) bool {
+	if q.steps[i].weight != q.steps[j].weight {
+		return q.steps[i].weight > q.steps[j].weight
+	}
+	return q.steps[i].readySeq < q.steps[j].readySeq
+}
+
+func (q readyQueue) Swap(i, j int) { q.steps[i], q.steps[j] = q.steps[j], q.steps[i] }
+
+func (q *readyQueue) Push(x any) {
+	step := x.(*Step)
+	step.readySeq = q.seq
+	q.seq++
+	q.steps = append(q.steps, step)
+}
+
+func (q *readyQueue) Pop() any {
+	n := len(q.steps)
+	step := q.steps[n-1]
+	// Deallocate q.steps[n-1] explicitly.
+	q.steps[n-1] = nil
+	q.steps = q.steps[:n-1]
+	return step
+}
+
 // schedulerOption is scheduler option.
 type schedulerOption struct {
 	Path        *Path
 	HashFS      *hashfs.HashFS
 	Prepare     bool
 	EnableTrace bool
+
+	// StepDurations is historical durations of steps,
+	// keyed by the first output of the step.
+	// If it is not nil, ready steps are dequeued by
+	// critical path weight computed with the durations.
+	StepDurations map[string]time.Duration
 }
 
 // scheduler creates a plan.
@@ -126,6 +172,11 @@ type scheduler struct {
 	prepareHeaderOnly bool
 
 	enableTrace bool
+
+	// stepDurations is historical durations of steps,
+	// keyed by the first output of the step.
+	// nil if critical path scheduling is disabled.
+	stepDurations map[string]time.Duration
 }
 
 // schedule schedules build plans for args from graph into sched.
@@ -145,6 +196,9 @@ func schedule(ctx context.Context, sched *scheduler, graph Graph, args ...string
 			return fmt.Errorf("failed in schedule %s: %w", t, err)
 		}
 	}
+	if sched.stepDurations != nil {
+		sched.computeWeights(ctx)
+	}
 	sched.finish(ctx, time.Since(started))
 	return nil
 }
@@ -263,13 +317,23 @@ func newScheduler(ctx context.Context, opt schedulerOption) *scheduler {
 	if opt.EnableTrace {
 		clog.Infof(ctx, "schedule: enable trace")
 	}
+	// preallocate capacity for performance optimization.
+	const qsize = 10000
+	qlimit := qsize
+	if opt.StepDurations != nil {
+		clog.Infof(ctx, "schedule: critical path with %d step durations", len(opt.StepDurations))
+		// keep ready steps in the priority queue as much as
+		// possible, so that the builder picks the step with
+		// the largest weight.
+		qlimit = 1
+	}
 	return &scheduler{
 		path:   opt.Path,
 		hashFS: opt.HashFS,
 		plan: &plan{
-			m: make(map[Target]bool),
-			// preallocate capacity for performance optimization.
-			q:       make(chan *Step, 10000),
+			m:       make(map[Target]bool),
+			q:       make(chan *Step, qsize),
+			qlimit:  qlimit,
 			waits:   make(map[Target][]*Step),
 			outputs: make(map[Target]struct{}),
 		},
@@ -277,6 +341,7 @@ func newScheduler(ctx context.Context, opt schedulerOption) *scheduler {
 		prepare:           opt.Prepare,
 		prepareHeaderOnly: prepareHeaderOnly,
 		enableTrace:       opt.EnableTrace,
+		stepDurations:     opt.StepDurations,
 	}
 }
 
@@ -315,7 +380,7 @@ func (s *scheduler) progressReport(format string, args ...any) {
 func (s *scheduler) finish(ctx context.Context, d time.Duration) {
 	s.plan.mu.Lock()
 	defer s.plan.mu.Unlock()
-	nready := len(s.plan.q) + len(s.plan.ready)
+	nready := len(s.plan.q) + s.plan.ready.Len()
 	npendings := s.plan.npendings
 	if d < ui.DurationThreshold {
 		return
@@ -331,7 +396,7 @@ func (s *scheduler) add(ctx context.Context, graph Graph, stepDef StepDef, waits
 		if time.Since(s.lastProgress) < 1*time.Second {
 			return
 		}
-		nready := len(s.plan.q) + len(s.plan.ready)
+		nready := len(s.plan.q) + s.plan.ready.Len()
 		npendings := s.plan.npendings
 		s.progressReport("schedule pending:%d+ready:%d (node:%d edge:%d)", npendings, nready, len(s.plan.m), s.visited)
 		s.lastProgress = time.Now()
@@ -348,13 +413,8 @@ func (s *scheduler) add(ctx context.Context, graph Graph, stepDef StepDef, waits
 		if log.V(1) {
 			clog.Infof(ctx, "step state: %s ready to run", step.String())
 		}
-		select {
-		case s.plan.q <- step:
-		default:
-			step.queueTime = time.Now()
-			step.queueSize = len(s.plan.ready)
-			s.plan.ready = append(s.plan.ready, step)
-		}
+		s.plan.pushLocked(step)
+		s.plan.fillLocked()
 		return
 	}
 	if log.V(1) {
@@ -376,31 +436,44 @@ func (p *plan) stats() planStats {
 	defer p.mu.Unlock()
 	return planStats{
 		npendings: p.npendings,
-		nready:    len(p.q) + len(p.ready),
+		nready:    len(p.q) + p.ready.Len(),
+	}
+}
+
+// pushLocked pushes ready step into the ready queue.
+// p.mu must be held.
+func (p *plan) pushLocked(step *Step) {
+	step.queueTime = time.Now()
+	step.queueSize = p.ready.Len()
+	heap.Push(&p.ready, step)
+}
+
+// fillLocked sends steps in the ready queue to q
+// until q has qlimit steps.
+// p.mu must be held.
+func (p *plan) fillLocked() {
+	for p.ready.Len() > 0 && len(p.q) < p.qlimit {
+		step := p.ready.steps[0]
+		select {
+		case p.q <- step:
+			heap.Pop(&p.ready)
+			step.queueDuration = time.Since(step.queueTime)
+		default:
+			return
+		}
 	}
 }
 
 func (p *plan) pushReady() {
 	p.mu.Lock()
 	defer p.mu.Unlock()
-	if len(p.ready) == 0 {
-		return
-	}
-	select {
-	case p.q <- p.ready[0]:
-		p.ready[0].queueDuration = time.Since(p.ready[0].queueTime)
-		// Deallocate p.ready[0] explicitly.
-		copy(p.ready, p.ready[1:])
-		p.ready[len(p.ready)-1] = nil
-		p.ready = p.ready[:len(p.ready)-1]
-	default:
-	}
+	p.fillLocked()
 }
 
 func (p *plan) hasReady() bool {
 	p.mu.Lock()
 	defer p.mu.Unlock()
-	return len(p.q) > 0 || len(p.ready) > 0
+	return len(p.q) > 0 || p.ready.Len() > 0
 }
 
 func (p *plan) done(ctx context.Context, step *Step) {
@@ -408,32 +481,14 @@ func (p *plan) done(ctx context.Context, step *Step) {
 	p.mu.Lock()
 	defer p.mu.Unlock()
 
-	// Before processing the completed step,
-	// send ready steps from p.ready to p.q and resize p.ready.
-	i := 0
-	for _, s := range p.ready {
-		select {
-		case p.q <- s:
-			s.queueDuration = time.Since(s.queueTime)
-		default:
-			p.ready[i] = s
-			i++
-		}
-	}
-	for j := i; j < len(p.ready); j++ {
-		p.ready[j] = nil
-	}
-	p.ready = p.ready[:i]
-
-	// Unblock waiting steps and send them to the queue if they are ready.
+	// Unblock waiting steps and push them to the ready queue if they are ready.
 	npendings := p.npendings
 	nready := 0
-	ready := make([]*Step, 0, len(outs))
 	for _, out := range outs {
 		if log.V(1) {
 			clog.Infof(ctx, "done %s", out)
 		}
-		i = 0
+		i := 0
 		for _, s := range p.waits[out] {
 			if s.ReadyToRun(step.String(), out) {
 				p.npendings--
@@ -441,13 +496,7 @@ func (p *plan) done(ctx context.Context, step *Step) {
 				if log.V(1) {
 					clog.Infof(ctx, "step state: %s ready to run", s.String())
 				}
-				select {
-				case p.q <- s:
-				default:
-					s.queueTime = time.Now()
-					s.queueSize = len(ready)
-					ready = append(ready, s)
-				}
+				p.pushLocked(s)
 				continue
 			}
 			p.waits[out][i] = s
@@ -469,8 +518,8 @@ func (p *plan) done(ctx context.Context, step *Step) {
 			clog.Infof(ctx, "zero-trigger outs=%q", outs)
 		}
 	}
-	p.ready = append(p.ready, ready...)
-	if len(p.ready) == 0 && p.npendings == 0 && !p.closed {
+	p.fillLocked()
+	if p.ready.Len() == 0 && p.npendings == 0 && !p.closed {
 		p.closed = true
 		clog.Infof(ctx, "no step in pending. closing q")
 		close(p.q)
@@ -485,8 +534,8 @@ func (p *plan) dump(ctx context.Context, graph Graph) {
 	var steps []*Step
 	seen := make(map[*Step]bool)
 	waits := make(map[Target]bool)
-	ready := make([]string, 0, len(p.ready))
-	for _, s := range p.ready {
+	ready := make([]string, 0, p.ready.Len())
+	for _, s := range p.ready.steps {
 		ready = append(ready, s.String())
 		seen[s] = true
 		steps = append(steps, s)
@@ -524,3 +573,83 @@ func (p *plan) dump(ctx context.Context, graph Graph) {
 	clog.Infof(ctx, "waits=%d no-trigger=%d", len(p.waits), len(outs))
 	clog.Infof(ctx, "no steps will trigger %q", outs)
 }
+
+// defaultStepDuration is the estimated duration of a step
+// that has no historical duration.
+const defaultStepDuration = 1 * time.Second
+
+// computeWeights computes critical path weight of each scheduled step,
+// i.e. the estimated duration of the step plus the largest weight
+// of the steps that depend on its outputs, and reorders the ready queue
+// by the weight.
+// https://github.com/ninja-build/ninja/pull/2177
+func (s *scheduler) computeWeights(ctx context.Context) {
+	started := time.Now()
+	p := s.plan
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	// use average of known durations for steps without history.
+	defaultDuration := defaultStepDuration
+	if len(s.stepDurations) > 0 {
+		var total time.Duration
+		for _, d := range s.stepDurations {
+			total += d
+		}
+		defaultDuration = total / time.Duration(len(s.stepDurations))
+	}
+	estimate := func(step *Step) time.Duration {
+		if step.def.IsPhony() {
+			return 0
+		}
+		outs := step.def.Outputs(ctx)
+		if len(outs) == 0 {
+			return defaultDuration
+		}
+		d, ok := s.stepDurations[outs[0]]
+		if !ok {
+			return defaultDuration
+		}
+		return d
+	}
+
+	// move steps in q back to the ready queue, to reorder them by weight.
+	for len(p.q) > 0 {
+		heap.Push(&p.ready, <-p.q)
+	}
+
+	visited := make(map[*Step]bool)
+	var weight func(*Step) time.Duration
+	weight = func(step *Step) time.Duration {
+		if visited[step] {
+			return step.weight
+		}
+		visited[step] = true
+		var w time.Duration
+		for _, out := range step.outputs {
+			for _, next := range p.waits[out] {
+				nw := weight(next)
+				if nw > w {
+					w = nw
+				}
+			}
+		}
+		step.weight = estimate(step) + w
+		return step.weight
+	}
+	var maxWeight time.Duration
+	for _, step := range p.ready.steps {
+		w := weight(step)
+		if w > maxWeight {
+			maxWeight = w
+		}
+	}
+	for _, steps := range p.waits {
+		for _, step := range steps {
+			weight(step)
+		}
+	}
+	heap.Init(&p.ready)
+	p.fillLocked()
+	clog.Infof(ctx, "compute weights of %d steps in %s: critical path %s", len(visited), time.Since(started), maxWeight)
+}
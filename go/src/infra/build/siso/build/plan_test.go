@@ -0,0 +1,236 @@
+// Copyright 2024 The Chromium Authors
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package build
+
+import (
+	"container/heap"
+	"context"
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+)
+
+// testPlanStep is a step in synthetic graph for plan tests.
+type testPlanStep struct {
+	output   string
+	inputs   []string
+	duration time.Duration
+}
+
+func newTestScheduler(ctx context.Context, steps []testPlanStep, durations map[string]time.Duration) *scheduler {
+	sched := newScheduler(ctx, schedulerOption{
+		StepDurations: durations,
+	})
+	for _, s := range steps {
+		waits := make(map[Target]struct{})
+		for _, in := range s.inputs {
+			waits[in] = struct{}{}
+		}
+		sched.add(ctx, nil, fakeStepDef{outputs: []string{s.output}}, waits, []Target{s.output})
+	}
+	if durations != nil {
+		sched.computeWeights(ctx)
+	}
+	return sched
+}
+
+func TestPlan_CriticalPath(t *testing.T) {
+	ctx := context.Background()
+	// short independent steps are added before the long chain.
+	var steps []testPlanStep
+	for i := 0; i < 5; i++ {
+		steps = append(steps, testPlanStep{
+			output:   fmt.Sprintf("short%d", i),
+			duration: 1 * time.Second,
+		})
+	}
+	steps = append(steps,
+		testPlanStep{output: "chain0", duration: 10 * time.Second},
+		testPlanStep{output: "chain1", inputs: []string{"chain0"}, duration: 10 * time.Second},
+		testPlanStep{output: "chain2", inputs: []string{"chain1"}, duration: 10 * time.Second},
+	)
+	durations := make(map[string]time.Duration)
+	for _, s := range steps {
+		durations[s.output] = s.duration
+	}
+
+	for _, tc := range []struct {
+		name      string
+		durations map[string]time.Duration
+		want      string
+	}{
+		{
+			name: "fifo",
+			want: "short0",
+		},
+		{
+			name:      "critical_path",
+			durations: durations,
+			want:      "chain0",
+		},
+		{
+			name:      "no_history",
+			durations: map[string]time.Duration{},
+			want:      "chain0",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			sched := newTestScheduler(ctx, steps, tc.durations)
+			step := <-sched.plan.q
+			got := step.def.Outputs(ctx)[0]
+			if got != tc.want {
+				t.Errorf("first step=%q; want=%q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestPlan_CriticalPathWeight(t *testing.T) {
+	ctx := context.Background()
+	steps := []testPlanStep{
+		{output: "a", duration: 1 * time.Second},
+		{output: "b", duration: 2 * time.Second},
+		{output: "c", inputs: []string{"a", "b"}, duration: 3 * time.Second},
+		{output: "d", inputs: []string{"a"}, duration: 10 * time.Second},
+	}
+	durations := make(map[string]time.Duration)
+	for _, s := range steps {
+		durations[s.output] = s.duration
+	}
+	sched := newTestScheduler(ctx, steps, durations)
+	want := map[string]time.Duration{
+		"a": 11 * time.Second,
+		"b": 5 * time.Second,
+		"c": 3 * time.Second,
+		"d": 10 * time.Second,
+	}
+	got := make(map[string]time.Duration)
+	var ordered []string
+	for sched.plan.hasReady() {
+		step := <-sched.plan.q
+		sched.plan.pushReady()
+		out := step.def.Outputs(ctx)[0]
+		got[out] = step.weight
+		ordered = append(ordered, out)
+		sched.plan.done(ctx, step)
+	}
+	for out, w := range want {
+		if got[out] != w {
+			t.Errorf("weight[%q]=%s; want=%s", out, got[out], w)
+		}
+	}
+	if ordered[0] != "a" {
+		t.Errorf("first step=%q; want=%q (order=%q)", ordered[0], "a", ordered)
+	}
+}
+
+// syntheticGraph generates a synthetic build graph with n steps.
+// Most steps are short like compile steps, and a few steps are
+// long like link steps.
+func syntheticGraph(n int) []testPlanStep {
+	r := rand.New(rand.NewSource(1))
+	steps := make([]testPlanStep, 0, n)
+	for i := 0; i < n; i++ {
+		s := testPlanStep{
+			output:   fmt.Sprintf("out%d", i),
+			duration: time.Duration(100+r.Intn(900)) * time.Millisecond,
+		}
+		if r.Intn(100) == 0 {
+			s.duration = time.Duration(10+r.Intn(50)) * time.Second
+		}
+		if i > 0 {
+			for j := r.Intn(4); j > 0; j-- {
+				s.inputs = append(s.inputs, fmt.Sprintf("out%d", r.Intn(i)))
+			}
+		}
+		steps = append(steps, s)
+	}
+	return steps
+}
+
+// simulateBuild simulates the build of the plan with the parallelism,
+// and returns the duration of the build.
+func simulateBuild(ctx context.Context, p *plan, durations map[string]time.Duration, parallelism int) time.Duration {
+	var now time.Duration
+	var running runningSteps
+	closed := false
+	for !closed || running.Len() > 0 {
+	dispatch:
+		for !closed && running.Len() < parallelism {
+			select {
+			case step, ok := <-p.q:
+				if !ok {
+					closed = true
+					continue
+				}
+				p.pushReady()
+				heap.Push(&running, runningStep{
+					step: step,
+					end:  now + durations[step.def.Outputs(ctx)[0]],
+				})
+			default:
+				break dispatch
+			}
+		}
+		if running.Len() == 0 {
+			break
+		}
+		r := heap.Pop(&running).(runningStep)
+		now = r.end
+		p.done(ctx, r.step)
+	}
+	return now
+}
+
+type runningStep struct {
+	step *Step
+	end  time.Duration
+}
+
+// runningSteps is a priority queue of running steps ordered by end time.
+type runningSteps []runningStep
+
+func (r runningSteps) Len() int           { return len(r) }
+func (r runningSteps) Less(i, j int) bool { return r[i].end < r[j].end }
+func (r runningSteps) Swap(i, j int)      { r[i], r[j] = r[j], r[i] }
+func (r *runningSteps) Push(x any)        { *r = append(*r, x.(runningStep)) }
+func (r *runningSteps) Pop() any {
+	old := *r
+	n := len(old)
+	x := old[n-1]
+	*r = old[:n-1]
+	return x
+}
+
+func BenchmarkPlan(b *testing.B) {
+	ctx := context.Background()
+	steps := syntheticGraph(20000)
+	durations := make(map[string]time.Duration)
+	for _, s := range steps {
+		durations[s.output] = s.duration
+	}
+	for _, bc := range []struct {
+		name      string
+		durations map[string]time.Duration
+	}{
+		{
+			name: "fifo",
+		},
+		{
+			name:      "critical_path",
+			durations: durations,
+		},
+	} {
+		b.Run(bc.name, func(b *testing.B) {
+			var makespan time.Duration
+			for i := 0; i < b.N; i++ {
+				sched := newTestScheduler(ctx, steps, bc.durations)
+				makespan = simulateBuild(ctx, sched.plan, durations, 64)
+			}
+			b.ReportMetric(makespan.Seconds(), "build-sec")
+		})
+	}
+}
@@ -12,15 +12,20 @@ func (b *Builder) runStrategy(ctx context.Context, step *Step) func(context.Cont
 	// Criteria for remote executable:
 	// - Allow remote if available and command has platform container-image property.
 	// - Allow reproxy if available and command has reproxy config set.
+	// - Allow remote cache if the reapi server provides cache only
+	//   and command has platform container-image property.
 	// If the command doesn't meet either criteria, fallback to local.
 	// Any further validation should be done in the exec handler, not here.
 	allowRemote := b.remoteExec != nil && len(step.cmd.Platform) > 0 && step.cmd.Platform["container-image"] != ""
 	allowREProxy := b.reproxyExec.Enabled() && step.cmd.REProxyConfig != nil
+	allowRemoteCache := b.reCacheWriteLocal && len(step.cmd.Platform) > 0 && step.cmd.Platform["container-image"] != ""
 	switch {
 	case step.cmd.Pure && allowREProxy:
 		return b.runReproxy
 	case step.cmd.Pure && allowRemote:
 		return b.runRemote
+	case step.cmd.Pure && allowRemoteCache:
+		return b.runLocalCache
 	default:
 		return b.runLocal
 	}
@@ -0,0 +1,116 @@
+// Copyright 2024 The Chromium Authors
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package build
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	rpb "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+
+	"infra/build/siso/execute"
+	"infra/build/siso/o11y/clog"
+	"infra/build/siso/o11y/trace"
+	"infra/build/siso/reapi/digest"
+)
+
+// runLocalCache runs step locally with using remote cache, for the
+// reapi server that provides cache but not remote execution
+// (e.g. `siso cache serve`).
+//
+//  1. Check remote cache if enabled.
+//  2. If cache miss, run locally.
+//  3. Upload outputs and action result to remote cache.
+func (b *Builder) runLocalCache(ctx context.Context, step *Step) error {
+	step.setPhase(stepPreproc)
+	err := b.preprocSema.Do(ctx, func(ctx context.Context) error {
+		ctx, span := trace.NewSpan(ctx, "preproc")
+		defer span.Close(nil)
+		return depsCmd(ctx, b, step)
+	})
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return err
+		}
+		// can't compute the action digest without full inputs.
+		clog.Warningf(ctx, "disable remote cache: failed to get %s deps: %v", step.cmd.Deps, err)
+		return b.runLocal(ctx, step)
+	}
+	dedupInputs(ctx, step.cmd)
+	if b.cache != nil && b.reCacheEnableRead {
+		err := b.execRemoteCache(ctx, step)
+		if err == nil {
+			return nil
+		}
+		clog.Infof(ctx, "cmd cache miss: %v", err)
+	}
+	// compute the action digest before local run, as
+	// execLocal may update inputs.
+	ds := digest.NewStore()
+	d, err := step.cmd.Digest(ctx, ds)
+	if err != nil {
+		clog.Warningf(ctx, "disable remote cache: failed to get action digest: %v", err)
+		return b.execLocal(ctx, step)
+	}
+	err = b.execLocal(ctx, step)
+	if err != nil {
+		return err
+	}
+	err = b.updateRemoteCache(ctx, step, d, ds)
+	if err != nil {
+		// the step succeeded, so just warn.
+		clog.Warningf(ctx, "failed to update remote cache %s: %v", d, err)
+	}
+	return nil
+}
+
+// updateRemoteCache uploads outputs of the locally executed step and
+// its action result for action digest d.
+// ds should contain the action, the command and the input tree of d.
+func (b *Builder) updateRemoteCache(ctx context.Context, step *Step, d digest.Digest, ds *digest.Store) error {
+	ctx, span := trace.NewSpan(ctx, "update-remote-cache")
+	defer span.Close(nil)
+	localResult, _ := step.cmd.ActionResult()
+	if localResult.GetExitCode() != 0 {
+		return nil
+	}
+	entries, err := b.hashFS.Entries(ctx, step.cmd.ExecRoot, step.cmd.AllOutputs())
+	if err != nil {
+		return err
+	}
+	for i := range entries {
+		// output paths in action result are relative to
+		// the working directory.
+		rel, err := filepath.Rel(step.cmd.Dir, entries[i].Name)
+		if err != nil {
+			return err
+		}
+		entries[i].Name = filepath.ToSlash(rel)
+		if !entries[i].Data.IsZero() {
+			ds.Set(entries[i].Data)
+		}
+	}
+	result := &rpb.ActionResult{
+		ExecutionMetadata: localResult.GetExecutionMetadata(),
+	}
+	execute.ResultFromEntries(result, entries)
+	if stdout := step.cmd.Stdout(); len(stdout) > 0 {
+		data := digest.FromBytes("stdout", stdout)
+		ds.Set(data)
+		result.StdoutDigest = data.Digest().Proto()
+	}
+	if stderr := step.cmd.Stderr(); len(stderr) > 0 {
+		data := digest.FromBytes("stderr", stderr)
+		ds.Set(data)
+		result.StderrDigest = data.Digest().Proto()
+	}
+	_, err = b.reapiclient.UploadAll(ctx, ds)
+	if err != nil {
+		return fmt.Errorf("failed to upload: %w", err)
+	}
+	return b.reapiclient.UpdateActionResult(ctx, d, result)
+}
@@ -87,6 +87,7 @@ func (b *Builder) runStep(ctx context.Context, step *Step) (err error) {
 			b.recordNinjaLogs(ctx, step)
 			b.stats.update(ctx, &step.metrics, step.cmd.Pure)
 			b.finalizeTrace(ctx, tc)
+			b.recordStatus(step, err)
 			b.outputFailureSummary(ctx, step, err)
 			b.outputFailedCommands(ctx, step, err)
 		}
@@ -376,6 +377,16 @@ func (b *Builder) outputFailureSummary(ctx context.Context, step *Step, err erro
 	stat := b.stats.stats()
 	var buf bytes.Buffer
 	fmt.Fprintf(&buf, "[%d/%d] %s\n", stat.Done-stat.Skipped, stat.Total-stat.Skipped, step.cmd.Desc)
+	buf.Write(failureSummary(step, err))
+	_, err = b.failureSummaryWriter.Write(buf.Bytes())
+	if err != nil {
+		clog.Warningf(ctx, "failed to write failure_summary: %v", err)
+	}
+}
+
+// failureSummary returns the command line, outputs and error of the failed step.
+func failureSummary(step *Step, err error) []byte {
+	var buf bytes.Buffer
 	fmt.Fprintf(&buf, "%s\n", strings.Join(step.cmd.Args, " "))
 	stderr := step.cmd.Stderr()
 	stdout := step.cmd.Stdout()
@@ -392,10 +403,7 @@ func (b *Builder) outputFailureSummary(ctx context.Context, step *Step, err erro
 		fmt.Fprint(&buf, ui.StripANSIEscapeCodes(string(stdout)))
 	}
 	fmt.Fprintf(&buf, "%v\n", err)
-	_, err = b.failureSummaryWriter.Write(buf.Bytes())
-	if err != nil {
-		clog.Warningf(ctx, "failed to write failure_summary: %v", err)
-	}
+	return buf.Bytes()
 }
 
 func (b *Builder) outputFailedCommands(ctx context.Context, step *Step, err error) {
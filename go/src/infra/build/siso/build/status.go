@@ -0,0 +1,152 @@
+// Copyright 2024 The Chromium Authors
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package build
+
+import (
+	"sync"
+	"time"
+
+	"infra/build/siso/sync/semaphore"
+)
+
+const (
+	// maxStatusSteps is the number of recently finished steps
+	// kept for the status.
+	maxStatusSteps = 500
+	// maxStatusFailures is the number of recent failures
+	// kept for the status.
+	maxStatusFailures = 20
+)
+
+// Status is a snapshot of the build status.
+type Status struct {
+	Start       time.Time
+	Elapsed     time.Duration
+	Stats       Stats
+	ActiveSteps []ActiveStepInfo
+	// RecentSteps are recently finished steps, in finished order.
+	RecentSteps []StepRecord
+	// Failures are recent failed steps, in finished order.
+	Failures   []StepFailure
+	Semaphores []SemaphoreStatus
+}
+
+// StepRecord is a record of the finished step.
+type StepRecord struct {
+	ID   string
+	Desc string
+	// Start and End are durations since the build started.
+	Start  time.Duration
+	End    time.Duration
+	Cached bool
+	Remote bool
+	Local  bool
+	Err    bool
+}
+
+// StepFailure is an output of the failed step.
+type StepFailure struct {
+	ID     string
+	Desc   string
+	Output string
+}
+
+// SemaphoreStatus is a current usage of the semaphore.
+type SemaphoreStatus struct {
+	Name     string
+	Capacity int
+	Servs    int
+	Waits    int
+}
+
+// buildStatus keeps recent steps for Status.
+type buildStatus struct {
+	mu       sync.Mutex
+	steps    []StepRecord
+	next     int
+	failures []StepFailure
+	semas    []*semaphore.Semaphore
+}
+
+func (s *buildStatus) setSemaphores(semas []*semaphore.Semaphore) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.semas = semas
+}
+
+func (s *buildStatus) add(r StepRecord) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.steps) < maxStatusSteps {
+		s.steps = append(s.steps, r)
+		return
+	}
+	s.steps[s.next] = r
+	s.next = (s.next + 1) % maxStatusSteps
+}
+
+func (s *buildStatus) addFailure(f StepFailure) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures = append(s.failures, f)
+	if len(s.failures) > maxStatusFailures {
+		s.failures = s.failures[len(s.failures)-maxStatusFailures:]
+	}
+}
+
+func (s *buildStatus) get(st *Status) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	st.RecentSteps = make([]StepRecord, 0, len(s.steps))
+	st.RecentSteps = append(st.RecentSteps, s.steps[s.next:]...)
+	st.RecentSteps = append(st.RecentSteps, s.steps[:s.next]...)
+	st.Failures = append([]StepFailure(nil), s.failures...)
+	for _, sema := range s.semas {
+		if sema == nil {
+			continue
+		}
+		st.Semaphores = append(st.Semaphores, SemaphoreStatus{
+			Name:     sema.Name(),
+			Capacity: sema.Capacity(),
+			Servs:    sema.NumServs(),
+			Waits:    sema.NumWaits(),
+		})
+	}
+}
+
+// recordStatus records the finished step for Status.
+func (b *Builder) recordStatus(step *Step, err error) {
+	b.status.add(StepRecord{
+		ID:     step.String(),
+		Desc:   step.cmd.Desc,
+		Start:  step.startTime.Sub(b.start),
+		End:    step.endTime.Sub(b.start),
+		Cached: step.metrics.Cached,
+		Remote: step.metrics.IsRemote,
+		Local:  step.metrics.IsLocal,
+		Err:    err != nil,
+	})
+	if err == nil {
+		return
+	}
+	b.status.addFailure(StepFailure{
+		ID:     step.String(),
+		Desc:   step.cmd.Desc,
+		Output: string(failureSummary(step, err)),
+	})
+}
+
+// Status returns the current status of the build.
+func (b *Builder) Status() Status {
+	now := time.Now()
+	st := Status{
+		Start:       b.start,
+		Elapsed:     now.Sub(b.start),
+		Stats:       b.Stats(),
+		ActiveSteps: b.ActiveSteps(),
+	}
+	b.status.get(&st)
+	return st
+}
@@ -0,0 +1,45 @@
+// Copyright 2024 The Chromium Authors
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package build
+
+import (
+	"fmt"
+	"testing"
+
+	"infra/build/siso/sync/semaphore"
+)
+
+func TestBuildStatus(t *testing.T) {
+	var s buildStatus
+	for i := 0; i < maxStatusSteps+10; i++ {
+		s.add(StepRecord{ID: fmt.Sprint(i)})
+	}
+	for i := 0; i < maxStatusFailures+3; i++ {
+		s.addFailure(StepFailure{ID: fmt.Sprint(i)})
+	}
+	sema := semaphore.New("test", 2)
+	s.setSemaphores([]*semaphore.Semaphore{nil, sema})
+
+	var st Status
+	s.get(&st)
+	if len(st.RecentSteps) != maxStatusSteps {
+		t.Fatalf("len(RecentSteps)=%d; want %d", len(st.RecentSteps), maxStatusSteps)
+	}
+	for i, r := range st.RecentSteps {
+		if want := fmt.Sprint(i + 10); r.ID != want {
+			t.Errorf("RecentSteps[%d].ID=%q; want %q", i, r.ID, want)
+		}
+	}
+	if len(st.Failures) != maxStatusFailures {
+		t.Fatalf("len(Failures)=%d; want %d", len(st.Failures), maxStatusFailures)
+	}
+	if got, want := st.Failures[0].ID, "3"; got != want {
+		t.Errorf("Failures[0].ID=%q; want %q", got, want)
+	}
+	want := []SemaphoreStatus{{Name: "test/2", Capacity: 2}}
+	if len(st.Semaphores) != 1 || st.Semaphores[0] != want[0] {
+		t.Errorf("Semaphores=%v; want %v", st.Semaphores, want)
+	}
+}
@@ -123,6 +123,12 @@ type Step struct {
 	startTime     time.Time
 	endTime       time.Time
 
+	// weight is critical path weight of the step, i.e. estimated
+	// duration from the start of the step to the end of the build.
+	weight time.Duration
+	// readySeq is sequence number when the step became ready.
+	readySeq int64
+
 	metrics StepMetric
 
 	state *stepState
@@ -341,7 +347,7 @@ func newCmd(ctx context.Context, b *Builder, stepDef StepDef) *execute.Cmd {
 		// we don't pass environment variables.
 		RSPFile:        stepDef.Rspfile(ctx),
 		RSPFileContent: []byte(rspfileContent),
-		CmdHash:        calculateCmdHash(cmdline, rspfileContent),
+		CmdHash:        CalculateCmdHash(cmdline, rspfileContent),
 		ExecRoot:       b.path.ExecRoot, // use step binding?
 		Dir:            b.path.Dir,
 		Inputs:         stepInputs(ctx, b, stepDef),
@@ -433,7 +439,9 @@ func stepDescription(stepDef StepDef) string {
 	return stepDef.Binding("command")
 }
 
-func calculateCmdHash(cmdline, rspfileContent string) []byte {
+// CalculateCmdHash returns the command hash of the step, which is
+// recorded in hashfs state for outputs of the step.
+func CalculateCmdHash(cmdline, rspfileContent string) []byte {
 	h := sha256.New()
 	fmt.Fprint(h, cmdline)
 	if rspfileContent != "" {
@@ -655,7 +655,9 @@ func (s *traceStats) get() []*TraceStat {
 	var ret []*TraceStat
 	s.mu.Lock()
 	for _, ts := range s.s {
-		ret = append(ret, ts)
+		// copy, as it may be updated while the build is running.
+		t := *ts
+		ret = append(ret, &t)
 	}
 	s.mu.Unlock()
 	sort.Slice(ret, func(i, j int) bool {
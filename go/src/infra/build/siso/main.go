@@ -26,6 +26,8 @@ import (
 	"infra/build/siso/auth/cred"
 	"infra/build/siso/hashfs/osfs"
 	"infra/build/siso/subcmd/authcheck"
+	"infra/build/siso/subcmd/cachecmd"
+	"infra/build/siso/subcmd/explain"
 	"infra/build/siso/subcmd/fetch"
 	"infra/build/siso/subcmd/fscmd"
 	"infra/build/siso/subcmd/help"
@@ -66,7 +68,9 @@ func getApplication(authOpts cred.Options) *cli.Application {
 			report.Cmd(),
 			fetch.Cmd(authOpts),
 			metricscmd.Cmd(),
+			cachecmd.Cmd(),
 			ps.Cmd(),
+			explain.Cmd(),
 			scandeps.Cmd(),
 			authcheck.Cmd(authOpts),
 
@@ -19,6 +19,7 @@ import (
 	rpb "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
 	"golang.org/x/sync/singleflight"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/keepalive"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/proto"
@@ -49,7 +50,7 @@ func (o *Option) RegisterFlags(fs *flag.FlagSet, envs map[string]string) {
 	if addr == "" {
 		addr = "remotebuildexecution.googleapis.com:443"
 	}
-	fs.StringVar(&o.Address, "reapi_address", addr, "reapi address")
+	fs.StringVar(&o.Address, "reapi_address", addr, `reapi address. "unix:///path/to/socket" for "siso cache serve"`)
 	instance := envs["SISO_REAPI_INSTANCE"]
 	if instance == "" {
 		instance = "default_instance"
@@ -72,13 +73,20 @@ func (o *Option) UpdateProjectID(projID string) string {
 	if projID == "" && strings.HasPrefix(o.Instance, "projects/") {
 		projID = strings.Split(o.Instance, "/")[1]
 	}
-	if projID == "" && !strings.HasPrefix(o.Instance, "projects/") {
+	if projID == "" && !strings.HasPrefix(o.Instance, "projects/") && !o.IsUnix() {
 		// make Option invalid.
 		o.Instance = ""
 	}
 	return projID
 }
 
+// IsUnix returns whether the address is a unix domain socket,
+// e.g. "unix:///path/to/socket" served by `siso cache serve`.
+// It doesn't need cloud project nor credentials.
+func (o Option) IsUnix() bool {
+	return strings.HasPrefix(o.Address, "unix:")
+}
+
 // IsValid returns whether option is valid or not.
 func (o Option) IsValid() bool {
 	return o.Address != "" && o.Instance != ""
@@ -193,7 +201,14 @@ func New(ctx context.Context, cred cred.Cred, opt Option) (*Client, error) {
 	}
 	clog.Infof(ctx, "address: %q instance: %q", opt.Address, opt.Instance)
 
-	dopts := append(cred.GRPCDialOptions(), dialOptions(opt.KeepAliveParams)...)
+	var dopts []grpc.DialOption
+	if opt.IsUnix() {
+		// local server on the same machine. no need to secure.
+		dopts = append(dopts, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	} else {
+		dopts = append(dopts, cred.GRPCDialOptions()...)
+	}
+	dopts = append(dopts, dialOptions(opt.KeepAliveParams)...)
 	conn, err := grpc.DialContext(ctx, opt.Address, dopts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to dial %s: %w", opt.Address, err)
@@ -262,6 +277,33 @@ func (c *Client) GetActionResult(ctx context.Context, d digest.Digest) (*rpb.Act
 	return result, err
 }
 
+// UpdateActionResult updates the action result of the digest.
+// The action, its command and the outputs must be uploaded to CAS before.
+func (c *Client) UpdateActionResult(ctx context.Context, d digest.Digest, result *rpb.ActionResult) error {
+	client := rpb.NewActionCacheClient(c.conn)
+	_, err := client.UpdateActionResult(ctx, &rpb.UpdateActionResultRequest{
+		InstanceName: c.opt.Instance,
+		ActionDigest: d.Proto(),
+		ActionResult: result,
+	})
+	c.m.OpsDone(err)
+	return err
+}
+
+// ExecEnabled reports whether the server supports remote execution.
+// When false, the server only provides remote cache.
+func (c *Client) ExecEnabled() bool {
+	return c.capabilities.GetExecutionCapabilities().GetExecEnabled()
+}
+
+// CacheOnly reports whether the client connects to a local cache server
+// that doesn't support remote execution, e.g. `siso cache serve`.
+// Remote servers are never cache only, even if they don't report
+// exec capability.
+func (c *Client) CacheOnly() bool {
+	return c.opt.IsUnix() && !c.ExecEnabled()
+}
+
 // NewContext returns new context with request metadata.
 func NewContext(ctx context.Context, rmd *rpb.RequestMetadata) context.Context {
 	ver, err := version.GetStartupVersion()
@@ -17,6 +17,9 @@ import (
 )
 
 // CPPScan scans C preprocessor directives for #include/#define in buf.
+// It also scans C++20 module declarations and import declarations.
+// Header unit imports are returned as includes, and named module
+// imports are returned as includes of `@name` (see isModuleImport).
 func CPPScan(ctx context.Context, fname string, buf []byte) ([]string, map[string][]string, error) {
 	ctx, span := trace.NewSpan(ctx, "cppScan")
 	defer span.Close(nil)
@@ -25,6 +28,8 @@ func CPPScan(ctx context.Context, fname string, buf []byte) ([]string, map[strin
 
 	var includes []string
 	defines := make(map[string][]string)
+	// module name of the module unit, to resolve partition imports.
+	var module string
 	for len(buf) > 0 {
 		// start of line
 		buf = bytes.TrimSpace(buf)
@@ -42,6 +47,17 @@ func CPPScan(ctx context.Context, fname string, buf []byte) ([]string, map[strin
 		}
 		lineStart := line
 		if line[0] != '#' {
+			decl, imp := cppModuleLine(line, module)
+			if decl != "" {
+				module = decl
+			}
+			if imp != "" {
+				if log.V(1) {
+					clog.Infof(ctx, "import %q", imp)
+				}
+				includes = append(includes, imp)
+				continue
+			}
 			// not directive line
 			if log.V(3) {
 				logLine := line
@@ -279,8 +295,116 @@ func isMacro(s string) bool {
 		return false
 	}
 	switch s[0] {
-	case '<', '"':
+	case '<', '"', '@':
 		return false
 	}
 	return true
 }
+
+// isModuleImport reports whether s is an import of a named module,
+// i.e. `@name` or `@name:partition`.
+func isModuleImport(s string) bool {
+	return strings.HasPrefix(s, "@")
+}
+
+// cppModuleLine parses C++20 module declaration or import declaration
+// in line. module is the module name declared earlier in the file.
+//
+// It returns the module name (without partition) in decl for
+//
+//	module foo;
+//	export module foo;
+//	module foo:part;
+//	export module foo:part;
+//
+// and returns imp for
+//
+//	import foo;        -> `@foo`
+//	import :part;      -> `@<module>:part`
+//	import <foo.h>;    -> `<foo.h>`
+//	import "foo.h";    -> `"foo.h"`
+//	module foo;        -> `@foo` (implementation unit implicitly imports foo)
+//
+// with optional `export` for import declaration.
+// Global module fragment `module;` and `module :private;` are ignored.
+func cppModuleLine(line []byte, module string) (decl, imp string) {
+	exported := false
+	if rest, ok := cutKeyword(line, "export"); ok {
+		exported = true
+		line = rest
+	}
+	if rest, ok := cutKeyword(line, "module"); ok {
+		name, ok := moduleName(rest)
+		if !ok || name == "" || name[0] == ':' {
+			return "", ""
+		}
+		decl, partition, _ := strings.Cut(name, ":")
+		if !exported && partition == "" {
+			imp = "@" + decl
+		}
+		return decl, imp
+	}
+	rest, ok := cutKeyword(line, "import")
+	if !ok || len(rest) == 0 {
+		return "", ""
+	}
+	switch rest[0] {
+	case '<', '"':
+		delim := rest[0]
+		if delim == '<' {
+			delim = '>'
+		}
+		i := bytes.IndexByte(rest[1:], delim)
+		if i < 0 {
+			return "", ""
+		}
+		return "", strings.Clone(string(rest[:i+2]))
+	}
+	name, ok := moduleName(rest)
+	if !ok || name == "" {
+		return "", ""
+	}
+	if name[0] == ':' {
+		if module == "" {
+			// partition import outside of module unit?
+			return "", ""
+		}
+		name = module + name
+	}
+	return "", "@" + name
+}
+
+// cutKeyword cuts keyword followed by space or punctuator from
+// the beginning of line, and returns the rest of line with leading
+// spaces trimmed.
+func cutKeyword(line []byte, keyword string) ([]byte, bool) {
+	rest, ok := bytes.CutPrefix(line, []byte(keyword))
+	if !ok || len(rest) == 0 {
+		return nil, false
+	}
+	switch rest[0] {
+	case ' ', '\t', ';', ':', '<', '"':
+	default:
+		return nil, false
+	}
+	return bytes.TrimLeft(rest, " \t"), true
+}
+
+// moduleName returns module name (optionally with partition) at the
+// beginning of buf, which should be terminated by `;` or attributes.
+func moduleName(buf []byte) (string, bool) {
+	i := 0
+	for ; i < len(buf); i++ {
+		c := buf[i]
+		if c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_' || c == '.' || c == ':' {
+			continue
+		}
+		break
+	}
+	name := buf[:i]
+	rest := bytes.TrimLeft(buf[i:], " \t")
+	if len(rest) == 0 || (rest[0] != ';' && rest[0] != '[') {
+		return "", false
+	}
+	return strings.Clone(string(name)), true
+}
@@ -116,6 +116,46 @@ namespace base {
 			},
 			wantDefines: map[string][]string{},
 		},
+		{
+			name: "cxx20-module-interface",
+			buf: `
+module;
+#include <stdio.h>
+export module apps:util;
+import base;
+export import base.strings;
+import :impl;
+import <vector>;
+import "apps/config.h";
+import std [[deprecated]];
+module :private;
+// import comment;
+import_fn();
+int import = 0;
+`,
+			wantIncludes: []string{
+				"<stdio.h>",
+				"@base",
+				"@base.strings",
+				"@apps:impl",
+				"<vector>",
+				`"apps/config.h"`,
+				"@std",
+			},
+			wantDefines: map[string][]string{},
+		},
+		{
+			name: "cxx20-module-implementation",
+			buf: `
+module apps;
+import :impl;
+`,
+			wantIncludes: []string{
+				"@apps",
+				"@apps:impl",
+			},
+			wantDefines: map[string][]string{},
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			gotIncludes, gotDefines, err := CPPScan(ctx, tc.name, []byte(tc.buf))
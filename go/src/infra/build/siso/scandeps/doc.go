@@ -28,6 +28,23 @@
 // It doesn't allow comments nor multiline (\ at the end of line)
 // for the directives.
 //
+// It also checks the following forms of C++20 module declarations
+// and import declarations (with optional `export`)
+//
+//	module foo;
+//	export module foo;
+//	import foo;
+//	import :part;
+//	import <foo.h>;
+//	import "foo.h";
+//
+// Header unit imports are handled as #include. Named modules are
+// resolved to BMI (built module interface) in -fprebuilt-module-path,
+// and the BMI is added to inputs without scanning it.
+// All BMIs given by -fmodule-file=<name>=<path> are added to inputs,
+// as the compiler may also read BMIs of modules imported by the
+// imported modules.
+//
 // Also it uses input_deps's label for sysroots etc.
 // if include dir or sysroot dir has label with `:headers`,
 // it adds files of the input_deps instead of scanning files
@@ -170,6 +170,20 @@ func (fv *fsview) get(ctx context.Context, dir, name string) (string, *scanResul
 	return incpath, sr, err
 }
 
+// addFile adds fname to the results without scanning it,
+// if it exists as a regular file.
+func (fv *fsview) addFile(ctx context.Context, fname string) error {
+	fi, err := fv.fs.hashfs.Stat(ctx, fv.execRoot, fname)
+	if err != nil {
+		return err
+	}
+	if !fi.Mode().IsRegular() {
+		return fs.ErrInvalid
+	}
+	fv.visited[fv.fs.pathIntern(fname)] = true
+	return nil
+}
+
 func (fv *fsview) scanFile(ctx context.Context, fname string) (*scanResult, error) {
 	sr, err := fv.scanResult(ctx, fname)
 	if err != nil {
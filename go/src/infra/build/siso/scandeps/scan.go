@@ -63,6 +63,15 @@ type scanner struct {
 	// hmap data: incpath -> filenames.
 	hmaps map[string][]string
 
+	// module name -> BMI path. i.e. -fmodule-file=<name>=<path>
+	moduleFiles map[string]string
+
+	// prebuilt module paths. i.e. -fprebuilt-module-path=<dir>
+	moduleDirs []string
+
+	// module name -> imported
+	imported map[string]bool
+
 	// allocation
 	ds    []string
 	names []string
@@ -96,6 +105,7 @@ func (fsys *filesystem) scanner(ctx context.Context, execRoot string, inputDeps
 		macroDirs:    make(map[string][]string),
 		nameDirs:     make(map[string]int),
 		hmaps:        make(map[string][]string),
+		imported:     make(map[string]bool),
 	}
 	for _, dir := range precomputedTrees {
 		s.fsview.addDir(ctx, dir, noSearchPath)
@@ -170,6 +180,32 @@ func (s *scanner) updateMacros(ctx context.Context, macros map[string][]string)
 	}
 }
 
+func (s *scanner) setModules(ctx context.Context, moduleFiles map[string]string, moduleDirs []string) {
+	s.moduleFiles = moduleFiles
+	s.moduleDirs = moduleDirs
+	if log.V(1) {
+		clog.Infof(ctx, "modules %q dirs %q", moduleFiles, moduleDirs)
+	}
+}
+
+// addModuleFiles adds all BMIs given by -fmodule-file to the inputs.
+// The compiler may read BMIs of modules imported by the imported modules,
+// which are not visible in the sources, so the BMIs are added even if
+// no source imports them.
+func (s *scanner) addModuleFiles(ctx context.Context) {
+	for name, bmi := range s.moduleFiles {
+		s.imported[name] = true
+		err := s.fsview.addFile(ctx, bmi)
+		if err != nil {
+			clog.Warningf(ctx, "module %s: %v", name, err)
+			continue
+		}
+		if log.V(1) {
+			clog.Infof(ctx, "module file %s=%s", name, bmi)
+		}
+	}
+}
+
 func (s *scanner) addInclude(ctx context.Context, fname string) {
 	// -include or /FI is equivalent with `#include "filename"`
 	s.pushInputs(`"` + fname + `"`)
@@ -233,6 +269,9 @@ func (s *scanner) find(ctx context.Context, name string) (string, error) {
 	if name == "" {
 		return "", io.EOF
 	}
+	if isModuleImport(name) {
+		return s.findModule(ctx, name[1:])
+	}
 	form := name[0] // '"' or '<'
 	name = name[1 : len(name)-1]
 	included, ok := s.included[name]
@@ -356,6 +395,43 @@ func (s *scanner) find(ctx context.Context, name string) (string, error) {
 	return "", fs.ErrNotExist
 }
 
+// findModule finds BMI (built module interface) of the named module
+// from -fmodule-file or prebuilt module paths.
+// BMIs given by -fmodule-file are already added by addModuleFiles.
+// BMI is not scanned, as it is an output of other step, that should
+// have been built from the module interface unit and its dependencies.
+func (s *scanner) findModule(ctx context.Context, name string) (string, error) {
+	if s.imported[name] {
+		return "", nil
+	}
+	s.imported[name] = true
+	if bmi, ok := s.moduleFiles[name]; ok {
+		err := s.fsview.addFile(ctx, bmi)
+		if err != nil {
+			return "", fmt.Errorf("module %s: %w", name, err)
+		}
+		return bmi, nil
+	}
+	// clang uses "<name>.pcm" in prebuilt module path,
+	// and "<name>-<partition>.pcm" for module partition.
+	fname := strings.ReplaceAll(name, ":", "-") + ".pcm"
+	for _, dir := range s.moduleDirs {
+		bmi := path.Join(dir, fname)
+		if log.V(1) {
+			clog.Infof(ctx, "find module check %s", bmi)
+		}
+		err := s.fsview.addFile(ctx, bmi)
+		if err != nil {
+			continue
+		}
+		return bmi, nil
+	}
+	if log.V(1) {
+		clog.Infof(ctx, "find module %s %v", name, fs.ErrNotExist)
+	}
+	return "", fs.ErrNotExist
+}
+
 func (s *scanner) macroCheck(ctx context.Context, dir, name, incpath string, incnames []string) {
 	for _, iname := range incnames {
 		if isMacro(iname) && !s.macroAllUsed(ctx, iname) {
@@ -62,6 +62,14 @@ type Request struct {
 	// It also includes toolchain root directory.
 	Sysroots []string
 
+	// ModuleFiles are C++20 module name to BMI (built module interface)
+	// path mapping (i.e. -fmodule-file=<name>=<path>).
+	ModuleFiles map[string]string
+
+	// ModuleDirs are prebuilt module paths to find BMI for
+	// C++20 modules (i.e. -fprebuilt-module-path).
+	ModuleDirs []string
+
 	// To mitigate scanning that does not terminate.
 	Timeout time.Duration
 }
@@ -81,6 +89,8 @@ func (s *ScanDeps) Scan(ctx context.Context, execRoot string, req Request) ([]st
 
 	scanner := s.fs.scanner(ctx, execRoot, s.inputDeps, precomputedTrees)
 	scanner.setMacros(ctx, req.Defines)
+	scanner.setModules(ctx, req.ModuleFiles, req.ModuleDirs)
+	scanner.addModuleFiles(ctx)
 
 	for _, s := range req.Includes {
 		scanner.addInclude(ctx, s)
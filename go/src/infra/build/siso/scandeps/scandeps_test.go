@@ -425,3 +425,85 @@ func TestScanDeps_AbsPath(t *testing.T) {
 		t.Errorf("scandeps diff -want +got:\n%s", diff)
 	}
 }
+
+func TestScanDeps_CXX20Modules(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	for fname, content := range map[string]string{
+		"apps/apps.cppm": `
+module;
+#include "apps/config.h"
+export module apps;
+import base;
+export import base.strings;
+import :util;
+import <vector>;
+import missing;
+`,
+		"apps/config.h": `
+`,
+		"include/vector": `
+`,
+		"obj/base/base.pcm":         "",
+		"obj/base/base-strings.pcm": "",
+		"obj/base/base-memory.pcm":  "",
+		"obj/modules/apps-util.pcm": "",
+		"obj/modules/unused.pcm":    "",
+	} {
+		fname := filepath.Join(dir, fname)
+		err := os.MkdirAll(filepath.Dir(fname), 0755)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = os.WriteFile(fname, []byte(content), 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	inputDeps := map[string][]string{}
+
+	hashFS, err := hashfs.New(ctx, hashfs.Option{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	scanDeps := New(hashFS, inputDeps)
+
+	req := Request{
+		Sources: []string{
+			"apps/apps.cppm",
+		},
+		Dirs: []string{
+			"",
+			"include",
+		},
+		ModuleFiles: map[string]string{
+			"base":         "obj/base/base.pcm",
+			"base.strings": "obj/base/base-strings.pcm",
+			// imported by base.
+			"base.memory": "obj/base/base-memory.pcm",
+		},
+		ModuleDirs: []string{
+			"obj/modules",
+		},
+	}
+	got, err := scanDeps.Scan(ctx, dir, req)
+	if err != nil {
+		t.Errorf("scandeps()=%v, %v; want nil err", got, err)
+	}
+
+	want := []string{
+		"apps",
+		"apps/apps.cppm",
+		"apps/config.h",
+		"include",
+		"include/vector",
+		"obj/base/base-memory.pcm",
+		"obj/base/base-strings.pcm",
+		"obj/base/base.pcm",
+		"obj/modules/apps-util.pcm",
+	}
+	if diff := cmp.Diff(want, got, cmpopts.SortSlices(func(a, b string) bool { return a < b })); diff != "" {
+		t.Errorf("scandeps diff -want +got:\n%s", diff)
+	}
+}
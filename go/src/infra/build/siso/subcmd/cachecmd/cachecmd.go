@@ -0,0 +1,82 @@
+// Copyright 2024 The Chromium Authors
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+// Package cachecmd provides cache subcommand.
+package cachecmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	log "github.com/golang/glog"
+	"github.com/maruel/subcommands"
+)
+
+// Cmd returns the Command for the `cache` subcommand provided by this package.
+func Cmd() *subcommands.Command {
+	return &subcommands.Command{
+		UsageLine: "cache <subcommand>",
+		ShortDesc: "manage siso local cache",
+		LongDesc:  "manage siso local cache in --cache_dir.",
+		Advanced:  true,
+		CommandRun: func() subcommands.CommandRun {
+			c := &cacheRun{
+				app: &subcommands.DefaultApplication{
+					Name:  "siso cache",
+					Title: "tools to manage siso local cache",
+					Commands: []*subcommands.Command{
+						cmdGC(),
+						cmdServe(),
+						cmdStats(),
+						subcommands.CmdHelp,
+					},
+				},
+			}
+			c.Flags.Usage = func() {
+				// TODO: handle -advanced?
+				subcommands.Usage(os.Stderr, c.app, true)
+			}
+			return c
+		},
+	}
+}
+
+type cacheRun struct {
+	subcommands.CommandRunBase
+	app *subcommands.DefaultApplication
+}
+
+func (c *cacheRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
+	return subcommands.Run(c.app, args)
+}
+
+// defaultCacheDir returns the default cache dir, same as `siso ninja`.
+func defaultCacheDir() string {
+	d, err := os.UserCacheDir()
+	if err != nil {
+		log.Warningf("Failed to get user cache dir: %v", err)
+		return ""
+	}
+	return filepath.Join(d, "siso")
+}
+
+var bytesUnits = []struct {
+	n    int64
+	unit string
+}{
+	{1 << 40, "TiB"},
+	{1 << 30, "GiB"},
+	{1 << 20, "MiB"},
+	{1 << 10, "KiB"},
+}
+
+func formatBytes(n int64) string {
+	for _, u := range bytesUnits {
+		if n >= u.n {
+			return fmt.Sprintf("%.02f%s", float64(n)/float64(u.n), u.unit)
+		}
+	}
+	return fmt.Sprintf("%dB", n)
+}
@@ -0,0 +1,88 @@
+// Copyright 2024 The Chromium Authors
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package cachecmd
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/maruel/subcommands"
+
+	"go.chromium.org/luci/common/cli"
+
+	"infra/build/siso/build"
+)
+
+const gcUsage = `evict least recently used entries from local cache
+
+ $ siso cache gc [-cache_dir <dir>] -max_size <size>
+
+evicts least recently used entries in <dir> until
+the cache becomes smaller than <size> (e.g. "50GiB").
+`
+
+// cmdGC returns the Command for the `gc` subcommand provided by this package.
+func cmdGC() *subcommands.Command {
+	return &subcommands.Command{
+		UsageLine: "gc [-cache_dir <dir>] -max_size <size>",
+		ShortDesc: "evict least recently used entries from local cache",
+		LongDesc:  gcUsage,
+		CommandRun: func() subcommands.CommandRun {
+			c := &gcRun{}
+			c.init()
+			return c
+		},
+	}
+}
+
+type gcRun struct {
+	subcommands.CommandRunBase
+
+	cacheDir string
+	maxSize  string
+}
+
+func (c *gcRun) init() {
+	c.Flags.StringVar(&c.cacheDir, "cache_dir", defaultCacheDir(), "cache directory")
+	c.Flags.StringVar(&c.maxSize, "max_size", "", `max size of the cache (e.g. "50GiB")`)
+}
+
+func (c *gcRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
+	ctx := cli.GetContext(a, c, env)
+	err := c.run(ctx)
+	if err != nil {
+		switch {
+		case errors.Is(err, flag.ErrHelp):
+			fmt.Fprintf(os.Stderr, "%v\n%s\n", err, gcUsage)
+		default:
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		}
+		return 1
+	}
+	return 0
+}
+
+func (c *gcRun) run(ctx context.Context) error {
+	if c.maxSize == "" {
+		return fmt.Errorf("-max_size is required: %w", flag.ErrHelp)
+	}
+	maxSize, err := build.ParseByteSize(c.maxSize)
+	if err != nil {
+		return fmt.Errorf("bad -max_size: %w", err)
+	}
+	cache, err := build.NewLocalCache(c.cacheDir, maxSize)
+	if err != nil {
+		return err
+	}
+	usage, err := cache.GC(ctx, maxSize)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("evicted %d files (%s). %d files (%s) in %s\n", usage.Evicted, formatBytes(usage.EvictedBytes), usage.Files, formatBytes(usage.Bytes), cache.Dir())
+	return cache.SaveStats(ctx)
+}
@@ -0,0 +1,189 @@
+// Copyright 2024 The Chromium Authors
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package cachecmd
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+
+	rpb "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	semverpb "github.com/bazelbuild/remote-apis/build/bazel/semver"
+	log "github.com/golang/glog"
+	"github.com/maruel/subcommands"
+	"google.golang.org/grpc"
+
+	"go.chromium.org/luci/common/cli"
+	"go.chromium.org/luci/common/system/signals"
+
+	"infra/build/kajiya/actioncache"
+	"infra/build/kajiya/blobstore"
+)
+
+const serveUsage = `serve shared cache over a unix socket
+
+ $ siso cache serve [-cache_dir <dir>] [-socket <path>]
+
+serves REAPI ContentAddressableStorage and ActionCache services
+over the unix socket <path> (default: <dir>/siso-cache.sock),
+storing data in <dir>/shared.
+
+Multiple checkouts on the same machine can share the cache
+by running siso ninja with
+
+ $ siso ninja -reapi_address unix://<path> ...
+
+The server doesn't support remote execution, so siso ninja
+runs steps locally and uploads their outputs and action results
+to the server for later builds.
+`
+
+// cmdServe returns the Command for the `serve` subcommand provided by this package.
+func cmdServe() *subcommands.Command {
+	return &subcommands.Command{
+		UsageLine: "serve [-cache_dir <dir>] [-socket <path>]",
+		ShortDesc: "serve shared cache over a unix socket",
+		LongDesc:  serveUsage,
+		CommandRun: func() subcommands.CommandRun {
+			c := &serveRun{}
+			c.init()
+			return c
+		},
+	}
+}
+
+type serveRun struct {
+	subcommands.CommandRunBase
+
+	cacheDir string
+	socket   string
+}
+
+func (c *serveRun) init() {
+	c.Flags.StringVar(&c.cacheDir, "cache_dir", defaultCacheDir(), "cache directory")
+	c.Flags.StringVar(&c.socket, "socket", "", "unix socket path to listen on. default: <cache_dir>/siso-cache.sock")
+}
+
+func (c *serveRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
+	ctx := cli.GetContext(a, c, env)
+	err := c.run(ctx)
+	if err != nil {
+		switch {
+		case errors.Is(err, flag.ErrHelp):
+			fmt.Fprintf(os.Stderr, "%v\n%s\n", err, serveUsage)
+		default:
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		}
+		return 1
+	}
+	return 0
+}
+
+func (c *serveRun) run(ctx context.Context) error {
+	if c.cacheDir == "" {
+		return fmt.Errorf("-cache_dir is required: %w", flag.ErrHelp)
+	}
+	socket := c.socket
+	if socket == "" {
+		socket = filepath.Join(c.cacheDir, "siso-cache.sock")
+	}
+	serv, err := newServer(filepath.Join(c.cacheDir, "shared"))
+	if err != nil {
+		return err
+	}
+	lis, err := listenUnix(socket)
+	if err != nil {
+		return err
+	}
+	defer signals.HandleInterrupt(func() {
+		log.Infof("interrupted. stopping")
+		serv.GracefulStop()
+	})()
+	fmt.Printf("serving cache at unix://%s\n", socket)
+	err = serv.Serve(lis)
+	if errors.Is(err, grpc.ErrServerStopped) {
+		return nil
+	}
+	return err
+}
+
+// listenUnix listens on the unix socket at path.
+// It removes a socket left by a previous server, if any.
+func listenUnix(path string) (net.Listener, error) {
+	err := os.MkdirAll(filepath.Dir(path), 0755)
+	if err != nil {
+		return nil, err
+	}
+	fi, err := os.Lstat(path)
+	if err == nil {
+		if fi.Mode()&os.ModeSocket == 0 {
+			return nil, fmt.Errorf("%s exists and is not a socket", path)
+		}
+		conn, err := net.Dial("unix", path)
+		if err == nil {
+			conn.Close()
+			return nil, fmt.Errorf("%s is already served by other process", path)
+		}
+		err = os.Remove(path)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return net.Listen("unix", path)
+}
+
+// newServer creates a grpc server that serves CAS and action cache stored in dir.
+func newServer(dir string) (*grpc.Server, error) {
+	serv := grpc.NewServer()
+	rpb.RegisterCapabilitiesServer(serv, capabilities{})
+
+	casDir := filepath.Join(dir, "cas")
+	cas, err := blobstore.New(casDir)
+	if err != nil {
+		return nil, err
+	}
+	err = blobstore.Register(serv, cas, filepath.Join(casDir, "tmp"))
+	if err != nil {
+		return nil, err
+	}
+	ac, err := actioncache.New(filepath.Join(dir, "ac"))
+	if err != nil {
+		return nil, err
+	}
+	err = actioncache.Register(serv, ac, cas)
+	if err != nil {
+		return nil, err
+	}
+	return serv, nil
+}
+
+// capabilities implements the REAPI Capabilities service for cache only server.
+type capabilities struct {
+	rpb.UnimplementedCapabilitiesServer
+}
+
+func (capabilities) GetCapabilities(ctx context.Context, req *rpb.GetCapabilitiesRequest) (*rpb.ServerCapabilities, error) {
+	return &rpb.ServerCapabilities{
+		CacheCapabilities: &rpb.CacheCapabilities{
+			DigestFunctions: []rpb.DigestFunction_Value{
+				rpb.DigestFunction_SHA256,
+			},
+			ActionCacheUpdateCapabilities: &rpb.ActionCacheUpdateCapabilities{
+				UpdateEnabled: true,
+			},
+			SymlinkAbsolutePathStrategy: rpb.SymlinkAbsolutePathStrategy_DISALLOWED,
+		},
+		ExecutionCapabilities: &rpb.ExecutionCapabilities{
+			DigestFunction: rpb.DigestFunction_SHA256,
+			ExecEnabled:    false,
+		},
+		LowApiVersion:  &semverpb.SemVer{Major: 2, Minor: 0},
+		HighApiVersion: &semverpb.SemVer{Major: 2, Minor: 0},
+	}, nil
+}
@@ -0,0 +1,111 @@
+// Copyright 2024 The Chromium Authors
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package cachecmd
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	rpb "github.com/bazelbuild/remote-apis/build/bazel/remote/execution/v2"
+	"google.golang.org/protobuf/proto"
+
+	"infra/build/siso/auth/cred"
+	"infra/build/siso/reapi"
+	"infra/build/siso/reapi/digest"
+)
+
+func TestServe(t *testing.T) {
+	ctx := context.Background()
+	// unix socket path should be short, so don't use t.TempDir.
+	sockDir, err := os.MkdirTemp("", "siso-cache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.RemoveAll(sockDir) })
+	socket := filepath.Join(sockDir, "sock")
+
+	serv, err := newServer(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	lis, err := listenUnix(socket)
+	if err != nil {
+		t.Fatal(err)
+	}
+	go serv.Serve(lis)
+	t.Cleanup(serv.Stop)
+
+	_, err = listenUnix(socket)
+	if err == nil {
+		t.Errorf("listenUnix(%q)=nil; want error for served socket", socket)
+	}
+
+	opt := reapi.Option{
+		Address:  "unix://" + socket,
+		Instance: "default_instance",
+	}
+	if projID := opt.UpdateProjectID(""); projID != "" || !opt.IsValid() {
+		t.Fatalf("UpdateProjectID=%q IsValid=%t; want \"\", true", projID, opt.IsValid())
+	}
+	client, err := reapi.New(ctx, cred.Cred{}, opt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+	if client.ExecEnabled() {
+		t.Errorf("ExecEnabled()=true; want false")
+	}
+	if !client.CacheOnly() {
+		t.Errorf("CacheOnly()=false; want true")
+	}
+
+	ds := digest.NewStore()
+	output := digest.FromBytes("output", []byte("output content"))
+	ds.Set(output)
+	action, err := digest.FromProtoMessage(&rpb.Action{
+		CommandDigest: output.Digest().Proto(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ds.Set(action)
+	_, err = client.UploadAll(ctx, ds)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.GetActionResult(ctx, action.Digest())
+	if err == nil {
+		t.Errorf("GetActionResult(%s)=nil; want not found", action.Digest())
+	}
+	result := &rpb.ActionResult{
+		OutputFiles: []*rpb.OutputFile{
+			{
+				Path:   "out",
+				Digest: output.Digest().Proto(),
+			},
+		},
+	}
+	err = client.UpdateActionResult(ctx, action.Digest(), result)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := client.GetActionResult(ctx, action.Digest())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(got, result) {
+		t.Errorf("GetActionResult(%s)=%s; want %s", action.Digest(), got, result)
+	}
+	b, err := client.Get(ctx, output.Digest(), "out")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != "output content" {
+		t.Errorf("Get(%s)=%q; want %q", output.Digest(), b, "output content")
+	}
+}
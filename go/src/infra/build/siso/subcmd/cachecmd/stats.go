@@ -0,0 +1,122 @@
+// Copyright 2024 The Chromium Authors
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package cachecmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"time"
+
+	"github.com/maruel/subcommands"
+
+	"go.chromium.org/luci/common/cli"
+
+	"infra/build/siso/build"
+)
+
+const statsUsage = `show stats of local cache
+
+ $ siso cache stats [-cache_dir <dir>] [-json]
+
+shows disk usage of the local cache in <dir>, and
+hit ratio etc accumulated by siso ninja builds
+that used the local cache.
+`
+
+// cmdStats returns the Command for the `stats` subcommand provided by this package.
+func cmdStats() *subcommands.Command {
+	return &subcommands.Command{
+		UsageLine: "stats [-cache_dir <dir>]",
+		ShortDesc: "show stats of local cache",
+		LongDesc:  statsUsage,
+		CommandRun: func() subcommands.CommandRun {
+			c := &statsRun{}
+			c.init()
+			return c
+		},
+	}
+}
+
+type statsRun struct {
+	subcommands.CommandRunBase
+
+	cacheDir   string
+	jsonOutput bool
+}
+
+func (c *statsRun) init() {
+	c.Flags.StringVar(&c.cacheDir, "cache_dir", defaultCacheDir(), "cache directory")
+	c.Flags.BoolVar(&c.jsonOutput, "json", false, "output in json")
+}
+
+func (c *statsRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
+	ctx := cli.GetContext(a, c, env)
+	err := c.run(ctx)
+	if err != nil {
+		switch {
+		case errors.Is(err, flag.ErrHelp):
+			fmt.Fprintf(os.Stderr, "%v\n%s\n", err, statsUsage)
+		default:
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		}
+		return 1
+	}
+	return 0
+}
+
+type cacheStats struct {
+	Dir      string                `json:"dir"`
+	Files    int                   `json:"files"`
+	Bytes    int64                 `json:"bytes"`
+	HitRatio float64               `json:"hit_ratio"`
+	Stats    build.LocalCacheStats `json:"stats"`
+}
+
+func (c *statsRun) run(ctx context.Context) error {
+	cache, err := build.NewLocalCache(c.cacheDir, 0)
+	if err != nil {
+		return err
+	}
+	usage, err := cache.Usage(ctx)
+	if err != nil {
+		return err
+	}
+	stats, err := build.LoadLocalCacheStats(cache.Dir())
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	s := cacheStats{
+		Dir:      cache.Dir(),
+		Files:    usage.Files,
+		Bytes:    usage.Bytes,
+		HitRatio: stats.HitRatio(),
+		Stats:    stats,
+	}
+	if c.jsonOutput {
+		buf, err := json.MarshalIndent(s, "", " ")
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%s\n", buf)
+		return nil
+	}
+	fmt.Printf("dir:       %s\n", s.Dir)
+	fmt.Printf("size:      %s (%d files)\n", formatBytes(s.Bytes), s.Files)
+	if stats.Since.IsZero() {
+		fmt.Printf("no stats recorded yet\n")
+		return nil
+	}
+	fmt.Printf("since:     %s\n", stats.Since.Format(time.RFC3339))
+	fmt.Printf("hit ratio: %.02f%% (hits:%d misses:%d)\n", s.HitRatio*100, stats.Hits, stats.Misses)
+	fmt.Printf("read:      %s\n", formatBytes(stats.ReadBytes))
+	fmt.Printf("write:     %s (%d writes)\n", formatBytes(stats.WriteBytes), stats.Writes)
+	fmt.Printf("evicted:   %s (%d files)\n", formatBytes(stats.EvictedBytes), stats.Evicted)
+	return nil
+}
@@ -0,0 +1,207 @@
+// Copyright 2024 The Chromium Authors
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+// Package explain is explain subcommand to explain why targets are dirty.
+package explain
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/maruel/subcommands"
+
+	"go.chromium.org/luci/common/cli"
+
+	"infra/build/siso/hashfs"
+	"infra/build/siso/toolsupport/ninjautil"
+)
+
+const explainUsage = `explain why targets are dirty
+
+ $ siso explain [-C <dir>] [-json] <targets>...
+
+explains, per output of the step that builds each target,
+which input digest, command line hash or mtime makes it dirty
+in the next build, by comparing build.ninja, the hashfs state
+(--fs_state) and the deps log (--deps_log) with local disk.
+
+It doesn't check whether inputs are dirty by their steps.
+Run it for the inputs if needed.
+`
+
+// Cmd returns the Command for the `explain` subcommand provided by this package.
+func Cmd() *subcommands.Command {
+	return &subcommands.Command{
+		UsageLine: "explain [-C <dir>] [-json] <targets>...",
+		ShortDesc: "explain why targets are dirty",
+		LongDesc:  explainUsage,
+		CommandRun: func() subcommands.CommandRun {
+			c := &run{}
+			c.init()
+			return c
+		},
+	}
+}
+
+type run struct {
+	subcommands.CommandRunBase
+
+	dir        string
+	fname      string
+	stateFile  string
+	depsLog    string
+	jsonOutput bool
+	all        bool
+}
+
+func (c *run) init() {
+	c.Flags.StringVar(&c.dir, "C", ".", "ninja running directory to find build.ninja")
+	c.Flags.StringVar(&c.fname, "f", "build.ninja", "input build filename (relative to -C)")
+	c.Flags.StringVar(&c.stateFile, "fs_state", ".siso_fs_state", "fs_state filename (relative to -C)")
+	c.Flags.StringVar(&c.depsLog, "deps_log", ".siso_deps", "deps log filename (relative to -C)")
+	c.Flags.BoolVar(&c.jsonOutput, "json", false, "output in json")
+	c.Flags.BoolVar(&c.all, "all", false, "show all inputs, not only inputs that make targets dirty")
+}
+
+func (c *run) Run(a subcommands.Application, args []string, env subcommands.Env) int {
+	ctx := cli.GetContext(a, c, env)
+	err := c.run(ctx, args)
+	if err != nil {
+		switch {
+		case errors.Is(err, flag.ErrHelp):
+			fmt.Fprintf(os.Stderr, "%v\n%s\n", err, explainUsage)
+		default:
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		}
+		return 1
+	}
+	return 0
+}
+
+func (c *run) run(ctx context.Context, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("no targets: %w", flag.ErrHelp)
+	}
+	err := os.Chdir(c.dir)
+	if err != nil {
+		return err
+	}
+	wd, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+	state := ninjautil.NewState()
+	p := ninjautil.NewManifestParser(state)
+	err = p.Load(ctx, c.fname)
+	if err != nil {
+		return err
+	}
+	st, err := hashfs.Load(ctx, c.stateFile)
+	if err != nil {
+		return fmt.Errorf("failed to load %s: %w", c.stateFile, err)
+	}
+	// don't use ninjautil.NewDepsLog for missing deps log,
+	// as it creates a new deps log file.
+	var depsLog *ninjautil.DepsLog
+	_, err = os.Stat(c.depsLog)
+	if err == nil {
+		depsLog, err = ninjautil.NewDepsLog(ctx, c.depsLog)
+		if err != nil {
+			return fmt.Errorf("failed to load %s: %w", c.depsLog, err)
+		}
+		defer depsLog.Close()
+	}
+	e := &explainer{
+		wd:      wd,
+		state:   state,
+		entries: hashfs.StateMap(st),
+		depsLog: depsLog,
+		all:     c.all,
+	}
+	nodes, err := state.Targets(args)
+	if err != nil {
+		return err
+	}
+	var exps []*explanation
+	for _, n := range nodes {
+		exp, err := e.explain(ctx, n.Path())
+		if err != nil {
+			return err
+		}
+		exps = append(exps, exp)
+	}
+	if c.jsonOutput {
+		buf, err := json.MarshalIndent(exps, "", " ")
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%s\n", buf)
+		return nil
+	}
+	for _, exp := range exps {
+		exp.print(os.Stdout)
+	}
+	return nil
+}
+
+func (exp *explanation) print(w io.Writer) {
+	status := "up-to-date"
+	if exp.Dirty {
+		status = "dirty"
+	}
+	fmt.Fprintf(w, "%s: %s\n", exp.Target, status)
+	for _, r := range exp.Reasons {
+		fmt.Fprintf(w, "  %s\n", r)
+	}
+	for _, out := range exp.Outputs {
+		fmt.Fprintf(w, "  output %s\n", out.Path)
+		switch {
+		case !out.Recorded && !out.OnDisk:
+			fmt.Fprintf(w, "    missing\n")
+			continue
+		case !out.Recorded:
+			fmt.Fprintf(w, "    not recorded in fs_state\n")
+		}
+		fmt.Fprintf(w, "    mtime:   %s\n", formatTime(out.MTime))
+		if out.Digest != "" {
+			fmt.Fprintf(w, "    digest:  %s\n", out.Digest)
+		}
+		if out.Action != "" {
+			fmt.Fprintf(w, "    action:  %s\n", out.Action)
+		}
+		fmt.Fprintf(w, "    cmdhash: %s (current %s)\n", out.CmdHash, exp.CmdHash)
+	}
+	for _, in := range exp.Inputs {
+		var flags []string
+		if in.Newer {
+			flags = append(flags, fmt.Sprintf("newer by %s", in.MTime.Sub(exp.OutputMTime)))
+		}
+		if in.Missing {
+			flags = append(flags, "missing")
+		}
+		if in.DiskDigest != "" && in.DiskDigest != in.Digest {
+			flags = append(flags, fmt.Sprintf("content changed %s -> %s", in.Digest, in.DiskDigest))
+		} else if in.Touched {
+			flags = append(flags, "touched")
+		}
+		if in.FromDeps {
+			flags = append(flags, "deps")
+		}
+		fmt.Fprintf(w, "  input %s mtime:%s %s\n", in.Path, formatTime(in.MTime), strings.Join(flags, " "))
+	}
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return "-"
+	}
+	return t.Format(time.RFC3339Nano)
+}
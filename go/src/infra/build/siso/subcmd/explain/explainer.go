@@ -0,0 +1,324 @@
+// Copyright 2024 The Chromium Authors
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package explain
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"infra/build/siso/build"
+	pb "infra/build/siso/hashfs/proto"
+	"infra/build/siso/reapi/digest"
+	"infra/build/siso/toolsupport/makeutil"
+	"infra/build/siso/toolsupport/ninjautil"
+)
+
+// Reasons why the step is dirty.
+// They match with "run-reason" in siso trace.
+const (
+	reasonMissingInputs = "missing-inputs"
+	reasonNoOutput      = "no-output"
+	reasonDirty         = "dirty"
+	reasonCmdHash       = "cmdhash-update"
+)
+
+// explanation explains why the target is dirty.
+type explanation struct {
+	Target  string   `json:"target"`
+	Rule    string   `json:"rule,omitempty"`
+	Dirty   bool     `json:"dirty"`
+	Reasons []string `json:"reasons,omitempty"`
+
+	// CmdHash is the command hash of the step in the current build.ninja.
+	CmdHash string `json:"cmd_hash,omitempty"`
+	// OutputMTime is the mtime of the oldest output,
+	// to compare with inputs' mtime.
+	OutputMTime time.Time      `json:"output_mtime,omitempty"`
+	Outputs     []outputStatus `json:"outputs,omitempty"`
+	Inputs      []inputStatus  `json:"inputs,omitempty"`
+	DepsError   string         `json:"deps_error,omitempty"`
+	Phony       bool           `json:"phony,omitempty"`
+	Source      bool           `json:"source,omitempty"`
+
+	// depsInputs is a set of inputs from deps log or depfile.
+	depsInputs map[string]bool
+}
+
+// outputStatus is a status of the output recorded in hashfs state.
+type outputStatus struct {
+	Path string `json:"path"`
+	// Recorded is true if the output is recorded in hashfs state.
+	Recorded bool `json:"recorded"`
+	OnDisk   bool `json:"on_disk"`
+
+	MTime       time.Time `json:"mtime,omitempty"`
+	UpdatedTime time.Time `json:"updated_time,omitempty"`
+	Digest      string    `json:"digest,omitempty"`
+	Action      string    `json:"action,omitempty"`
+	CmdHash     string    `json:"cmd_hash,omitempty"`
+}
+
+// inputStatus is a status of the input.
+type inputStatus struct {
+	Path string `json:"path"`
+	// FromDeps is true if the input comes from deps log or depfile.
+	FromDeps bool `json:"from_deps,omitempty"`
+
+	MTime time.Time `json:"mtime,omitempty"`
+	// Digest is the digest recorded in hashfs state.
+	Digest string `json:"digest,omitempty"`
+	// Action is the action digest that generated the input.
+	Action string `json:"action,omitempty"`
+	// DiskDigest is the digest on local disk, when the file
+	// on disk is modified after it was recorded in hashfs state.
+	DiskDigest string `json:"disk_digest,omitempty"`
+
+	// Newer is true if the input is newer than the outputs.
+	Newer bool `json:"newer,omitempty"`
+	// Touched is true if mtime on disk differs from hashfs state.
+	Touched bool `json:"touched,omitempty"`
+	Missing bool `json:"missing,omitempty"`
+}
+
+// explainer explains the targets with the hashfs state and the deps log.
+type explainer struct {
+	// wd is the absolute path of the ninja running directory.
+	wd      string
+	state   *ninjautil.State
+	entries map[string]*pb.Entry
+	depsLog *ninjautil.DepsLog
+	all     bool
+}
+
+// fileStatus is a status of the file in hashfs state and on disk.
+type fileStatus struct {
+	ent       *pb.Entry
+	fi        os.FileInfo
+	diskMTime time.Time
+}
+
+func (e *explainer) stat(fname string) fileStatus {
+	var fs fileStatus
+	fname = filepath.Join(e.wd, fname)
+	fs.ent = e.entries[fname]
+	fi, err := os.Lstat(fname)
+	if err == nil {
+		fs.fi = fi
+		fs.diskMTime = fi.ModTime()
+	}
+	return fs
+}
+
+func (fs fileStatus) stateMTime() time.Time {
+	if fs.ent.GetId().GetModTime() == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, fs.ent.GetId().GetModTime())
+}
+
+// mtime returns the mtime used by the build.
+// It prefers mtime on disk, as the build reads it at startup.
+func (fs fileStatus) mtime() time.Time {
+	if fs.fi != nil {
+		return fs.diskMTime
+	}
+	return fs.stateMTime()
+}
+
+func (e *explainer) explain(ctx context.Context, target string) (*explanation, error) {
+	exp := &explanation{
+		Target: target,
+	}
+	n, ok := e.state.LookupNode(target)
+	if !ok {
+		return nil, fmt.Errorf("target not found: %q", target)
+	}
+	edge, ok := n.InEdge()
+	if !ok {
+		exp.Source = true
+		return exp, nil
+	}
+	exp.Rule = edge.RuleName()
+	if edge.IsPhony() {
+		exp.Phony = true
+		return exp, nil
+	}
+	generator := edge.Binding("generator") != ""
+	restat := edge.Binding("restat") != ""
+	cmdhash := build.CalculateCmdHash(edge.Binding("command"), edge.Binding("rspfile_content"))
+	exp.CmdHash = base64.StdEncoding.EncodeToString(cmdhash)
+
+	var outmtime time.Time
+	var noOutput, cmdhashChanged bool
+	for _, out := range edge.Outputs() {
+		fs := e.stat(out.Path())
+		ostat := outputStatus{
+			Path:     out.Path(),
+			Recorded: fs.ent != nil,
+			OnDisk:   fs.fi != nil,
+		}
+		if fs.ent == nil && fs.fi == nil {
+			noOutput = true
+			exp.Outputs = append(exp.Outputs, ostat)
+			continue
+		}
+		ostat.MTime = fs.mtime()
+		if fs.ent.GetUpdatedTime() != 0 {
+			ostat.UpdatedTime = time.Unix(0, fs.ent.GetUpdatedTime())
+		}
+		ostat.Digest = entryDigest(fs.ent.GetDigest())
+		ostat.Action = entryDigest(fs.ent.GetAction())
+		ostat.CmdHash = base64.StdEncoding.EncodeToString(fs.ent.GetCmdHash())
+		if !generator && !bytes.Equal(fs.ent.GetCmdHash(), cmdhash) {
+			cmdhashChanged = true
+		}
+		t := ostat.MTime
+		if restat && !ostat.UpdatedTime.IsZero() {
+			t = ostat.UpdatedTime
+		}
+		if outmtime.IsZero() || t.Before(outmtime) {
+			outmtime = t
+		}
+		exp.Outputs = append(exp.Outputs, ostat)
+	}
+	if noOutput {
+		outmtime = time.Time{}
+	}
+	exp.OutputMTime = outmtime
+
+	inputs, err := e.inputs(ctx, edge, exp)
+	if err != nil {
+		exp.DepsError = err.Error()
+		exp.Reasons = append(exp.Reasons, fmt.Sprintf("%s: %v", reasonMissingInputs, err))
+	}
+	for _, in := range inputs {
+		is := e.inputStatus(ctx, in, exp.depsInputs[in], outmtime)
+		switch {
+		case is.Missing:
+			exp.Reasons = append(exp.Reasons, fmt.Sprintf("%s: %s", reasonMissingInputs, in))
+		case is.Newer:
+			exp.Reasons = append(exp.Reasons, fmt.Sprintf("%s: input %s is newer than output by %s", reasonDirty, in, is.MTime.Sub(outmtime)))
+		case !e.all && !is.Touched:
+			continue
+		}
+		exp.Inputs = append(exp.Inputs, is)
+	}
+	if noOutput {
+		exp.Reasons = append(exp.Reasons, reasonNoOutput)
+	}
+	if !noOutput && cmdhashChanged {
+		exp.Reasons = append(exp.Reasons, fmt.Sprintf("%s: command line changed", reasonCmdHash))
+	}
+	exp.Dirty = len(exp.Reasons) > 0
+	return exp, nil
+}
+
+// inputs returns the inputs to check mtime of the step, i.e.
+// explicit and implicit inputs in build.ninja and inputs
+// in deps log or depfile.
+func (e *explainer) inputs(ctx context.Context, edge *ninjautil.Edge, exp *explanation) ([]string, error) {
+	seen := make(map[string]bool)
+	var inputs []string
+	for _, in := range edge.TriggerInputs() {
+		if seen[in.Path()] {
+			continue
+		}
+		seen[in.Path()] = true
+		inputs = append(inputs, in.Path())
+	}
+	var deps []string
+	switch edge.Binding("deps") {
+	case "gcc", "msvc":
+		out := edge.Outputs()[0].Path()
+		var err error
+		deps, _, err = e.depsLog.Get(ctx, out)
+		if err != nil {
+			return inputs, fmt.Errorf("failed to lookup deps log %s: %w", out, err)
+		}
+	case "":
+		depfile := edge.UnescapedBinding("depfile")
+		if depfile == "" {
+			break
+		}
+		var err error
+		deps, err = makeutil.ParseDepsFile(ctx, os.DirFS(e.wd), filepath.ToSlash(filepath.Clean(depfile)))
+		if err != nil {
+			return inputs, fmt.Errorf("failed to load depfile %s: %w", depfile, err)
+		}
+	}
+	exp.depsInputs = make(map[string]bool)
+	for _, in := range deps {
+		if seen[in] {
+			continue
+		}
+		seen[in] = true
+		exp.depsInputs[in] = true
+		inputs = append(inputs, in)
+	}
+	return inputs, nil
+}
+
+func (e *explainer) inputStatus(ctx context.Context, in string, fromDeps bool, outmtime time.Time) inputStatus {
+	is := inputStatus{
+		Path:     in,
+		FromDeps: fromDeps,
+	}
+	if n, ok := e.state.LookupNode(in); ok {
+		if edge, ok := n.InEdge(); ok && edge.IsPhony() {
+			// no existence check for phony targets.
+			return is
+		}
+	}
+	fs := e.stat(in)
+	if fs.ent == nil && fs.fi == nil {
+		is.Missing = true
+		return is
+	}
+	is.MTime = fs.mtime()
+	is.Digest = entryDigest(fs.ent.GetDigest())
+	is.Action = entryDigest(fs.ent.GetAction())
+	if fs.ent != nil && fs.fi != nil && !fs.stateMTime().Equal(fs.diskMTime) {
+		is.Touched = true
+		if fs.fi.Mode().IsRegular() {
+			d, err := fileDigest(filepath.Join(e.wd, in))
+			if err == nil {
+				is.DiskDigest = d
+			}
+		}
+	}
+	if !outmtime.IsZero() && is.MTime.After(outmtime) {
+		is.Newer = true
+	}
+	return is
+}
+
+func entryDigest(d *pb.Digest) string {
+	if d == nil {
+		return ""
+	}
+	return digest.Digest{
+		Hash:      d.Hash,
+		SizeBytes: d.SizeBytes,
+	}.String()
+}
+
+func fileDigest(fname string) (string, error) {
+	b, err := os.ReadFile(fname)
+	if err != nil {
+		return "", err
+	}
+	h := sha256.Sum256(b)
+	return digest.Digest{
+		Hash:      hex.EncodeToString(h[:]),
+		SizeBytes: int64(len(b)),
+	}.String(), nil
+}
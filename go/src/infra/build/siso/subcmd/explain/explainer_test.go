@@ -0,0 +1,170 @@
+// Copyright 2024 The Chromium Authors
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package explain
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"infra/build/siso/build"
+	pb "infra/build/siso/hashfs/proto"
+	"infra/build/siso/toolsupport/ninjautil"
+)
+
+func TestExplain(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	t1 := time.Unix(1000, 0)
+	t2 := time.Unix(2000, 0)
+	t3 := time.Unix(3000, 0)
+
+	writeFile := func(t *testing.T, fname, content string, mtime time.Time) {
+		t.Helper()
+		fullpath := filepath.Join(dir, fname)
+		err := os.WriteFile(fullpath, []byte(content), 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = os.Chtimes(fullpath, mtime, mtime)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeFile(t, "build.ninja", `
+rule cc
+  command = cc $in -o $out
+  deps = gcc
+  depfile = $out.d
+rule cp
+  command = cp $in $out
+build foo.o: cc foo.cc
+build bar: cp bar.in
+build baz: cp bar.in
+build all: phony foo.o bar baz
+`, t1)
+	writeFile(t, "foo.cc", "foo.cc", t1)
+	writeFile(t, "foo.h", "foo.h", t1)
+	writeFile(t, "foo.o", "foo.o", t2)
+	writeFile(t, "bar.in", "bar.in", t1)
+	writeFile(t, "bar", "bar.in", t2)
+
+	depsLog, err := ninjautil.NewDepsLog(ctx, filepath.Join(dir, ".siso_deps"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = depsLog.Record(ctx, "foo.o", t2, []string{"foo.cc", "foo.h"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = depsLog.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	depsLog, err = ninjautil.NewDepsLog(ctx, filepath.Join(dir, ".siso_deps"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer depsLog.Close()
+
+	state := ninjautil.NewState()
+	p := ninjautil.NewManifestParser(state)
+	err = p.Load(ctx, filepath.Join(dir, "build.ninja"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fooDigest := &pb.Digest{Hash: "f00", SizeBytes: 5}
+	entries := map[string]*pb.Entry{
+		filepath.Join(dir, "foo.cc"): {Id: &pb.FileID{ModTime: t1.UnixNano()}},
+		filepath.Join(dir, "foo.h"):  {Id: &pb.FileID{ModTime: t1.UnixNano()}, Digest: fooDigest},
+		filepath.Join(dir, "foo.o"): {
+			Id:      &pb.FileID{ModTime: t2.UnixNano()},
+			CmdHash: build.CalculateCmdHash("cc foo.cc -o foo.o", ""),
+		},
+		filepath.Join(dir, "bar.in"): {Id: &pb.FileID{ModTime: t1.UnixNano()}},
+		filepath.Join(dir, "bar"): {
+			Id:      &pb.FileID{ModTime: t2.UnixNano()},
+			CmdHash: build.CalculateCmdHash("cp old bar", ""),
+		},
+	}
+	e := &explainer{
+		wd:      dir,
+		state:   state,
+		entries: entries,
+		depsLog: depsLog,
+	}
+
+	for _, tc := range []struct {
+		name   string
+		modify func(t *testing.T)
+		target string
+		want   []string
+	}{
+		{
+			name:   "up-to-date",
+			target: "foo.o",
+		},
+		{
+			name:   "input-newer",
+			modify: func(t *testing.T) { writeFile(t, "foo.h", "new foo.h", t3) },
+			target: "foo.o",
+			want:   []string{"dirty: input foo.h is newer than output by 16m40s"},
+		},
+		{
+			name:   "cmdhash",
+			target: "bar",
+			want:   []string{"cmdhash-update: command line changed"},
+		},
+		{
+			name:   "no-output",
+			target: "baz",
+			want:   []string{"no-output"},
+		},
+		{
+			name:   "phony",
+			target: "all",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.modify != nil {
+				tc.modify(t)
+			}
+			exp, err := e.explain(ctx, tc.target)
+			if err != nil {
+				t.Fatalf("explain(ctx, %q)=_, %v; want nil err", tc.target, err)
+			}
+			if diff := cmp.Diff(tc.want, exp.Reasons); diff != "" {
+				t.Errorf("explain(ctx, %q).Reasons diff -want +got:\n%s", tc.target, diff)
+			}
+			if exp.Dirty != (len(tc.want) > 0) {
+				t.Errorf("explain(ctx, %q).Dirty=%t; want %t", tc.target, exp.Dirty, len(tc.want) > 0)
+			}
+		})
+	}
+
+	exp, err := e.explain(ctx, "foo.o")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []inputStatus{
+		{
+			Path:       "foo.h",
+			FromDeps:   true,
+			MTime:      t3,
+			Digest:     "f00/5",
+			DiskDigest: "788691fcab6b5252ffae5c7b9c002baa116909067f53112932e801cbb42201ed/9",
+			Newer:      true,
+			Touched:    true,
+		},
+	}
+	if diff := cmp.Diff(want, exp.Inputs); diff != "" {
+		t.Errorf("explain(ctx, %q).Inputs diff -want +got:\n%s", "foo.o", diff)
+	}
+}
@@ -0,0 +1,425 @@
+// Copyright 2024 The Chromium Authors
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package metricscmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/maruel/subcommands"
+
+	"go.chromium.org/luci/common/cli"
+
+	"infra/build/siso/build"
+	"infra/build/siso/toolsupport/ninjautil"
+)
+
+const critpathUsage = `show critical path of the build.
+
+ $ siso metrics critpath -C <dir> \
+    [-f build.ninja] \
+    [--input siso_metrics.json] \
+    [--trace siso_trace.json] \
+    [--output_trace siso_critpath_trace.json]
+
+reconstructs the dependency graph from <dir>/build.ninja (-f) and
+<dir>/siso_metrics.json (--input), and shows the critical path of
+the build, i.e. chain of steps that bounded the wall time of the build,
+with per-step queue, scandeps, remote-exec and local-exec time.
+
+It also writes the critical path as a Chrome trace overlay
+to <dir>/siso_critpath_trace.json (--output_trace), which contains
+events in <dir>/siso_trace.json (--trace) if it exists.
+`
+
+// critpathCmd returns the Command for the `critpath` subcommand provided by this package.
+func critpathCmd() *subcommands.Command {
+	return &subcommands.Command{
+		UsageLine: "critpath <args>...",
+		ShortDesc: "show critical path of the build",
+		LongDesc:  critpathUsage,
+		CommandRun: func() subcommands.CommandRun {
+			c := &critpathRun{}
+			c.init()
+			return c
+		},
+	}
+}
+
+type critpathRun struct {
+	subcommands.CommandRunBase
+
+	dir         string
+	fname       string
+	input       string
+	traceJSON   string
+	outputTrace string
+}
+
+func (c *critpathRun) init() {
+	c.Flags.StringVar(&c.dir, "C", ".", "ninja running directory, where siso_metrics.json exists")
+	c.Flags.StringVar(&c.fname, "f", "build.ninja", "input build manifest filename (relative to -C)")
+	c.Flags.StringVar(&c.input, "input", "siso_metrics.json", "filename of siso_metrics.json")
+	c.Flags.StringVar(&c.traceJSON, "trace", "siso_trace.json", "filename of siso_trace.json to overlay the critical path. ignored if not exist")
+	c.Flags.StringVar(&c.outputTrace, "output_trace", "siso_critpath_trace.json", "filename of trace json to write. no trace output if empty")
+}
+
+func (c *critpathRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
+	ctx := cli.GetContext(a, c, env)
+	err := c.run(ctx)
+	if err != nil {
+		switch {
+		case errors.Is(err, flag.ErrHelp):
+			fmt.Fprintf(os.Stderr, "%v\n%s\n", err, critpathUsage)
+		default:
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		}
+		return 1
+	}
+	return 0
+}
+
+func (c *critpathRun) run(ctx context.Context) error {
+	err := os.Chdir(c.dir)
+	if err != nil {
+		return err
+	}
+	metrics, err := loadMetrics(ctx, c.input)
+	if err != nil {
+		return err
+	}
+	state := ninjautil.NewState()
+	p := ninjautil.NewManifestParser(state)
+	err = p.Load(ctx, c.fname)
+	if err != nil {
+		return err
+	}
+	cwd, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+	path := criticalPath(state, metrics, outputPrefix(cwd, metrics))
+	if len(path) == 0 {
+		return fmt.Errorf("no steps in %s", c.input)
+	}
+	printCriticalPath(os.Stdout, path)
+	if c.outputTrace == "" {
+		return nil
+	}
+	err = writeCriticalPathTrace(c.outputTrace, c.traceJSON, path)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("critical path trace: %s\n", c.outputTrace)
+	return nil
+}
+
+// critStep is a step in the critical path.
+type critStep struct {
+	metric build.StepMetric
+
+	// ready, start and end are times since the build start.
+	ready time.Duration
+	start time.Duration
+	end   time.Duration
+}
+
+func newCritStep(m build.StepMetric) *critStep {
+	ready := time.Duration(m.Ready)
+	start := ready + time.Duration(m.Start)
+	return &critStep{
+		metric: m,
+		ready:  ready,
+		start:  start,
+		end:    start + time.Duration(m.Duration),
+	}
+}
+
+// queue is the time waiting to start the step after it became ready.
+func (s *critStep) queue() time.Duration {
+	return time.Duration(s.metric.Start)
+}
+
+func (s *critStep) scandeps() time.Duration {
+	return time.Duration(s.metric.DepsScanTime)
+}
+
+// remoteExec is the time of remote execution, including remote cache check.
+func (s *critStep) remoteExec() time.Duration {
+	if s.metric.IsLocal {
+		return 0
+	}
+	return time.Duration(s.metric.RunTime)
+}
+
+func (s *critStep) localExec() time.Duration {
+	if !s.metric.IsLocal {
+		return 0
+	}
+	return time.Duration(s.metric.RunTime)
+}
+
+// outputPrefix returns prefix of outputs in metrics to make
+// them relative to cwd.
+// Outputs in metrics are relative to exec root, so it finds
+// the longest suffix of cwd that is a prefix of an output.
+func outputPrefix(cwd string, metrics []build.StepMetric) string {
+	elems := strings.Split(filepath.ToSlash(cwd), "/")
+	for _, m := range metrics {
+		if m.StepID == "" || m.Output == "" {
+			continue
+		}
+		for i := 0; i < len(elems); i++ {
+			prefix := strings.Join(elems[i:], "/") + "/"
+			if prefix == "/" {
+				continue
+			}
+			if strings.HasPrefix(m.Output, prefix) {
+				return prefix
+			}
+		}
+	}
+	return ""
+}
+
+// criticalPath returns the critical path of the build.
+// It starts from the step finished last, and follows
+// the input that finished last, found in the build graph of state.
+// prefix is trimmed from outputs in metrics to look up nodes in state.
+func criticalPath(state *ninjautil.State, metrics []build.StepMetric, prefix string) []*critStep {
+	steps := make(map[string]*critStep)
+	var last *critStep
+	for _, m := range metrics {
+		if m.StepID == "" {
+			// this is special entry for build metrics, not per step metrics.
+			continue
+		}
+		s := newCritStep(m)
+		steps[strings.TrimPrefix(m.Output, prefix)] = s
+		if last == nil || s.end > last.end {
+			last = s
+		}
+	}
+	if last == nil {
+		return nil
+	}
+	var path []*critStep
+	seen := make(map[*critStep]bool)
+	for s := last; s != nil && !seen[s]; {
+		seen[s] = true
+		path = append(path, s)
+		s = prevCritStep(state, steps, s, prefix)
+	}
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path
+}
+
+// prevCritStep returns the step that made s ready, i.e. the step
+// finished last among the steps generating inputs of s.
+func prevCritStep(state *ninjautil.State, steps map[string]*critStep, s *critStep, prefix string) *critStep {
+	var prev *critStep
+	node, ok := state.LookupNode(strings.TrimPrefix(s.metric.Output, prefix))
+	if ok {
+		if edge, ok := node.InEdge(); ok {
+			seen := make(map[*ninjautil.Edge]bool)
+			prev = lastInputStep(steps, edge, s.ready, seen)
+		}
+	}
+	if prev != nil {
+		return prev
+	}
+	// no input steps found in the manifest, e.g. the step
+	// depends on inputs via deps log, so use previous step
+	// recorded in metrics.
+	if s.metric.PrevStepOut == "" {
+		return nil
+	}
+	prev, ok = steps[strings.TrimPrefix(s.metric.PrevStepOut, prefix)]
+	if !ok || prev.end > s.ready {
+		return nil
+	}
+	return prev
+}
+
+// lastInputStep returns the step finished last before ready
+// among the steps generating inputs of edge.
+// It follows inputs of phony edges, as they are not recorded
+// in metrics.
+func lastInputStep(steps map[string]*critStep, edge *ninjautil.Edge, ready time.Duration, seen map[*ninjautil.Edge]bool) *critStep {
+	var last *critStep
+	for _, in := range edge.Inputs() {
+		e, ok := in.InEdge()
+		if !ok || seen[e] {
+			continue
+		}
+		seen[e] = true
+		var s *critStep
+		if e.IsPhony() {
+			s = lastInputStep(steps, e, ready, seen)
+		} else if outs := e.Outputs(); len(outs) > 0 {
+			s = steps[outs[0].Path()]
+		}
+		if s == nil || s.end > ready {
+			continue
+		}
+		if last == nil || s.end > last.end {
+			last = s
+		}
+	}
+	return last
+}
+
+func printCriticalPath(w io.Writer, path []*critStep) {
+	var queue, scandeps, remote, local, total time.Duration
+	fmt.Fprintf(w, "%8s %8s %8s %8s %8s %8s %8s  %s\n", "ready", "start", "duration", "queue", "scandeps", "remote", "local", "output")
+	for _, s := range path {
+		fmt.Fprintf(w, "%8s %8s %8s %8s %8s %8s %8s  %s\n",
+			formatDuration(s.ready),
+			formatDuration(s.start),
+			formatDuration(s.end-s.start),
+			formatDuration(s.queue()),
+			formatDuration(s.scandeps()),
+			formatDuration(s.remoteExec()),
+			formatDuration(s.localExec()),
+			s.metric.Output)
+		queue += s.queue()
+		scandeps += s.scandeps()
+		remote += s.remoteExec()
+		local += s.localExec()
+		total += s.end - s.ready
+	}
+	last := path[len(path)-1]
+	fmt.Fprintf(w, "critical path: %d steps %s in %s build\n", len(path), formatDuration(total), formatDuration(last.end))
+	fmt.Fprintf(w, " queue:%s scandeps:%s remote:%s local:%s\n", formatDuration(queue), formatDuration(scandeps), formatDuration(remote), formatDuration(local))
+}
+
+// critpathPid is pid of the critical path in trace json.
+// It should not conflict with pids used in siso_trace.json.
+const critpathPid = 100
+
+// traceEvent is an event in trace json.
+// see https://docs.google.com/document/d/1CvAClvFfyA5R-PhYUmn5OOQtYMH4h6I0nSsKchNAySU/preview
+type traceEvent struct {
+	Name string         `json:"name"`
+	Cat  string         `json:"cat,omitempty"`
+	Ph   string         `json:"ph"`
+	T    int64          `json:"ts"`
+	Pid  int64          `json:"pid"`
+	Tid  int64          `json:"tid"`
+	Dur  int64          `json:"dur,omitempty"`
+	Args map[string]any `json:"args,omitempty"`
+}
+
+func criticalPathTraceEvents(path []*critStep) []traceEvent {
+	events := []traceEvent{
+		{
+			Name: "process_name",
+			Ph:   "M",
+			Pid:  critpathPid,
+			Tid:  1,
+			Args: map[string]any{
+				"name": "critical path",
+			},
+		},
+	}
+	for _, s := range path {
+		events = append(events, traceEvent{
+			Name: s.metric.Output,
+			Cat:  s.metric.Rule,
+			Ph:   "X",
+			T:    s.ready.Microseconds(),
+			Pid:  critpathPid,
+			Tid:  1,
+			Dur:  (s.end - s.ready).Microseconds(),
+			Args: map[string]any{
+				"id":       s.metric.StepID,
+				"action":   s.metric.Action,
+				"queue":    s.queue().String(),
+				"scandeps": s.scandeps().String(),
+				"remote":   s.remoteExec().String(),
+				"local":    s.localExec().String(),
+				"cached":   s.metric.Cached,
+			},
+		})
+		if s.queue() > 0 {
+			events = append(events, traceEvent{
+				Name: "queue",
+				Ph:   "X",
+				T:    s.ready.Microseconds(),
+				Pid:  critpathPid,
+				Tid:  1,
+				Dur:  s.queue().Microseconds(),
+			})
+		}
+		if s.metric.RunTime > 0 && s.metric.ActionStartTime > 0 {
+			name := "remote exec"
+			if s.metric.IsLocal {
+				name = "local exec"
+			}
+			events = append(events, traceEvent{
+				Name: name,
+				Ph:   "X",
+				T:    time.Duration(s.metric.ActionStartTime).Microseconds(),
+				Pid:  critpathPid,
+				Tid:  1,
+				Dur:  time.Duration(s.metric.RunTime).Microseconds(),
+			})
+		}
+	}
+	return events
+}
+
+// writeCriticalPathTrace writes trace json of the critical path to fname.
+// If traceJSON exists, it includes the events in traceJSON, so
+// the critical path is shown as an overlay of siso_trace.json.
+func writeCriticalPathTrace(fname, traceJSON string, path []*critStep) error {
+	traceData := map[string]json.RawMessage{}
+	var events []json.RawMessage
+	buf, err := os.ReadFile(traceJSON)
+	switch {
+	case err == nil:
+		err = json.Unmarshal(buf, &traceData)
+		if err != nil {
+			return fmt.Errorf("failed to parse %s: %w", traceJSON, err)
+		}
+		if te, ok := traceData["traceEvents"]; ok {
+			err = json.Unmarshal(te, &events)
+			if err != nil {
+				return fmt.Errorf("failed to parse traceEvents in %s: %w", traceJSON, err)
+			}
+		}
+	case errors.Is(err, os.ErrNotExist):
+		traceData["displayTimeUnit"] = json.RawMessage(`"ms"`)
+	default:
+		return err
+	}
+	for _, ev := range criticalPathTraceEvents(path) {
+		b, err := json.Marshal(ev)
+		if err != nil {
+			return err
+		}
+		events = append(events, b)
+	}
+	b, err := json.Marshal(events)
+	if err != nil {
+		return err
+	}
+	traceData["traceEvents"] = b
+	buf, err = json.Marshal(traceData)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(fname, buf, 0644)
+}
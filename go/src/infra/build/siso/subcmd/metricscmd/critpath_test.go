@@ -0,0 +1,96 @@
+// Copyright 2024 The Chromium Authors
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package metricscmd
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"infra/build/siso/build"
+	"infra/build/siso/toolsupport/ninjautil"
+)
+
+func TestCriticalPath(t *testing.T) {
+	ctx := context.Background()
+	fname := filepath.Join(t.TempDir(), "build.ninja")
+	err := os.WriteFile(fname, []byte(`
+rule cxx
+  command = clang++ -c ${in} -o ${out}
+
+rule gen
+  command = gen ${in} ${out}
+
+rule link
+  command = clang++ -o ${out} ${in}
+
+build gen/foo.h: gen ../../foo.h.in
+build gen_headers: phony gen/foo.h
+
+build obj/foo.o: cxx ../../foo.cc || gen_headers
+build obj/bar.o: cxx ../../bar.cc
+
+build app: link obj/foo.o obj/bar.o
+`), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	state := ninjautil.NewState()
+	p := ninjautil.NewManifestParser(state)
+	err = p.Load(ctx, fname)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sec := func(n float64) build.IntervalMetric {
+		return build.IntervalMetric(time.Duration(n * float64(time.Second)))
+	}
+	metrics := []build.StepMetric{
+		{
+			StepID:   "gen",
+			Output:   "out/siso/gen/foo.h",
+			Duration: sec(5),
+		},
+		{
+			StepID:   "bar",
+			Output:   "out/siso/obj/bar.o",
+			Duration: sec(2),
+		},
+		{
+			StepID:   "foo",
+			Output:   "out/siso/obj/foo.o",
+			Ready:    sec(5),
+			Start:    sec(1),
+			Duration: sec(3),
+		},
+		{
+			StepID:   "app",
+			Output:   "out/siso/app",
+			Ready:    sec(9),
+			Duration: sec(1),
+		},
+		{
+			// build metrics.
+			Duration: sec(10),
+		},
+	}
+	prefix := outputPrefix("/b/src/out/siso", metrics)
+	if prefix != "out/siso/" {
+		t.Errorf("outputPrefix=%q; want=%q", prefix, "out/siso/")
+	}
+	path := criticalPath(state, metrics, prefix)
+	var got []string
+	for _, s := range path {
+		got = append(got, s.metric.StepID)
+	}
+	want := []string{"gen", "foo", "app"}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("criticalPath(...) diff -want +got:\n%s", diff)
+	}
+}
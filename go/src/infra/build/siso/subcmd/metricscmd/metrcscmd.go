@@ -25,6 +25,7 @@ func Cmd() *subcommands.Command {
 					Title: "tools to analyze siso_metrics.json",
 					Commands: []*subcommands.Command{
 						cmpCmd(),
+						critpathCmd(),
 						summaryCmd(),
 						subcommands.CmdHelp,
 					},
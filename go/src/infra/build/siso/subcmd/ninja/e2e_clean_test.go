@@ -0,0 +1,87 @@
+// Copyright 2024 The Chromium Authors
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package ninja
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"infra/build/siso/build"
+	"infra/build/siso/hashfs"
+)
+
+func TestBuild_Clean(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	ninja := func(t *testing.T, subtool string, targets ...string) (build.Stats, error) {
+		t.Helper()
+		opt, graph, cleanup := setupBuild(ctx, t, dir, hashfs.Option{
+			StateFile: ".siso_fs_state",
+		})
+		defer cleanup()
+		return runNinja(ctx, "build.ninja", graph, opt, targets, runNinjaOpts{
+			subtool: subtool,
+		})
+	}
+
+	t.Logf("setup workspace")
+	setupFiles(t, dir, t.Name(), nil)
+
+	t.Logf("first build")
+	_, err := ninja(t, "")
+	if err != nil {
+		t.Fatalf("ninja err: %v", err)
+	}
+
+	t.Logf("clean obj/foo.o")
+	_, err = ninja(t, "clean", "obj/foo.o")
+	if err != nil {
+		t.Fatalf("clean err: %v", err)
+	}
+	for _, fname := range []string{
+		"out/siso/gen/foo.h",
+		"out/siso/obj/foo.o",
+	} {
+		_, err := os.Stat(filepath.Join(dir, fname))
+		if !errors.Is(err, fs.ErrNotExist) {
+			t.Errorf("stat(%q)=%v; want %v", fname, err, fs.ErrNotExist)
+		}
+	}
+	for _, fname := range []string{
+		"out/siso/gen/bar.h",
+		"out/siso/obj/bar.o",
+		"out/siso/target",
+	} {
+		_, err := os.Stat(filepath.Join(dir, fname))
+		if err != nil {
+			t.Errorf("stat(%q)=%v; want nil error", fname, err)
+		}
+	}
+
+	t.Logf("clean all")
+	_, err = ninja(t, "clean")
+	if err != nil {
+		t.Fatalf("clean err: %v", err)
+	}
+	for _, fname := range []string{
+		"out/siso/gen/bar.h",
+		"out/siso/obj/bar.o",
+		"out/siso/target",
+	} {
+		_, err := os.Stat(filepath.Join(dir, fname))
+		if !errors.Is(err, fs.ErrNotExist) {
+			t.Errorf("stat(%q)=%v; want %v", fname, err, fs.ErrNotExist)
+		}
+	}
+	_, err = os.Stat(filepath.Join(dir, "out/siso/build.ninja"))
+	if err != nil {
+		t.Errorf("stat(%q)=%v; want nil error", "out/siso/build.ninja", err)
+	}
+}
@@ -102,9 +102,10 @@ type ninjaCmdRun struct {
 	remoteJobs int
 	fname      string
 
-	cacheDir         string
-	localCacheEnable bool
-	cacheEnableRead  bool
+	cacheDir          string
+	localCacheEnable  bool
+	localCacheMaxSize string
+	cacheEnableRead   bool
 	// cacheEnableWrite bool
 
 	configRepoDir  string
@@ -123,7 +124,9 @@ type ninjaCmdRun struct {
 	localexecLogFile   string
 	metricsJSON        string
 	traceJSON          string
+	criticalPath       bool
 	buildPprof         string
+	statuszAddr        string
 	// uploadBuildPprof bool
 
 	fsopt             *hashfs.Option
@@ -158,6 +161,14 @@ func (c *ninjaCmdRun) Run(a subcommands.Application, args []string, env subcomma
 		fmt.Fprintln(os.Stderr, err)
 		return 2
 	}
+	if isManifestTool(c.subtool) {
+		err := c.runManifestTool(ctx)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return 1
+		}
+		return 0
+	}
 	stats, err := c.run(ctx)
 	d := time.Since(c.started)
 	sps := float64(stats.Done-stats.Skipped) / d.Seconds()
@@ -323,10 +334,14 @@ func (c *ninjaCmdRun) run(ctx context.Context) (stats build.Stats, err error) {
 	case "list":
 		return stats, flagError{
 			err: errors.New(`ninja subtools:
-  commands   Use "siso query commands" instead
-  inputs     Use "siso query inputs" instead
-  targets    Use "siso query targets" instead
-  cleandead  clean built files that are no longer produced by the manifest`),
+  commands     Use "siso query commands" instead
+  inputs       Use "siso query inputs" instead
+  targets      Use "siso query targets" instead
+  compdb       dump JSON compilation database to stdout
+  graph        output graphviz dot file for targets
+  missingdeps  check deps log dependencies on generated files
+  clean        clean built files
+  cleandead    clean built files that are no longer produced by the manifest`),
 		}
 	case "commands":
 		return stats, flagError{
@@ -341,20 +356,13 @@ func (c *ninjaCmdRun) run(ctx context.Context) (stats build.Stats, err error) {
 			err: errors.New("use `siso query targets` instead"),
 		}
 
+	case "clean":
 	case "cleandead":
 		c.cleandead = true
 	default:
 		return stats, flagError{err: fmt.Errorf("unknown tool %q", c.subtool)}
 	}
 
-	limits := build.DefaultLimits(ctx)
-	if c.remoteJobs > 0 {
-		limits.Remote = c.remoteJobs
-		limits.REWrap = c.remoteJobs
-	}
-	if c.ninjaJobs >= 0 {
-		fmt.Fprintf(os.Stderr, "-j is specified. but not supported. b/288829511\n")
-	}
 	if c.failuresAllowed == 0 {
 		c.failuresAllowed = math.MaxInt
 	}
@@ -524,6 +532,23 @@ func (c *ninjaCmdRun) run(ctx context.Context) (stats build.Stats, err error) {
 			clog.Errorf(ctx, "close datasource: %v", err)
 		}
 	}()
+	if ds.client != nil && ds.client.CacheOnly() {
+		ui.Default.PrintLines(fmt.Sprintf("%s provides cache only. remote exec is disabled\n", c.reopt.Address))
+	}
+
+	limits := build.DefaultLimits(ctx)
+	if c.remoteJobs > 0 {
+		limits.Remote = c.remoteJobs
+		limits.REWrap = c.remoteJobs
+	}
+	// -j is applied after -remote_jobs, so it caps remote jobs too.
+	if c.ninjaJobs >= 0 {
+		// ds.client is created only if reapi is valid for the resolved project.
+		remote := c.reproxyAddr != "" || (ds.client != nil && !ds.client.CacheOnly())
+		limits = limits.WithJobs(c.ninjaJobs, remote)
+		clog.Infof(ctx, "-j %d (remote=%t): limits=%#v", c.ninjaJobs, remote, limits)
+	}
+
 	c.fsopt.DataSource = ds
 	c.fsopt.OutputLocal, err = c.initOutputLocal()
 	if err != nil {
@@ -641,7 +666,7 @@ func (c *ninjaCmdRun) run(ctx context.Context) (stats build.Stats, err error) {
 	_, err = os.Stat(failedTargetsFile)
 	lastFailed := err == nil
 	clog.Infof(ctx, "sameTargets: %t hashfs clean: %t last failed: %t", sameTargets, hashFS.IsClean(), lastFailed)
-	if !c.clobber && !c.dryRun && !c.debugMode.Explain && c.subtool != "cleandead" && sameTargets && hashFS.IsClean() && !lastFailed {
+	if !c.clobber && !c.dryRun && !c.debugMode.Explain && c.subtool == "" && sameTargets && hashFS.IsClean() && !lastFailed {
 		// TODO: better to check digest of .siso_fs_state?
 		return stats, errNothingToDo
 	}
@@ -678,9 +703,10 @@ func (c *ninjaCmdRun) run(ctx context.Context) (stats build.Stats, err error) {
 	graph := ninjabuild.NewGraph(ctx, c.fname, nstate, config, buildPath, hashFS, stepConfig, localDepsLog)
 
 	return runNinja(ctx, c.fname, graph, bopts, targets, runNinjaOpts{
-		checkFailedTargets: !c.batch && sameTargets && !c.clobber,
+		checkFailedTargets: c.subtool == "" && !c.batch && sameTargets && !c.clobber,
 		cleandead:          c.cleandead,
 		subtool:            c.subtool,
+		statuszAddr:        c.statuszAddr,
 	})
 }
 
@@ -693,7 +719,11 @@ type runNinjaOpts struct {
 
 	// subtool name.
 	// if "cleandead", it returns after cleandead performed.
+	// if "clean", it returns after clean performed.
 	subtool string
+
+	// address to serve statusz.
+	statuszAddr string
 }
 
 func runNinja(ctx context.Context, fname string, graph *ninjabuild.Graph, bopts build.Options, targets []string, nopts runNinjaOpts) (build.Stats, error) {
@@ -793,12 +823,13 @@ func (c *ninjaCmdRun) init() {
 	c.Flags.IntVar(&c.failuresAllowed, "k", 1, "keep going until N jobs fail (0 means inifinity)")
 	c.Flags.StringVar(&c.actionSalt, "action_salt", "", "action salt")
 
-	c.Flags.IntVar(&c.ninjaJobs, "j", -1, "run N jobs in parallel (0 means infinity). not supported b/288829511")
+	c.Flags.IntVar(&c.ninjaJobs, "j", -1, "run N jobs in parallel (0 means infinity). without remote execution, run N local jobs in parallel")
 	c.Flags.IntVar(&c.remoteJobs, "remote_jobs", 0, "run N remote jobs in parallel. when the value is no positive, the default will be computed based on # of CPUs.")
 	c.Flags.StringVar(&c.fname, "f", "build.ninja", "input build manifet filename (relative to -C)")
 
 	c.Flags.StringVar(&c.cacheDir, "cache_dir", defaultCacheDir(), "cache directory")
 	c.Flags.BoolVar(&c.localCacheEnable, "local_cache_enable", false, "local cache enable")
+	c.Flags.StringVar(&c.localCacheMaxSize, "local_cache_max_size", "", `max size of local cache (e.g. "50GiB"). least recently used entries are evicted in background. empty or 0 means no limit`)
 	c.Flags.BoolVar(&c.cacheEnableRead, "cache_enable_read", true, "cache enable read")
 
 	c.Flags.StringVar(&c.configRepoDir, "config_repo_dir", "build/config/siso", "config repo directory (relative to exec root)")
@@ -819,6 +850,8 @@ func (c *ninjaCmdRun) init() {
 	c.Flags.StringVar(&c.metricsJSON, "metrics_json", "siso_metrics.json", "metrics JSON filename (relative to -log_dir)")
 	c.Flags.StringVar(&c.traceJSON, "trace_json", "siso_trace.json", "trace JSON filename (relative to -log_dir)")
 	c.Flags.StringVar(&c.buildPprof, "build_pprof", "siso_build.pprof", "build pprof filename (relative to -log_dir)")
+	c.Flags.StringVar(&c.statuszAddr, "statusz_addr", "localhost:0", "address to serve the statusz dashboard and api. the served address is written in .siso_port")
+	c.Flags.BoolVar(&c.criticalPath, "critical_path", true, "schedule ready steps by critical path, estimated from step durations in the previous metrics JSON")
 
 	c.fsopt = new(hashfs.Option)
 	c.fsopt.StateFile = ".siso_fs_state"
@@ -1109,6 +1142,11 @@ func (c *ninjaCmdRun) initBuildOpts(ctx context.Context, projectID, buildID stri
 	}
 	dones = append(dones, done)
 
+	var stepDurations map[string]time.Duration
+	if c.criticalPath {
+		// load before metrics JSON is rotated by logWriter.
+		stepDurations = c.loadStepDurations(ctx)
+	}
 	metricsJSONWriter, done, err := c.logWriter(ctx, c.metricsJSON)
 	if err != nil {
 		return bopts, nil, err
@@ -1179,6 +1217,7 @@ func (c *ninjaCmdRun) initBuildOpts(ctx context.Context, projectID, buildID stri
 		FailuresAllowed:      c.failuresAllowed,
 		KeepRSP:              c.debugMode.Keeprsp,
 		Limits:               limits,
+		StepDurations:        stepDurations,
 	}
 	return bopts, func(err *error) {
 		for i := len(dones) - 1; i >= 0; i-- {
@@ -1208,6 +1247,27 @@ func (c *ninjaCmdRun) glogFilename() string {
 	return filepath.Join(c.logDir, logFilename)
 }
 
+// loadStepDurations loads step durations from the metrics JSON
+// of the previous build.
+// It returns empty map if the metrics JSON is not available,
+// so critical path is estimated only by the number of steps.
+func (c *ninjaCmdRun) loadStepDurations(ctx context.Context) map[string]time.Duration {
+	if c.metricsJSON == "" {
+		return map[string]time.Duration{}
+	}
+	fname := c.metricsJSON
+	if !filepath.IsAbs(fname) {
+		fname = filepath.Join(c.logDir, fname)
+	}
+	durations, err := build.LoadStepDurations(fname)
+	if err != nil {
+		clog.Warningf(ctx, "failed to load step durations: %v", err)
+		return map[string]time.Duration{}
+	}
+	clog.Infof(ctx, "loaded %d step durations from %s", len(durations), fname)
+	return durations
+}
+
 func (c *ninjaCmdRun) logWriter(ctx context.Context, fname string) (io.Writer, func(errp *error), error) {
 	if fname == "" {
 		return nil, func(*error) {}, nil
@@ -1239,6 +1299,17 @@ func defaultCacheDir() string {
 }
 
 func doBuild(ctx context.Context, graph *ninjabuild.Graph, bopts build.Options, nopts runNinjaOpts, args ...string) (stats build.Stats, err error) {
+	if nopts.subtool == "clean" {
+		spin := ui.Default.NewSpinner()
+		spin.Start("cleaning")
+		n, err := graph.Clean(ctx, args, bopts.DryRun)
+		if err != nil {
+			spin.Stop(err)
+			return stats, err
+		}
+		spin.Done("%d files", n)
+		return stats, nil
+	}
 	clog.Infof(ctx, "rebuild manifest")
 	mfbopts := bopts
 	mfbopts.Clobber = false
@@ -1279,7 +1350,7 @@ func doBuild(ctx context.Context, graph *ninjabuild.Graph, bopts build.Options,
 	hctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 	go func() {
-		err := newStatuszServer(hctx, b)
+		err := newStatuszServer(hctx, b, nopts.statuszAddr)
 		if err != nil {
 			clog.Warningf(ctx, "statusz: %v", err)
 		}
@@ -1295,69 +1366,11 @@ func doBuild(ctx context.Context, graph *ninjabuild.Graph, bopts build.Options,
 	err = b.Build(ctx, "build", args...)
 	// prof.stop(ctx)
 
-	semaTraces := make(map[string]semaTrace)
 	tstats := b.TraceStats()
-	var rbeWorker, rbeExec *build.TraceStat
 	for _, ts := range tstats {
 		clog.Infof(ctx, "%s: n=%d avg=%s max=%s", ts.Name, ts.N, ts.Avg(), ts.Max)
-		switch {
-		case strings.HasPrefix(ts.Name, "wait:"):
-			name := strings.TrimPrefix(ts.Name, "wait:")
-			t := semaTraces[name]
-			t.name = name
-			t.n = ts.N
-			t.waitAvg = ts.Avg()
-			t.waitBuckets = ts.Buckets
-			semaTraces[name] = t
-		case strings.HasPrefix(ts.Name, "serv:"):
-			name := strings.TrimPrefix(ts.Name, "serv:")
-			t := semaTraces[name]
-			t.name = name
-			t.n = ts.N
-			t.nerr = ts.NErr
-			t.servAvg = ts.Avg()
-			t.servBuckets = ts.Buckets
-			semaTraces[name] = t
-		case ts.Name == "rbe:queue":
-			name := "rbe:sched"
-			t := semaTraces[name]
-			t.name = name
-			t.n = ts.N
-			t.nerr = ts.NErr
-			t.waitAvg = ts.Avg()
-			t.waitBuckets = ts.Buckets
-			semaTraces[name] = t
-		case ts.Name == "rbe:worker":
-			rbeWorker = ts
-		case ts.Name == "rbe:exec":
-			rbeExec = ts
-		}
-	}
-	if rbeWorker != nil {
-		name := "rbe:sched"
-		t := semaTraces[name]
-		t.name = name
-		t.servAvg = rbeWorker.Avg()
-		t.servBuckets = rbeWorker.Buckets
-		semaTraces[name] = t
-	}
-	if rbeWorker != nil && rbeExec != nil {
-		name := "rbe:worker"
-		t := semaTraces[name]
-		t.name = name
-		t.n = rbeExec.N
-		t.waitAvg = rbeWorker.Avg() - rbeExec.Avg()
-		// number of waits would not be correct with this calculation
-		// because it just uses counts in buckets.
-		// not sure how we can measure actual waiting time in buckets,
-		// but this would provide enough estimated values.
-		for i := range rbeWorker.Buckets {
-			t.waitBuckets[i] = rbeWorker.Buckets[i] - rbeExec.Buckets[i]
-		}
-		t.servAvg = rbeExec.Avg()
-		t.servBuckets = rbeExec.Buckets
-		semaTraces[name] = t
 	}
+	semaTraces := newSemaTraces(tstats)
 	if len(semaTraces) > 0 {
 		dumpResourceUsageTable(ctx, semaTraces)
 	}
@@ -1441,6 +1454,72 @@ func histogram(b [7]int) string {
 	return sb.String()
 }
 
+// newSemaTraces returns semaphore traces computed from trace stats.
+func newSemaTraces(tstats []*build.TraceStat) map[string]semaTrace {
+	semaTraces := make(map[string]semaTrace)
+	var rbeWorker, rbeExec *build.TraceStat
+	for _, ts := range tstats {
+		switch {
+		case strings.HasPrefix(ts.Name, "wait:"):
+			name := strings.TrimPrefix(ts.Name, "wait:")
+			t := semaTraces[name]
+			t.name = name
+			t.n = ts.N
+			t.waitAvg = ts.Avg()
+			t.waitBuckets = ts.Buckets
+			semaTraces[name] = t
+		case strings.HasPrefix(ts.Name, "serv:"):
+			name := strings.TrimPrefix(ts.Name, "serv:")
+			t := semaTraces[name]
+			t.name = name
+			t.n = ts.N
+			t.nerr = ts.NErr
+			t.servAvg = ts.Avg()
+			t.servBuckets = ts.Buckets
+			semaTraces[name] = t
+		case ts.Name == "rbe:queue":
+			name := "rbe:sched"
+			t := semaTraces[name]
+			t.name = name
+			t.n = ts.N
+			t.nerr = ts.NErr
+			t.waitAvg = ts.Avg()
+			t.waitBuckets = ts.Buckets
+			semaTraces[name] = t
+		case ts.Name == "rbe:worker":
+			rbeWorker = ts
+		case ts.Name == "rbe:exec":
+			rbeExec = ts
+		}
+	}
+	if rbeWorker != nil {
+		name := "rbe:sched"
+		t := semaTraces[name]
+		t.name = name
+		t.servAvg = rbeWorker.Avg()
+		t.servBuckets = rbeWorker.Buckets
+		semaTraces[name] = t
+	}
+	if rbeWorker != nil && rbeExec != nil {
+		name := "rbe:worker"
+		t := semaTraces[name]
+		t.name = name
+		t.n = rbeExec.N
+		t.waitAvg = rbeWorker.Avg() - rbeExec.Avg()
+		// number of waits would not be correct with this calculation
+		// because it just uses counts in buckets.
+		// not sure how we can measure actual waiting time in buckets,
+		// but this would provide enough estimated values.
+		for i := range rbeWorker.Buckets {
+			t.waitBuckets[i] = rbeWorker.Buckets[i] - rbeExec.Buckets[i]
+		}
+		t.servAvg = rbeExec.Avg()
+		t.servBuckets = rbeExec.Buckets
+		semaTraces[name] = t
+	}
+	return semaTraces
+}
+
 type semaTrace struct {
 	name                     string
 	n, nerr                  int
@@ -1478,17 +1557,31 @@ func (c *ninjaCmdRun) logSymlink(ctx context.Context) error {
 type dataSource struct {
 	cache  build.CacheStore
 	client *reapi.Client
+
+	// localCache is set when local cache is enabled,
+	// to save its stats on close.
+	localCache *build.LocalCache
 }
 
 func (c *ninjaCmdRun) initDataSource(ctx context.Context, credential cred.Cred) (dataSource, error) {
 	if !c.localCacheEnable {
 		c.cacheDir = ""
 	}
+	var maxSize int64
+	if c.localCacheMaxSize != "" {
+		var err error
+		maxSize, err = build.ParseByteSize(c.localCacheMaxSize)
+		if err != nil {
+			return dataSource{}, flagError{err: fmt.Errorf("bad -local_cache_max_size: %w", err)}
+		}
+	}
 	var ds dataSource
-	var err error
-	ds.cache, err = build.NewLocalCache(c.cacheDir)
+	localCache, err := build.NewLocalCache(c.cacheDir, maxSize)
 	if err != nil {
 		clog.Warningf(ctx, "no local cache enabled: %v", err)
+	} else {
+		ds.cache = localCache
+		ds.localCache = localCache
 	}
 	if c.reopt.IsValid() {
 		ds.client, err = reapi.New(ctx, credential, *c.reopt)
@@ -1501,6 +1594,12 @@ func (c *ninjaCmdRun) initDataSource(ctx context.Context, credential cred.Cred)
 }
 
 func (ds dataSource) Close(ctx context.Context) error {
+	if ds.localCache != nil {
+		err := ds.localCache.SaveStats(ctx)
+		if err != nil {
+			clog.Warningf(ctx, "failed to save local cache stats: %v", err)
+		}
+	}
 	if ds.client == nil {
 		return nil
 	}
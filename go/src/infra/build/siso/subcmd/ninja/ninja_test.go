@@ -189,7 +189,7 @@ func setupBuild(ctx context.Context, t *testing.T, dir string, fsopt hashfs.Opti
 
 	graph := ninjabuild.NewGraph(ctx, "build.ninja", nstate, config, path, hashFS, stepConfig, depsLog)
 
-	cachestore, err := build.NewLocalCache(".siso_cache")
+	cachestore, err := build.NewLocalCache(".siso_cache", 0)
 	if err != nil {
 		t.Logf("no local cache enabled: %v", err)
 	}
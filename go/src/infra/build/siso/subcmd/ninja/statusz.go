@@ -6,19 +6,88 @@ package ninja
 
 import (
 	"context"
+	_ "embed"
 	"encoding/json"
 	"fmt"
 	"net"
 	"net/http"
 	"os"
+	"sort"
+	"time"
 
 	"infra/build/siso/build"
 	"infra/build/siso/o11y/clog"
 )
 
-func newStatuszServer(ctx context.Context, b *build.Builder) error {
+//go:embed statusz.html
+var statuszHTML []byte
+
+// statusz is a response of /api/status.
+type statusz struct {
+	build.Status
+	SemaTraces []semaTraceStatus
+}
+
+// semaTraceStatus is a semaTrace for /api/status.
+type semaTraceStatus struct {
+	Name             string
+	N, NErr          int
+	WaitAvg, ServAvg time.Duration
+	WaitBuckets      [7]int
+	ServBuckets      [7]int
+}
+
+func newStatusz(b *build.Builder) statusz {
+	st := statusz{
+		Status: b.Status(),
+	}
+	for _, t := range newSemaTraces(b.TraceStats()) {
+		st.SemaTraces = append(st.SemaTraces, semaTraceStatus{
+			Name:        t.name,
+			N:           t.n,
+			NErr:        t.nerr,
+			WaitAvg:     t.waitAvg,
+			ServAvg:     t.servAvg,
+			WaitBuckets: t.waitBuckets,
+			ServBuckets: t.servBuckets,
+		})
+	}
+	sort.Slice(st.SemaTraces, func(i, j int) bool {
+		return st.SemaTraces[i].Name < st.SemaTraces[j].Name
+	})
+	return st
+}
+
+func newStatuszServer(ctx context.Context, b *build.Builder, addr string) error {
+	if addr == "" {
+		addr = "localhost:0"
+	}
 	mux := http.NewServeMux()
 
+	mux.Handle("/", http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/" {
+			http.NotFound(w, req)
+			return
+		}
+		w.Header().Add("Content-Type", "text/html; charset=utf-8")
+		_, err := w.Write(statuszHTML)
+		if err != nil {
+			clog.Warningf(ctx, "failed to write response: %v", err)
+		}
+	}))
+	mux.Handle("/api/status", http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		buf, err := json.Marshal(newStatusz(b))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("failed to json marshal: %v", err), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Add("Content-Type", "application/json")
+		_, err = w.Write(buf)
+		if err != nil {
+			clog.Warningf(ctx, "failed to write response: %v", err)
+		}
+	}))
+
 	mux.Handle("/api/active_steps", http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
 		activeSteps := b.ActiveSteps()
 		buf, err := json.Marshal(activeSteps)
@@ -35,7 +104,7 @@ func newStatuszServer(ctx context.Context, b *build.Builder) error {
 	s := &http.Server{
 		Handler: mux,
 	}
-	listener, err := net.Listen("tcp", "localhost:0")
+	listener, err := net.Listen("tcp", addr)
 	if err != nil {
 		clog.Warningf(ctx, "listener error: %v", err)
 		return err
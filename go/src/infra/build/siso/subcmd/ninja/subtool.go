@@ -0,0 +1,328 @@
+// Copyright 2024 The Chromium Authors
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package ninja
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"infra/build/siso/toolsupport/ninjautil"
+)
+
+// isManifestTool reports whether subtool only needs the build manifest
+// (and deps log), so it can run without preparing a build.
+func isManifestTool(subtool string) bool {
+	switch subtool {
+	case "compdb", "graph", "missingdeps":
+		return true
+	}
+	return false
+}
+
+// runManifestTool runs a subtool that only reads the build manifest
+// (and deps log), and writes its output to stdout.
+func (c *ninjaCmdRun) runManifestTool(ctx context.Context) error {
+	err := os.Chdir(c.dir)
+	if err != nil {
+		return err
+	}
+	state := ninjautil.NewState()
+	p := ninjautil.NewManifestParser(state)
+	err = p.Load(ctx, c.fname)
+	if err != nil {
+		return err
+	}
+	args := c.Flags.Args()
+	switch c.subtool {
+	case "compdb":
+		cwd, err := os.Getwd()
+		if err != nil {
+			return err
+		}
+		return writeCompdb(os.Stdout, state, cwd, args)
+	case "graph":
+		return writeGraph(os.Stdout, state, args)
+	case "missingdeps":
+		_, err := os.Stat(c.depsLogFile)
+		if err != nil {
+			return fmt.Errorf("no deps log %s. need to build first: %w", c.depsLogFile, err)
+		}
+		depsLog, err := ninjautil.NewDepsLog(ctx, c.depsLogFile)
+		if err != nil {
+			return err
+		}
+		defer depsLog.Close()
+		return checkMissingDeps(ctx, os.Stdout, state, depsLog, args)
+	}
+	return fmt.Errorf("unknown tool %q", c.subtool)
+}
+
+// compdbEntry is an entry of JSON compilation database.
+// https://clang.llvm.org/docs/JSONCompilationDatabase.html
+type compdbEntry struct {
+	Directory string `json:"directory"`
+	Command   string `json:"command"`
+	File      string `json:"file"`
+	Output    string `json:"output"`
+}
+
+// writeCompdb writes JSON compilation database of the steps in state
+// to w, as `ninja -t compdb [rules...]` does.
+// If rules is empty, it writes all steps with inputs.
+func writeCompdb(w io.Writer, state *ninjautil.State, dir string, rules []string) error {
+	ruleSet := make(map[string]bool)
+	for _, r := range rules {
+		ruleSet[r] = true
+	}
+	entries := []compdbEntry{}
+	for _, edge := range state.Edges() {
+		if len(edge.Inputs()) == 0 || edge.IsPhony() {
+			continue
+		}
+		if len(ruleSet) > 0 && !ruleSet[edge.RuleName()] {
+			continue
+		}
+		var output string
+		if outs := edge.Outputs(); len(outs) > 0 {
+			output = outs[0].Path()
+		}
+		entries = append(entries, compdbEntry{
+			Directory: dir,
+			Command:   edge.Binding("command"),
+			File:      edge.Inputs()[0].Path(),
+			Output:    output,
+		})
+	}
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	return enc.Encode(entries)
+}
+
+// writeGraph writes the build graph for targets in graphviz dot format
+// to w, as `ninja -t graph [targets...]` does.
+func writeGraph(w io.Writer, state *ninjautil.State, targets []string) error {
+	nodes, err := state.Targets(targets)
+	if err != nil {
+		return err
+	}
+	g := &graphviz{
+		w:       w,
+		nodes:   make(map[*ninjautil.Node]string),
+		edges:   make(map[*ninjautil.Edge]string),
+		visited: make(map[*ninjautil.Node]bool),
+	}
+	fmt.Fprintf(w, "digraph ninja {\n")
+	fmt.Fprintf(w, "rankdir=\"LR\"\n")
+	fmt.Fprintf(w, "node [fontsize=10, shape=box, height=0.25]\n")
+	fmt.Fprintf(w, "edge [fontsize=10]\n")
+	for _, n := range nodes {
+		g.addTarget(n)
+	}
+	fmt.Fprintf(w, "}\n")
+	return nil
+}
+
+// graphviz writes nodes and edges in dot format.
+// It uses sequential ids rather than pointers (as ninja does)
+// to make the output stable.
+type graphviz struct {
+	w     io.Writer
+	nodes map[*ninjautil.Node]string
+	edges map[*ninjautil.Edge]string
+
+	visited map[*ninjautil.Node]bool
+}
+
+func (g *graphviz) nodeID(n *ninjautil.Node) string {
+	id, ok := g.nodes[n]
+	if ok {
+		return id
+	}
+	id = fmt.Sprintf("n%d", len(g.nodes))
+	g.nodes[n] = id
+	return id
+}
+
+func (g *graphviz) addTarget(n *ninjautil.Node) {
+	if g.visited[n] {
+		return
+	}
+	g.visited[n] = true
+	fmt.Fprintf(g.w, "%q [label=%q]\n", g.nodeID(n), strings.ReplaceAll(n.Path(), `\`, "/"))
+	edge, ok := n.InEdge()
+	if !ok {
+		return
+	}
+	if _, ok := g.edges[edge]; ok {
+		return
+	}
+	edgeID := fmt.Sprintf("e%d", len(g.edges))
+	g.edges[edge] = edgeID
+
+	inputs := edge.Inputs()
+	outputs := edge.Outputs()
+	if len(inputs) == 1 && len(outputs) == 1 {
+		// Note extra space before label text, same as ninja.
+		fmt.Fprintf(g.w, "%q -> %q [label=\" %s\"]\n", g.nodeID(inputs[0]), g.nodeID(outputs[0]), edge.RuleName())
+	} else {
+		fmt.Fprintf(g.w, "%q [label=%q, shape=ellipse]\n", edgeID, edge.RuleName())
+		for _, out := range outputs {
+			fmt.Fprintf(g.w, "%q -> %q\n", edgeID, g.nodeID(out))
+		}
+		numTriggers := len(edge.TriggerInputs())
+		for i, in := range inputs {
+			var orderOnly string
+			if i >= numTriggers {
+				orderOnly = " style=dotted"
+			}
+			fmt.Fprintf(g.w, "%q -> %q [arrowhead=none%s]\n", g.nodeID(in), edgeID, orderOnly)
+		}
+	}
+	for _, in := range inputs {
+		g.addTarget(in)
+	}
+}
+
+// errMissingDeps is returned when missingdeps found missing dependencies.
+var errMissingDeps = errors.New("missing dependencies on generated files found")
+
+// checkMissingDeps checks deps in deps log for targets, and reports
+// generated inputs that has no dependency path in the build graph
+// from the step generating the input to the step using it,
+// as `ninja -t missingdeps [targets...]` does.
+//
+// https://github.com/ninja-build/ninja/blob/a524bf3f6bacd1b4ad85d719eed2737d8562f27a/src/missing_deps.cc
+func checkMissingDeps(ctx context.Context, w io.Writer, state *ninjautil.State, depsLog *ninjautil.DepsLog, targets []string) error {
+	nodes, err := state.Targets(targets)
+	if err != nil {
+		return err
+	}
+	s := &missingDepsScanner{
+		w:              w,
+		state:          state,
+		depsLog:        depsLog,
+		seen:           make(map[*ninjautil.Node]bool),
+		paths:          make(map[edgePair]bool),
+		nodesMissing:   make(map[*ninjautil.Node]bool),
+		generatedNodes: make(map[*ninjautil.Node]bool),
+		generatorRules: make(map[string]bool),
+	}
+	for _, n := range nodes {
+		s.processNode(ctx, n)
+	}
+	fmt.Fprintf(w, "Processed %d nodes.\n", len(s.seen))
+	if s.missingPathCount == 0 {
+		fmt.Fprintf(w, "No missing dependencies on generated files found.\n")
+		return nil
+	}
+	fmt.Fprintf(w, "Error: There are %d missing dependency paths.\n", s.missingPathCount)
+	fmt.Fprintf(w, "%d targets had depfile dependencies on %d distinct generated inputs (from %d rules) without a non-depfile dep path to the generator.\n", len(s.nodesMissing), len(s.generatedNodes), len(s.generatorRules))
+	fmt.Fprintf(w, "There might be build flakiness if any of the targets listed above are built alone, or not late enough, in a clean output directory.\n")
+	return errMissingDeps
+}
+
+type edgePair struct {
+	from, to *ninjautil.Edge
+}
+
+type missingDepsScanner struct {
+	w       io.Writer
+	state   *ninjautil.State
+	depsLog *ninjautil.DepsLog
+
+	seen map[*ninjautil.Node]bool
+
+	// paths caches whether a path exists between edges.
+	paths map[edgePair]bool
+
+	missingPathCount int
+	nodesMissing     map[*ninjautil.Node]bool
+	generatedNodes   map[*ninjautil.Node]bool
+	generatorRules   map[string]bool
+}
+
+func (s *missingDepsScanner) processNode(ctx context.Context, n *ninjautil.Node) {
+	if s.seen[n] {
+		return
+	}
+	s.seen[n] = true
+	edge, ok := n.InEdge()
+	if !ok {
+		return
+	}
+	for _, in := range edge.Inputs() {
+		s.processNode(ctx, in)
+	}
+	if edge.IsPhony() {
+		return
+	}
+	deps, _, err := s.depsLog.Get(ctx, n.Path())
+	if err != nil {
+		return
+	}
+	var depNodes []*ninjautil.Node
+	for _, dep := range deps {
+		// A dep on build.ninja is used to mean "always rebuild
+		// when the build is reconfigured", so it is not a missing
+		// dependency.
+		if dep == "build.ninja" {
+			return
+		}
+		dn, ok := s.state.LookupNode(dep)
+		if !ok {
+			continue
+		}
+		depNodes = append(depNodes, dn)
+	}
+	missingRules := make(map[string]bool)
+	checked := make(map[*ninjautil.Edge]bool)
+	for _, dn := range depNodes {
+		gen, ok := dn.InEdge()
+		if !ok {
+			continue
+		}
+		missing, ok := checked[gen]
+		if !ok {
+			missing = !s.pathExists(gen, edge)
+			checked[gen] = missing
+		}
+		if !missing {
+			continue
+		}
+		s.generatedNodes[dn] = true
+		s.generatorRules[gen.RuleName()] = true
+		missingRules[gen.RuleName()] = true
+		fmt.Fprintf(s.w, "Missing dep: %s uses %s (generated by %s)\n", n.Path(), dn.Path(), gen.RuleName())
+	}
+	if len(missingRules) > 0 {
+		s.missingPathCount += len(missingRules)
+		s.nodesMissing[n] = true
+	}
+}
+
+// pathExists reports whether edge `to` depends on edge `from`
+// via inputs in the build graph.
+func (s *missingDepsScanner) pathExists(from, to *ninjautil.Edge) bool {
+	key := edgePair{from: from, to: to}
+	found, ok := s.paths[key]
+	if ok {
+		return found
+	}
+	for _, in := range to.Inputs() {
+		e, ok := in.InEdge()
+		if ok && (e == from || s.pathExists(from, e)) {
+			found = true
+			break
+		}
+	}
+	s.paths[key] = found
+	return found
+}
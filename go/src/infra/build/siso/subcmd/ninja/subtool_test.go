@@ -0,0 +1,169 @@
+// Copyright 2024 The Chromium Authors
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package ninja
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"infra/build/siso/toolsupport/ninjautil"
+)
+
+const subtoolTestManifest = `
+rule cxx
+  command = clang++ -c ${in} -o ${out}
+  deps = gcc
+  depfile = ${out}.d
+
+rule gen
+  command = gen ${in} ${out}
+
+rule link
+  command = clang++ -o ${out} ${in}
+
+build gen/foo.h: gen ../../foo.h.in
+build gen/bar.h: gen ../../bar.h.in
+
+build obj/foo.o: cxx ../../foo.cc || gen/foo.h
+build obj/bar.o: cxx ../../bar.cc
+
+build app: link obj/foo.o obj/bar.o
+
+build all: phony app
+`
+
+func loadSubtoolTestState(t *testing.T) *ninjautil.State {
+	t.Helper()
+	ctx := context.Background()
+	fname := filepath.Join(t.TempDir(), "build.ninja")
+	err := os.WriteFile(fname, []byte(subtoolTestManifest), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	state := ninjautil.NewState()
+	p := ninjautil.NewManifestParser(state)
+	err = p.Load(ctx, fname)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return state
+}
+
+func TestWriteCompdb(t *testing.T) {
+	state := loadSubtoolTestState(t)
+
+	var buf bytes.Buffer
+	err := writeCompdb(&buf, state, "/b/out/siso", []string{"cxx"})
+	if err != nil {
+		t.Fatalf("writeCompdb(...)=%v; want nil error", err)
+	}
+	var got []compdbEntry
+	err = json.Unmarshal(buf.Bytes(), &got)
+	if err != nil {
+		t.Fatalf("unmarshal %q: %v", buf.String(), err)
+	}
+	want := []compdbEntry{
+		{
+			Directory: "/b/out/siso",
+			Command:   "clang++ -c ../../foo.cc -o obj/foo.o",
+			File:      "../../foo.cc",
+			Output:    "obj/foo.o",
+		},
+		{
+			Directory: "/b/out/siso",
+			Command:   "clang++ -c ../../bar.cc -o obj/bar.o",
+			File:      "../../bar.cc",
+			Output:    "obj/bar.o",
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("writeCompdb(...) diff -want +got:\n%s", diff)
+	}
+}
+
+func TestWriteGraph(t *testing.T) {
+	state := loadSubtoolTestState(t)
+
+	var buf bytes.Buffer
+	err := writeGraph(&buf, state, []string{"obj/foo.o"})
+	if err != nil {
+		t.Fatalf("writeGraph(...)=%v; want nil error", err)
+	}
+	want := `digraph ninja {
+rankdir="LR"
+node [fontsize=10, shape=box, height=0.25]
+edge [fontsize=10]
+"n0" [label="obj/foo.o"]
+"e0" [label="cxx", shape=ellipse]
+"e0" -> "n0"
+"n1" -> "e0" [arrowhead=none]
+"n2" -> "e0" [arrowhead=none style=dotted]
+"n1" [label="../../foo.cc"]
+"n2" [label="gen/foo.h"]
+"n3" -> "n2" [label=" gen"]
+"n3" [label="../../foo.h.in"]
+}
+`
+	if diff := cmp.Diff(want, buf.String()); diff != "" {
+		t.Errorf("writeGraph(...) diff -want +got:\n%s", diff)
+	}
+}
+
+func TestCheckMissingDeps(t *testing.T) {
+	ctx := context.Background()
+	state := loadSubtoolTestState(t)
+
+	fname := filepath.Join(t.TempDir(), ".siso_deps")
+	depsLog, err := ninjautil.NewDepsLog(ctx, fname)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mtime := time.Unix(1, 0)
+	for output, deps := range map[string][]string{
+		"obj/foo.o": {"../../foo.cc", "gen/foo.h"},
+		"obj/bar.o": {"../../bar.cc", "gen/bar.h"},
+	} {
+		_, err := depsLog.Record(ctx, output, mtime, deps)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	err = depsLog.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	depsLog, err = ninjautil.NewDepsLog(ctx, fname)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer depsLog.Close()
+
+	var buf bytes.Buffer
+	err = checkMissingDeps(ctx, &buf, state, depsLog, []string{"all"})
+	if !errors.Is(err, errMissingDeps) {
+		t.Errorf("checkMissingDeps(...)=%v; want %v", err, errMissingDeps)
+	}
+	got := buf.String()
+	for _, want := range []string{
+		"Missing dep: obj/bar.o uses gen/bar.h (generated by gen)\n",
+		"Error: There are 1 missing dependency paths.\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("checkMissingDeps(...) output=%q; want to contain %q", got, want)
+		}
+	}
+	if strings.Contains(got, "uses gen/foo.h") {
+		t.Errorf("checkMissingDeps(...) output=%q; gen/foo.h should not be reported", got)
+	}
+}
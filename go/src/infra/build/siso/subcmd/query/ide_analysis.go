@@ -312,13 +312,21 @@ func (a *ideAnalyzer) analyzeCPP(ctx context.Context, edge *ninjautil.Edge, resu
 	for i := range params.Sysroots {
 		params.Sysroots[i] = a.path.MaybeFromWD(ctx, params.Sysroots[i])
 	}
+	for name, bmi := range params.ModuleFiles {
+		params.ModuleFiles[name] = a.path.MaybeFromWD(ctx, bmi)
+	}
+	for i := range params.ModuleDirs {
+		params.ModuleDirs[i] = a.path.MaybeFromWD(ctx, params.ModuleDirs[i])
+	}
 	req := scandeps.Request{
-		Defines:    params.Defines,
-		Sources:    params.Sources,
-		Includes:   params.Includes,
-		Dirs:       params.Dirs,
-		Frameworks: params.Frameworks,
-		Sysroots:   params.Sysroots,
+		Defines:     params.Defines,
+		Sources:     params.Sources,
+		Includes:    params.Includes,
+		Dirs:        params.Dirs,
+		Frameworks:  params.Frameworks,
+		Sysroots:    params.Sysroots,
+		ModuleFiles: params.ModuleFiles,
+		ModuleDirs:  params.ModuleDirs,
 	}
 	started := time.Now()
 	clog.Infof(ctx, "scandeps %#v", req)
@@ -32,6 +32,12 @@ type ScanDepsParams struct {
 
 	// Defines are defined macros.
 	Defines map[string]string
+
+	// ModuleFiles are C++20 module name to BMI path mapping.
+	ModuleFiles map[string]string
+
+	// ModuleDirs are prebuilt module paths.
+	ModuleDirs []string
 }
 
 // ExtractScanDepsParams parses args and returns ScanDepsParams for scandeps.
@@ -100,11 +106,30 @@ func ExtractScanDepsParams(ctx context.Context, args, env []string) ScanDepsPara
 			res.Sysroots = append(res.Sysroots, strings.TrimPrefix(arg, "--sysroot="))
 		case strings.HasPrefix(arg, "-D"):
 			defineMacro(res.Defines, strings.TrimPrefix(arg, "-D"))
+		case strings.HasPrefix(arg, "-fmodule-file="):
+			// -fmodule-file=<name>=<path> or -fmodule-file=<path>
+			v := strings.TrimPrefix(arg, "-fmodule-file=")
+			name, bmi, ok := strings.Cut(v, "=")
+			if !ok {
+				// module name is unknown (e.g. header unit),
+				// so always use it as input.
+				res.Files = append(res.Files, v)
+				break
+			}
+			if res.ModuleFiles == nil {
+				res.ModuleFiles = make(map[string]string)
+			}
+			res.ModuleFiles[name] = bmi
+		case strings.HasPrefix(arg, "-fprebuilt-module-path="):
+			res.ModuleDirs = append(res.ModuleDirs, strings.TrimPrefix(arg, "-fprebuilt-module-path="))
+		case strings.HasPrefix(arg, "-fmodule-map-file="):
+			res.Files = append(res.Files, strings.TrimPrefix(arg, "-fmodule-map-file="))
 
 		case !strings.HasPrefix(arg, "-"):
 			ext := filepath.Ext(arg)
 			switch ext {
-			case ".c", ".cc", ".cxx", ".cpp", ".m", ".mm", ".S":
+			case ".c", ".cc", ".cxx", ".cpp", ".m", ".mm", ".S",
+				".cppm", ".cxxm", ".c++m", ".ccm", ".ixx":
 				res.Sources = append(res.Sources, arg)
 			}
 		}
@@ -227,6 +227,49 @@ func TestScanDepsParams(t *testing.T) {
 				Defines: map[string]string{},
 			},
 		},
+		{
+			name: "clang++-modules",
+			args: []string{
+				"../../third_party/llvm-build/Release+Asserts/bin/clang++",
+				"-MMD",
+				"-MF",
+				"obj/apps/apps/apps.o.d",
+				"-std=c++20",
+				"-I../..",
+				"-fmodule-file=base=obj/base/base.pcm",
+				"-fmodule-file=base:strings=obj/base/base-strings.pcm",
+				"-fmodule-file=gen/vector.pcm",
+				"-fprebuilt-module-path=obj/modules",
+				"-fmodule-map-file=../../build/module.modulemap",
+				"-c",
+				"../../apps/apps.cppm",
+				"-o",
+				"obj/apps/apps/apps.o",
+			},
+			want: ScanDepsParams{
+				Sources: []string{
+					"../../apps/apps.cppm",
+				},
+				Files: []string{
+					"gen/vector.pcm",
+					"../../build/module.modulemap",
+				},
+				Dirs: []string{
+					"../..",
+				},
+				Sysroots: []string{
+					"../../third_party/llvm-build/Release+Asserts",
+				},
+				Defines: map[string]string{},
+				ModuleFiles: map[string]string{
+					"base":         "obj/base/base.pcm",
+					"base:strings": "obj/base/base-strings.pcm",
+				},
+				ModuleDirs: []string{
+					"obj/modules",
+				},
+			},
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			got := ExtractScanDepsParams(ctx, tc.args, tc.env)
@@ -108,6 +108,11 @@ func (s *State) addEdge(rule *rule) *Edge {
 	return edge
 }
 
+// Edges returns all edges in the state, in the order of the manifest.
+func (s *State) Edges() []*Edge {
+	return s.edges
+}
+
 // node returns a node.
 func (s *State) node(path []byte) *Node {
 	n, ok := s.paths[string(path)]
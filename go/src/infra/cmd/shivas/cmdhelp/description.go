@@ -1609,6 +1609,11 @@ https://chromium.googlesource.com/infra/infra/+/refs/heads/main/go/src/infra/uni
 -keys -json -noemit prints JSON object with only name/id field.
 Operation will be faster as only name/id will be retrieved from the service.`
 
+	// AsOfText help text for asOf option
+	AsOfText string = `get the entities as they were at the given time, reconstructed from the change history.
+Accepts RFC 3339 (e.g. 2024-01-02T15:04:05Z) or a date (YYYY-MM-DD) in UTC.
+Cannot be used with filters.`
+
 	// ShowIPsText help text for list all occupied/reserved IPs option
 	ShowIPsText string = `list all occupied/reserved IPs when querying a single Vlan`
 
@@ -0,0 +1,391 @@
+// Copyright 2024 The Chromium Authors
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package declarative
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"go.chromium.org/luci/common/errors"
+
+	ufspb "infra/unifiedfleet/api/v1/models"
+	ufsAPI "infra/unifiedfleet/api/v1/rpc"
+	ufsUtil "infra/unifiedfleet/app/util"
+)
+
+// kind is a kind of UFS entity managed by the declarative workflow.
+type kind struct {
+	name       string
+	collection string
+	// get returns the existing entity, or nil if it doesn't exist.
+	get    func(ctx context.Context, ic ufsAPI.FleetClient, name string) (proto.Message, error)
+	create func(ctx context.Context, ic ufsAPI.FleetClient, pm proto.Message) error
+	update func(ctx context.Context, ic ufsAPI.FleetClient, pm proto.Message) error
+}
+
+var (
+	rackKind = &kind{
+		name:       "rack",
+		collection: ufsUtil.RackCollection,
+		get: func(ctx context.Context, ic ufsAPI.FleetClient, name string) (proto.Message, error) {
+			res, err := ic.GetRack(ctx, &ufsAPI.GetRackRequest{
+				Name: ufsUtil.AddPrefix(ufsUtil.RackCollection, name),
+			})
+			return notFoundAsNil(res, err)
+		},
+		create: func(ctx context.Context, ic ufsAPI.FleetClient, pm proto.Message) error {
+			_, err := ic.RackRegistration(ctx, &ufsAPI.RackRegistrationRequest{
+				Rack: pm.(*ufspb.Rack),
+			})
+			return err
+		},
+		update: func(ctx context.Context, ic ufsAPI.FleetClient, pm proto.Message) error {
+			r := proto.Clone(pm).(*ufspb.Rack)
+			r.Name = ufsUtil.AddPrefix(ufsUtil.RackCollection, r.Name)
+			_, err := ic.UpdateRack(ctx, &ufsAPI.UpdateRackRequest{Rack: r})
+			return err
+		},
+	}
+	machineKind = &kind{
+		name:       "machine",
+		collection: ufsUtil.MachineCollection,
+		get: func(ctx context.Context, ic ufsAPI.FleetClient, name string) (proto.Message, error) {
+			res, err := ic.GetMachine(ctx, &ufsAPI.GetMachineRequest{
+				Name: ufsUtil.AddPrefix(ufsUtil.MachineCollection, name),
+			})
+			return notFoundAsNil(res, err)
+		},
+		create: func(ctx context.Context, ic ufsAPI.FleetClient, pm proto.Message) error {
+			_, err := ic.MachineRegistration(ctx, &ufsAPI.MachineRegistrationRequest{
+				Machine: pm.(*ufspb.Machine),
+			})
+			return err
+		},
+		update: func(ctx context.Context, ic ufsAPI.FleetClient, pm proto.Message) error {
+			m := proto.Clone(pm).(*ufspb.Machine)
+			m.Name = ufsUtil.AddPrefix(ufsUtil.MachineCollection, m.Name)
+			_, err := ic.UpdateMachine(ctx, &ufsAPI.UpdateMachineRequest{Machine: m})
+			return err
+		},
+	}
+	hostKind = &kind{
+		name:       "host",
+		collection: ufsUtil.MachineLSECollection,
+		get:        getMachineLSE,
+		create:     createMachineLSE,
+		update:     updateMachineLSE,
+	}
+	dutKind = &kind{
+		name:       "dut",
+		collection: ufsUtil.MachineLSECollection,
+		get:        getMachineLSE,
+		create:     createMachineLSE,
+		update:     updateMachineLSE,
+	}
+)
+
+// kinds lists the kinds in the order they are applied, so that an entity is
+// created after the entities it refers to.
+var kinds = []*kind{rackKind, machineKind, hostKind, dutKind}
+
+func getMachineLSE(ctx context.Context, ic ufsAPI.FleetClient, name string) (proto.Message, error) {
+	res, err := ic.GetMachineLSE(ctx, &ufsAPI.GetMachineLSERequest{
+		Name: ufsUtil.AddPrefix(ufsUtil.MachineLSECollection, name),
+	})
+	return notFoundAsNil(res, err)
+}
+
+func createMachineLSE(ctx context.Context, ic ufsAPI.FleetClient, pm proto.Message) error {
+	lse := pm.(*ufspb.MachineLSE)
+	_, err := ic.CreateMachineLSE(ctx, &ufsAPI.CreateMachineLSERequest{
+		MachineLSE:   lse,
+		MachineLSEId: lse.GetName(),
+	})
+	return err
+}
+
+func updateMachineLSE(ctx context.Context, ic ufsAPI.FleetClient, pm proto.Message) error {
+	lse := proto.Clone(pm).(*ufspb.MachineLSE)
+	lse.Name = ufsUtil.AddPrefix(ufsUtil.MachineLSECollection, lse.Name)
+	_, err := ic.UpdateMachineLSE(ctx, &ufsAPI.UpdateMachineLSERequest{MachineLSE: lse})
+	return err
+}
+
+// notFoundAsNil returns nil entity and nil error if err is NotFound.
+func notFoundAsNil(res proto.Message, err error) (proto.Message, error) {
+	if status.Code(err) == codes.NotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// action is an action to take on an entity.
+type action string
+
+const (
+	actionCreate action = "create"
+	actionUpdate action = "update"
+)
+
+// change is a change to apply to UFS.
+type change struct {
+	kind   *kind
+	action action
+	name   string
+	// fields are the changed fields of an update.
+	fields []fieldChange
+	// entity is the entity to create, or the existing entity with the
+	// desired fields applied for an update.
+	entity proto.Message
+}
+
+// key identifies the changed entity in the journal.
+//
+// It doesn't include the action, as an entity created before an apply was
+// stopped is seen as an update (or no change) when the apply is resumed.
+func (c *change) key() string {
+	return fmt.Sprintf("%s %s", c.kind.name, c.name)
+}
+
+func (c *change) String() string {
+	return fmt.Sprintf("%s %s", c.action, c.key())
+}
+
+func (c *change) apply(ctx context.Context, ic ufsAPI.FleetClient) error {
+	switch c.action {
+	case actionCreate:
+		return c.kind.create(ctx, ic, c.entity)
+	case actionUpdate:
+		return c.kind.update(ctx, ic, c.entity)
+	}
+	return errors.Reason("unknown action %q", c.action).Err()
+}
+
+// fieldChange is a change of a leaf field of an entity.
+//
+// path is the dotted JSON path of the field, e.g. "location.zone".
+type fieldChange struct {
+	path string
+	old  string
+	new  string
+}
+
+// plan is the list of changes to bring UFS to the desired state.
+type plan struct {
+	changes   []*change
+	unchanged int
+}
+
+// computePlan diffs the desired state against the entities in UFS.
+func computePlan(ctx context.Context, ic ufsAPI.FleetClient, s *desiredState) (*plan, error) {
+	p := &plan{}
+	for _, k := range kinds {
+		for _, desired := range s.entities(k) {
+			name := entityName(desired)
+			current, err := k.get(ctx, ic, name)
+			if err != nil {
+				return nil, errors.Annotate(err, "get %s %s", k.name, name).Err()
+			}
+			if current == nil {
+				p.changes = append(p.changes, &change{
+					kind:   k,
+					action: actionCreate,
+					name:   name,
+					entity: desired,
+				})
+				continue
+			}
+			current = proto.Clone(current)
+			setEntityName(current, ufsUtil.RemovePrefix(entityName(current)))
+			fields, err := diffEntity(current, desired)
+			if err != nil {
+				return nil, errors.Annotate(err, "diff %s %s", k.name, name).Err()
+			}
+			if len(fields) == 0 {
+				p.unchanged++
+				continue
+			}
+			p.changes = append(p.changes, &change{
+				kind:   k,
+				action: actionUpdate,
+				name:   name,
+				fields: fields,
+				entity: mergeEntity(current, desired),
+			})
+		}
+	}
+	return p, nil
+}
+
+// print prints the plan in a human readable form.
+func (p *plan) print(w io.Writer) {
+	creates, updates := 0, 0
+	for _, c := range p.changes {
+		switch c.action {
+		case actionCreate:
+			creates++
+			fmt.Fprintf(w, "+ %s\n", c)
+		case actionUpdate:
+			updates++
+			fmt.Fprintf(w, "~ %s\n", c)
+			for _, f := range c.fields {
+				fmt.Fprintf(w, "    %s: %s -> %s\n", f.path, f.old, f.new)
+			}
+		}
+	}
+	fmt.Fprintf(w, "Plan: %d to create, %d to update, %d unchanged.\n", creates, updates, p.unchanged)
+}
+
+// ignoredFields are not compared, as they identify the entity or are
+// set by UFS.
+var ignoredFields = map[protoreflect.Name]bool{
+	"name":        true,
+	"update_time": true,
+}
+
+// diffEntity returns the leaf fields set in desired that differ in current.
+func diffEntity(current, desired proto.Message) ([]fieldChange, error) {
+	fields, err := diffMessage(proto.MessageReflect(current), proto.MessageReflect(desired), "")
+	if err != nil {
+		return nil, err
+	}
+	// Range visits fields in undefined order.
+	sort.Slice(fields, func(i, j int) bool {
+		return fields[i].path < fields[j].path
+	})
+	return fields, nil
+}
+
+// diffMessage returns the leaf fields set in dm that differ in cm, with paths
+// prefixed by prefix.
+//
+// Singular message fields are compared field by field, so that only the set
+// fields of nested messages are managed. Other fields, including lists and
+// maps, are compared as a whole.
+func diffMessage(cm, dm protoreflect.Message, prefix string) ([]fieldChange, error) {
+	var fields []fieldChange
+	var err error
+	dm.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if (prefix == "" && ignoredFields[fd.Name()]) || fieldEqual(cm, dm, fd) {
+			return true
+		}
+		path := prefix + fd.JSONName()
+		if isNestedMessage(fd) {
+			var nested []fieldChange
+			if nested, err = diffMessage(cm.Get(fd).Message(), v.Message(), path+"."); err != nil {
+				return false
+			}
+			fields = append(fields, nested...)
+			return true
+		}
+		fc := fieldChange{path: path}
+		if fc.old, err = formatField(cm, fd); err != nil {
+			return false
+		}
+		if fc.new, err = formatField(dm, fd); err != nil {
+			return false
+		}
+		fields = append(fields, fc)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+// isNestedMessage reports whether fd is a singular message field, which is
+// diffed and merged recursively.
+func isNestedMessage(fd protoreflect.FieldDescriptor) bool {
+	return fd.Message() != nil && !fd.IsList() && !fd.IsMap()
+}
+
+// fieldEqual reports whether the field fd is the same in a and b.
+func fieldEqual(a, b protoreflect.Message, fd protoreflect.FieldDescriptor) bool {
+	return proto.Equal(proto.MessageV1(fieldOnly(a, fd).Interface()), proto.MessageV1(fieldOnly(b, fd).Interface()))
+}
+
+// fieldOnly returns a message of the same type as m with only fd set.
+func fieldOnly(m protoreflect.Message, fd protoreflect.FieldDescriptor) protoreflect.Message {
+	f := m.Type().New()
+	if m.Has(fd) {
+		f.Set(fd, m.Get(fd))
+	}
+	return f
+}
+
+// formatField formats the field fd of m in JSON.
+func formatField(m protoreflect.Message, fd protoreflect.FieldDescriptor) (string, error) {
+	if !m.Has(fd) {
+		return "<unset>", nil
+	}
+	b, err := protojson.Marshal(fieldOnly(m, fd).Interface())
+	if err != nil {
+		return "", err
+	}
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal(b, &obj); err != nil {
+		return "", err
+	}
+	// protojson output is deliberately unstable, compact it.
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, obj[fd.JSONName()]); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// mergeEntity returns a copy of current with the leaf fields set in desired
+// replaced.
+func mergeEntity(current, desired proto.Message) proto.Message {
+	merged := proto.Clone(current)
+	mergeMessage(proto.MessageReflect(merged), proto.MessageReflect(proto.Clone(desired)), true)
+	return merged
+}
+
+// mergeMessage replaces the fields of mm set in dm, merging singular message
+// fields recursively. Lists and maps are replaced as a whole, unlike
+// proto.Merge, which would append to them.
+func mergeMessage(mm, dm protoreflect.Message, top bool) {
+	dm.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case top && ignoredFields[fd.Name()]:
+		case isNestedMessage(fd):
+			mergeMessage(mm.Mutable(fd).Message(), v.Message(), false)
+		default:
+			mm.Set(fd, v)
+		}
+		return true
+	})
+}
+
+func entityName(pm proto.Message) string {
+	m := proto.MessageReflect(pm)
+	fd := m.Descriptor().Fields().ByName("name")
+	if fd == nil {
+		return ""
+	}
+	return m.Get(fd).String()
+}
+
+func setEntityName(pm proto.Message, name string) {
+	m := proto.MessageReflect(pm)
+	if fd := m.Descriptor().Fields().ByName("name"); fd != nil {
+		m.Set(fd, protoreflect.ValueOfString(name))
+	}
+}
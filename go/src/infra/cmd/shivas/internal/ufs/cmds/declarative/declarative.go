@@ -0,0 +1,258 @@
+// Copyright 2024 The Chromium Authors
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+// Package declarative implements `shivas plan` and `shivas apply`, which
+// manage racks, machines, hosts and DUTs from a desired state file.
+package declarative
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/maruel/subcommands"
+
+	"go.chromium.org/luci/auth/client/authcli"
+	"go.chromium.org/luci/common/cli"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/grpc/prpc"
+
+	"infra/cmd/shivas/site"
+	"infra/cmd/shivas/utils"
+	"infra/cmdsupport/cmdlib"
+	ufsAPI "infra/unifiedfleet/api/v1/rpc"
+)
+
+const stateFileHelp = `Path to the desired state file in YAML.
+
+The file lists the racks, machines, hosts and duts to manage, with the same
+fields as the JSON specs files of the add/update commands:
+
+racks:
+- name: rack1
+  location:
+    zone: ZONE_CHROMEOS6
+machines:
+- name: machine1
+  serialNumber: abc123
+hosts:
+- name: host1
+  machines: [machine1]
+
+Only the fields set in the file are managed, including the fields of nested
+messages: setting location.zone keeps the rest of the location. Lists and maps
+are managed as a whole. Entities missing from the file are never deleted.`
+
+// PlanCmd prints the changes to bring UFS to the desired state.
+var PlanCmd = &subcommands.Command{
+	UsageLine: "plan -f lab.yaml",
+	ShortDesc: "Preview the changes to bring UFS to a desired state",
+	LongDesc: `Preview the changes to bring UFS to a desired state.
+
+Diffs the racks, machines, hosts and DUTs in the desired state file against
+UFS and prints the entities to create and the fields to update.
+Nothing is changed in UFS, use 'shivas apply' to apply the changes.
+
+Example:
+
+shivas plan -f lab.yaml`,
+	CommandRun: func() subcommands.CommandRun {
+		c := &planRun{}
+		c.register()
+		return c
+	},
+}
+
+// ApplyCmd applies the changes to bring UFS to the desired state.
+var ApplyCmd = &subcommands.Command{
+	UsageLine: "apply -f lab.yaml",
+	ShortDesc: "Apply the changes to bring UFS to a desired state",
+	LongDesc: `Apply the changes to bring UFS to a desired state.
+
+Computes the same changes as 'shivas plan', then applies them in dependency
+order: racks, machines, hosts and then DUTs.
+
+Apply stops at the first error. The applied changes are recorded in a journal
+file (lab.yaml.journal by default), so that running the same command again
+after fixing the error resumes where it stopped. The journal is removed once
+all the changes are applied.
+
+Example:
+
+shivas apply -f lab.yaml
+
+shivas apply -f lab.yaml -yes`,
+	CommandRun: func() subcommands.CommandRun {
+		c := &applyRun{}
+		c.register()
+		c.Flags.StringVar(&c.journalPath, "journal", "", "Path to the journal file. Defaults to the state file path with a .journal suffix.")
+		c.Flags.BoolVar(&c.skipYes, "yes", false, "Skip yes option by saying yes.")
+		return c
+	},
+}
+
+// commonRun has the flags shared by plan and apply.
+type commonRun struct {
+	subcommands.CommandRunBase
+	authFlags   authcli.Flags
+	envFlags    site.EnvFlags
+	commonFlags site.CommonFlags
+
+	stateFile string
+}
+
+func (c *commonRun) register() {
+	c.authFlags.Register(&c.Flags, site.DefaultAuthOptions)
+	c.envFlags.Register(&c.Flags)
+	c.commonFlags.Register(&c.Flags)
+	c.Flags.StringVar(&c.stateFile, "f", "", stateFileHelp)
+}
+
+func (c *commonRun) validateArgs(args []string) error {
+	if c.stateFile == "" {
+		return cmdlib.NewUsageError(c.Flags, "-f is required")
+	}
+	if len(args) > 0 {
+		return cmdlib.NewUsageError(c.Flags, "unexpected positional arguments: %q", args)
+	}
+	return nil
+}
+
+// plan reads the state file and computes the changes.
+func (c *commonRun) plan(ctx context.Context) (ufsAPI.FleetClient, *desiredState, *plan, error) {
+	s, err := readDesiredState(c.stateFile)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	hc, err := cmdlib.NewHTTPClient(ctx, &c.authFlags)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	e := c.envFlags.Env()
+	if c.commonFlags.Verbose() {
+		fmt.Printf("Using UFS service %s\n", e.UnifiedFleetService)
+	}
+	ic := ufsAPI.NewFleetPRPCClient(&prpc.Client{
+		C:       hc,
+		Host:    e.UnifiedFleetService,
+		Options: site.DefaultPRPCOptions,
+	})
+	p, err := computePlan(ctx, ic, s)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return ic, s, p, nil
+}
+
+func (c *commonRun) context(a subcommands.Application, env subcommands.Env) (context.Context, error) {
+	ctx := cli.GetContext(a, c, env)
+	ns, err := c.envFlags.Namespace(nil, "")
+	if err != nil {
+		return nil, err
+	}
+	return utils.SetupContext(ctx, ns), nil
+}
+
+type planRun struct {
+	commonRun
+}
+
+func (c *planRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
+	if err := c.innerRun(a, args, env); err != nil {
+		cmdlib.PrintError(a, err)
+		return 1
+	}
+	return 0
+}
+
+func (c *planRun) innerRun(a subcommands.Application, args []string, env subcommands.Env) error {
+	if err := c.validateArgs(args); err != nil {
+		return err
+	}
+	ctx, err := c.context(a, env)
+	if err != nil {
+		return err
+	}
+	_, _, p, err := c.plan(ctx)
+	if err != nil {
+		return err
+	}
+	p.print(a.GetOut())
+	return nil
+}
+
+type applyRun struct {
+	commonRun
+
+	journalPath string
+	skipYes     bool
+}
+
+func (c *applyRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
+	if err := c.innerRun(a, args, env); err != nil {
+		cmdlib.PrintError(a, err)
+		return 1
+	}
+	return 0
+}
+
+func (c *applyRun) innerRun(a subcommands.Application, args []string, env subcommands.Env) error {
+	if err := c.validateArgs(args); err != nil {
+		return err
+	}
+	ctx, err := c.context(a, env)
+	if err != nil {
+		return err
+	}
+	ic, s, p, err := c.plan(ctx)
+	if err != nil {
+		return err
+	}
+	p.print(a.GetOut())
+	if len(p.changes) == 0 {
+		return nil
+	}
+	if !c.skipYes {
+		prompt := utils.CLIPrompt(a.GetOut(), os.Stdin, false)
+		if prompt != nil && !prompt("Are you sure you want to apply the changes?") {
+			return nil
+		}
+	}
+	journalPath := c.journalPath
+	if journalPath == "" {
+		journalPath = c.stateFile + ".journal"
+	}
+	j, err := openJournal(journalPath, s.digest)
+	if err != nil {
+		return err
+	}
+	err = applyPlan(ctx, ic, p, j, a.GetOut())
+	if cerr := j.close(err == nil); cerr != nil && err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return errors.Annotate(err, "apply stopped; fix the error and run the same command to resume from %s", journalPath).Err()
+	}
+	return nil
+}
+
+// applyPlan applies the changes of the plan in order, skipping the changes
+// already recorded in the journal. It stops at the first error.
+func applyPlan(ctx context.Context, ic ufsAPI.FleetClient, p *plan, j *journal, w io.Writer) error {
+	for _, c := range p.changes {
+		if j.applied(c) {
+			fmt.Fprintf(w, "Skipped %s: already applied\n", c)
+			continue
+		}
+		if err := c.apply(ctx, ic); err != nil {
+			return errors.Annotate(err, "%s", c).Err()
+		}
+		if err := j.record(c); err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "Applied %s\n", c)
+	}
+	return nil
+}
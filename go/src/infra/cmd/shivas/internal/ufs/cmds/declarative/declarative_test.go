@@ -0,0 +1,358 @@
+// Copyright 2024 The Chromium Authors
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package declarative
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	. "go.chromium.org/luci/common/testing/assertions"
+
+	ufspb "infra/unifiedfleet/api/v1/models"
+	chromeosLab "infra/unifiedfleet/api/v1/models/chromeos/lab"
+	ufsAPI "infra/unifiedfleet/api/v1/rpc"
+	ufsUtil "infra/unifiedfleet/app/util"
+)
+
+// fakeFleetClient keeps racks, machines and machineLSEs in memory.
+type fakeFleetClient struct {
+	ufsAPI.FleetClient
+
+	racks    map[string]*ufspb.Rack
+	machines map[string]*ufspb.Machine
+	lses     map[string]*ufspb.MachineLSE
+	// failOn is the name of an entity whose create or update fails.
+	failOn string
+	calls  []string
+}
+
+func newFakeFleetClient() *fakeFleetClient {
+	return &fakeFleetClient{
+		racks:    make(map[string]*ufspb.Rack),
+		machines: make(map[string]*ufspb.Machine),
+		lses:     make(map[string]*ufspb.MachineLSE),
+	}
+}
+
+func (c *fakeFleetClient) write(method, name string) error {
+	c.calls = append(c.calls, method+" "+name)
+	if name == c.failOn {
+		return status.Errorf(codes.Internal, "failed to write %s", name)
+	}
+	return nil
+}
+
+func (c *fakeFleetClient) GetRack(ctx context.Context, in *ufsAPI.GetRackRequest, opts ...grpc.CallOption) (*ufspb.Rack, error) {
+	r, ok := c.racks[ufsUtil.RemovePrefix(in.GetName())]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "%s not found", in.GetName())
+	}
+	r = proto.Clone(r).(*ufspb.Rack)
+	r.Name = in.GetName()
+	return r, nil
+}
+
+func (c *fakeFleetClient) RackRegistration(ctx context.Context, in *ufsAPI.RackRegistrationRequest, opts ...grpc.CallOption) (*ufspb.Rack, error) {
+	if err := c.write("RackRegistration", in.GetRack().GetName()); err != nil {
+		return nil, err
+	}
+	c.racks[in.GetRack().GetName()] = in.GetRack()
+	return in.GetRack(), nil
+}
+
+func (c *fakeFleetClient) UpdateRack(ctx context.Context, in *ufsAPI.UpdateRackRequest, opts ...grpc.CallOption) (*ufspb.Rack, error) {
+	name := ufsUtil.RemovePrefix(in.GetRack().GetName())
+	if err := c.write("UpdateRack", name); err != nil {
+		return nil, err
+	}
+	c.racks[name] = in.GetRack()
+	return in.GetRack(), nil
+}
+
+func (c *fakeFleetClient) GetMachine(ctx context.Context, in *ufsAPI.GetMachineRequest, opts ...grpc.CallOption) (*ufspb.Machine, error) {
+	m, ok := c.machines[ufsUtil.RemovePrefix(in.GetName())]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "%s not found", in.GetName())
+	}
+	m = proto.Clone(m).(*ufspb.Machine)
+	m.Name = in.GetName()
+	return m, nil
+}
+
+func (c *fakeFleetClient) MachineRegistration(ctx context.Context, in *ufsAPI.MachineRegistrationRequest, opts ...grpc.CallOption) (*ufspb.Machine, error) {
+	if err := c.write("MachineRegistration", in.GetMachine().GetName()); err != nil {
+		return nil, err
+	}
+	c.machines[in.GetMachine().GetName()] = in.GetMachine()
+	return in.GetMachine(), nil
+}
+
+func (c *fakeFleetClient) UpdateMachine(ctx context.Context, in *ufsAPI.UpdateMachineRequest, opts ...grpc.CallOption) (*ufspb.Machine, error) {
+	name := ufsUtil.RemovePrefix(in.GetMachine().GetName())
+	if err := c.write("UpdateMachine", name); err != nil {
+		return nil, err
+	}
+	c.machines[name] = in.GetMachine()
+	return in.GetMachine(), nil
+}
+
+func (c *fakeFleetClient) GetMachineLSE(ctx context.Context, in *ufsAPI.GetMachineLSERequest, opts ...grpc.CallOption) (*ufspb.MachineLSE, error) {
+	lse, ok := c.lses[ufsUtil.RemovePrefix(in.GetName())]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "%s not found", in.GetName())
+	}
+	lse = proto.Clone(lse).(*ufspb.MachineLSE)
+	lse.Name = in.GetName()
+	return lse, nil
+}
+
+func (c *fakeFleetClient) CreateMachineLSE(ctx context.Context, in *ufsAPI.CreateMachineLSERequest, opts ...grpc.CallOption) (*ufspb.MachineLSE, error) {
+	if err := c.write("CreateMachineLSE", in.GetMachineLSEId()); err != nil {
+		return nil, err
+	}
+	c.lses[in.GetMachineLSEId()] = in.GetMachineLSE()
+	return in.GetMachineLSE(), nil
+}
+
+func (c *fakeFleetClient) UpdateMachineLSE(ctx context.Context, in *ufsAPI.UpdateMachineLSERequest, opts ...grpc.CallOption) (*ufspb.MachineLSE, error) {
+	name := ufsUtil.RemovePrefix(in.GetMachineLSE().GetName())
+	if err := c.write("UpdateMachineLSE", name); err != nil {
+		return nil, err
+	}
+	c.lses[name] = in.GetMachineLSE()
+	return in.GetMachineLSE(), nil
+}
+
+const testState = `
+duts:
+- name: dut1
+  machines: [machine2]
+  chromeosMachineLse:
+    deviceLse:
+      dut:
+        hostname: dut1
+hosts:
+- name: host1
+  machines: [machine1]
+machines:
+- name: machine1
+  serialNumber: new-serial
+  tags: [a, b]
+- name: machine2
+  location:
+    rack: rack1
+racks:
+- name: rack1
+  description: rack one
+`
+
+func TestParseDesiredState(t *testing.T) {
+	Convey("parseDesiredState", t, func() {
+		Convey("happy path", func() {
+			s, err := parseDesiredState([]byte(testState))
+			So(err, ShouldBeNil)
+			So(s.racks, ShouldHaveLength, 1)
+			So(s.machines, ShouldHaveLength, 2)
+			So(s.machines[0].GetTags(), ShouldResemble, []string{"a", "b"})
+			So(s.hosts, ShouldHaveLength, 1)
+			So(s.duts, ShouldHaveLength, 1)
+			So(s.digest, ShouldNotBeEmpty)
+		})
+		Convey("missing name", func() {
+			_, err := parseDesiredState([]byte("machines:\n- serialNumber: abc\n"))
+			So(err, ShouldErrLike, "machines[0]: name is required")
+		})
+		Convey("unknown field", func() {
+			_, err := parseDesiredState([]byte("racks:\n- name: rack1\n  foo: bar\n"))
+			So(err, ShouldErrLike, "racks[0]")
+		})
+		Convey("host listed as a dut", func() {
+			_, err := parseDesiredState([]byte("duts:\n- name: host1\n"))
+			So(err, ShouldErrLike, "is not a DUT")
+		})
+		Convey("host and dut with the same name", func() {
+			_, err := parseDesiredState([]byte(`
+hosts:
+- name: dut1
+duts:
+- name: dut1
+  chromeosMachineLse: {deviceLse: {dut: {hostname: dut1}}}
+`))
+			So(err, ShouldErrLike, "listed more than once")
+		})
+	})
+}
+
+func TestPlanAndApply(t *testing.T) {
+	ctx := context.Background()
+	Convey("plan and apply", t, func() {
+		ic := newFakeFleetClient()
+		ic.machines["machine1"] = &ufspb.Machine{
+			Name:         "machine1",
+			SerialNumber: "old-serial",
+			Description:  "unmanaged",
+		}
+		ic.racks["rack1"] = &ufspb.Rack{
+			Name:        "rack1",
+			Description: "rack one",
+		}
+		s, err := parseDesiredState([]byte(testState))
+		So(err, ShouldBeNil)
+
+		p, err := computePlan(ctx, ic, s)
+		So(err, ShouldBeNil)
+
+		Convey("plan is in dependency order", func() {
+			var out bytes.Buffer
+			p.print(&out)
+			So(out.String(), ShouldEqual, `~ update machine machine1
+    serialNumber: "old-serial" -> "new-serial"
+    tags: <unset> -> ["a","b"]
++ create machine machine2
++ create host host1
++ create dut dut1
+Plan: 3 to create, 1 to update, 1 unchanged.
+`)
+		})
+
+		Convey("apply keeps unmanaged fields", func() {
+			j, err := openJournal(filepath.Join(t.TempDir(), "journal"), s.digest)
+			So(err, ShouldBeNil)
+			So(applyPlan(ctx, ic, p, j, &bytes.Buffer{}), ShouldBeNil)
+			So(j.close(true), ShouldBeNil)
+			So(ic.calls, ShouldResemble, []string{
+				"UpdateMachine machine1",
+				"MachineRegistration machine2",
+				"CreateMachineLSE host1",
+				"CreateMachineLSE dut1",
+			})
+			So(ic.machines["machine1"].GetDescription(), ShouldEqual, "unmanaged")
+			So(ic.machines["machine1"].GetSerialNumber(), ShouldEqual, "new-serial")
+
+			p, err := computePlan(ctx, ic, s)
+			So(err, ShouldBeNil)
+			So(p.changes, ShouldBeEmpty)
+			So(p.unchanged, ShouldEqual, 5)
+		})
+
+		Convey("apply stops on error and resumes from the journal", func() {
+			path := filepath.Join(t.TempDir(), "journal")
+			ic.failOn = "host1"
+			j, err := openJournal(path, s.digest)
+			So(err, ShouldBeNil)
+			err = applyPlan(ctx, ic, p, j, &bytes.Buffer{})
+			So(err, ShouldErrLike, "create host host1")
+			So(j.close(false), ShouldBeNil)
+			So(ic.lses, ShouldNotContainKey, "dut1")
+
+			// Reset the fake so that re-applied changes are visible.
+			ic.failOn = ""
+			ic.calls = nil
+			j, err = openJournal(path, s.digest)
+			So(err, ShouldBeNil)
+			So(applyPlan(ctx, ic, p, j, &bytes.Buffer{}), ShouldBeNil)
+			So(j.close(true), ShouldBeNil)
+			So(ic.calls, ShouldResemble, []string{
+				"CreateMachineLSE host1",
+				"CreateMachineLSE dut1",
+			})
+			_, err = os.Stat(path)
+			So(os.IsNotExist(err), ShouldBeTrue)
+		})
+
+		Convey("journal of another state file", func() {
+			path := filepath.Join(t.TempDir(), "journal")
+			j, err := openJournal(path, "other")
+			So(err, ShouldBeNil)
+			So(j.close(false), ShouldBeNil)
+			_, err = openJournal(path, s.digest)
+			So(err, ShouldErrLike, "different state file")
+		})
+	})
+}
+
+func TestPartialNestedMessages(t *testing.T) {
+	ctx := context.Background()
+	Convey("partial nested messages keep sibling fields", t, func() {
+		ic := newFakeFleetClient()
+		ic.racks["rack1"] = &ufspb.Rack{
+			Name: "rack1",
+			Location: &ufspb.Location{
+				Rack: "rack1",
+				Zone: ufspb.Zone_ZONE_CHROMEOS4,
+			},
+		}
+		ic.lses["dut1"] = &ufspb.MachineLSE{
+			Name:     "dut1",
+			Machines: []string{"machine1"},
+			Lse: &ufspb.MachineLSE_ChromeosMachineLse{
+				ChromeosMachineLse: &ufspb.ChromeOSMachineLSE{
+					ChromeosLse: &ufspb.ChromeOSMachineLSE_DeviceLse{
+						DeviceLse: &ufspb.ChromeOSDeviceLSE{
+							Device: &ufspb.ChromeOSDeviceLSE_Dut{
+								Dut: &chromeosLab.DeviceUnderTest{
+									Hostname: "dut1",
+									Pools:    []string{"old"},
+									Peripherals: &chromeosLab.Peripherals{
+										Servo: &chromeosLab.Servo{ServoHostname: "labstation1"},
+										Rpm:   &chromeosLab.OSRPM{PowerunitName: "rpm1"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+		s, err := parseDesiredState([]byte(`
+racks:
+- name: rack1
+  location:
+    zone: ZONE_CHROMEOS6
+duts:
+- name: dut1
+  chromeosMachineLse:
+    deviceLse:
+      dut:
+        hostname: dut1
+        pools: [new]
+`))
+		So(err, ShouldBeNil)
+
+		p, err := computePlan(ctx, ic, s)
+		So(err, ShouldBeNil)
+		var out bytes.Buffer
+		p.print(&out)
+		So(out.String(), ShouldEqual, `~ update rack rack1
+    location.zone: "ZONE_CHROMEOS4" -> "ZONE_CHROMEOS6"
+~ update dut dut1
+    chromeosMachineLse.deviceLse.dut.pools: ["old"] -> ["new"]
+Plan: 0 to create, 2 to update, 0 unchanged.
+`)
+
+		j, err := openJournal(filepath.Join(t.TempDir(), "journal"), s.digest)
+		So(err, ShouldBeNil)
+		So(applyPlan(ctx, ic, p, j, &bytes.Buffer{}), ShouldBeNil)
+		So(j.close(true), ShouldBeNil)
+		So(ic.racks["rack1"].GetLocation(), ShouldResembleProto, &ufspb.Location{
+			Rack: "rack1",
+			Zone: ufspb.Zone_ZONE_CHROMEOS6,
+		})
+		dut := ic.lses["dut1"].GetChromeosMachineLse().GetDeviceLse().GetDut()
+		So(dut.GetPools(), ShouldResemble, []string{"new"})
+		So(dut.GetPeripherals().GetServo().GetServoHostname(), ShouldEqual, "labstation1")
+		So(dut.GetPeripherals().GetRpm().GetPowerunitName(), ShouldEqual, "rpm1")
+		So(ic.lses["dut1"].GetMachines(), ShouldResemble, []string{"machine1"})
+	})
+}
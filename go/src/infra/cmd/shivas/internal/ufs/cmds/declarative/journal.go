@@ -0,0 +1,126 @@
+// Copyright 2024 The Chromium Authors
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package declarative
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"time"
+
+	"go.chromium.org/luci/common/errors"
+)
+
+// journal records the changes applied by `shivas apply`, so that an apply
+// stopped by an error can be resumed without applying the same change twice.
+//
+// The journal is a JSON lines file. The first line records the digest of the
+// state file, each following line records an applied change.
+type journal struct {
+	path string
+	f    *os.File
+	// done is the set of keys of the applied changes.
+	done map[string]bool
+}
+
+// journalEntry is a line of the journal.
+type journalEntry struct {
+	Digest string    `json:"digest,omitempty"`
+	Change string    `json:"change,omitempty"`
+	Time   time.Time `json:"time"`
+}
+
+// openJournal opens the journal at path for the state file with digest.
+//
+// If the journal already exists, it must have been written for the same
+// state file, and the changes recorded in it are treated as applied.
+func openJournal(path, digest string) (*journal, error) {
+	j := &journal{
+		path: path,
+		done: make(map[string]bool),
+	}
+	resume, err := j.load(digest)
+	if err != nil {
+		return nil, err
+	}
+	j.f, err = os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, errors.Annotate(err, "open journal").Err()
+	}
+	if !resume {
+		if err := j.write(journalEntry{Digest: digest}); err != nil {
+			j.f.Close()
+			return nil, err
+		}
+	}
+	return j, nil
+}
+
+// load loads the existing journal, and reports whether it exists.
+func (j *journal) load(digest string) (bool, error) {
+	f, err := os.Open(j.path)
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, errors.Annotate(err, "load journal").Err()
+	}
+	defer f.Close()
+	s := bufio.NewScanner(f)
+	for first := true; s.Scan(); first = false {
+		var e journalEntry
+		if err := json.Unmarshal(s.Bytes(), &e); err != nil {
+			return false, errors.Annotate(err, "load journal %s", j.path).Err()
+		}
+		if first {
+			if e.Digest != digest {
+				return false, errors.Reason("journal %s was written for a different state file; remove it to start over", j.path).Err()
+			}
+			continue
+		}
+		j.done[e.Change] = true
+	}
+	if err := s.Err(); err != nil {
+		return false, errors.Annotate(err, "load journal %s", j.path).Err()
+	}
+	return true, nil
+}
+
+// applied reports whether the change was applied by a previous run.
+func (j *journal) applied(c *change) bool {
+	return j.done[c.key()]
+}
+
+// record records the change as applied.
+func (j *journal) record(c *change) error {
+	if err := j.write(journalEntry{Change: c.key()}); err != nil {
+		return err
+	}
+	j.done[c.key()] = true
+	return nil
+}
+
+func (j *journal) write(e journalEntry) error {
+	e.Time = time.Now().UTC()
+	b, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	if _, err := j.f.Write(append(b, '\n')); err != nil {
+		return errors.Annotate(err, "write journal").Err()
+	}
+	return j.f.Sync()
+}
+
+// close closes the journal, and removes it if all changes were applied.
+func (j *journal) close(completed bool) error {
+	if err := j.f.Close(); err != nil {
+		return err
+	}
+	if completed {
+		return os.Remove(j.path)
+	}
+	return nil
+}
@@ -0,0 +1,179 @@
+// Copyright 2024 The Chromium Authors
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package declarative
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/protobuf/encoding/protojson"
+	"sigs.k8s.io/yaml"
+
+	"go.chromium.org/luci/common/errors"
+
+	ufspb "infra/unifiedfleet/api/v1/models"
+	ufsUtil "infra/unifiedfleet/app/util"
+)
+
+// desiredState is the desired state of the lab described in a state file.
+//
+// Each entity only manages the fields that are set in the file; the other
+// fields of the existing UFS entity are left untouched.
+type desiredState struct {
+	// digest is the sha256 of the state file, used to match a journal with
+	// the state file it was written for.
+	digest string
+
+	racks    []*ufspb.Rack
+	machines []*ufspb.Machine
+	hosts    []*ufspb.MachineLSE
+	duts     []*ufspb.MachineLSE
+}
+
+// stateFile is the layout of the state file. Entities are decoded with
+// protojson so they accept the same fields as the JSON specs files.
+type stateFile struct {
+	Racks    []json.RawMessage `json:"racks"`
+	Machines []json.RawMessage `json:"machines"`
+	Hosts    []json.RawMessage `json:"hosts"`
+	Duts     []json.RawMessage `json:"duts"`
+}
+
+// readDesiredState reads the desired state from the YAML (or JSON) file.
+func readDesiredState(path string) (*desiredState, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Annotate(err, "read desired state").Err()
+	}
+	return parseDesiredState(b)
+}
+
+// parseDesiredState parses the content of a state file.
+//
+// Example:
+//
+//	racks:
+//	- name: rack1
+//	  location:
+//	    zone: ZONE_CHROMEOS6
+//	machines:
+//	- name: machine1
+//	  serialNumber: abc123
+//	hosts:
+//	- name: host1
+//	  machines: [machine1]
+func parseDesiredState(b []byte) (*desiredState, error) {
+	j, err := yaml.YAMLToJSON(b)
+	if err != nil {
+		return nil, errors.Annotate(err, "parse desired state").Err()
+	}
+	var f stateFile
+	if err := json.Unmarshal(j, &f); err != nil {
+		return nil, errors.Annotate(err, "parse desired state").Err()
+	}
+	h := sha256.Sum256(b)
+	s := &desiredState{digest: hex.EncodeToString(h[:])}
+	for i, raw := range f.Racks {
+		r := &ufspb.Rack{}
+		if err := unmarshalEntity(raw, r); err != nil {
+			return nil, errors.Annotate(err, "racks[%d]", i).Err()
+		}
+		if zone := r.GetLocation().GetZone(); zone != ufspb.Zone_ZONE_UNSPECIFIED {
+			r.Realm = ufsUtil.ToUFSRealm(zone.String())
+		}
+		s.racks = append(s.racks, r)
+	}
+	for i, raw := range f.Machines {
+		m := &ufspb.Machine{}
+		if err := unmarshalEntity(raw, m); err != nil {
+			return nil, errors.Annotate(err, "machines[%d]", i).Err()
+		}
+		if zone := m.GetLocation().GetZone(); zone != ufspb.Zone_ZONE_UNSPECIFIED {
+			m.Realm = ufsUtil.ToUFSRealm(zone.String())
+		}
+		s.machines = append(s.machines, m)
+	}
+	for i, raw := range f.Hosts {
+		lse := &ufspb.MachineLSE{}
+		if err := unmarshalEntity(raw, lse); err != nil {
+			return nil, errors.Annotate(err, "hosts[%d]", i).Err()
+		}
+		s.hosts = append(s.hosts, lse)
+	}
+	for i, raw := range f.Duts {
+		lse := &ufspb.MachineLSE{}
+		if err := unmarshalEntity(raw, lse); err != nil {
+			return nil, errors.Annotate(err, "duts[%d]", i).Err()
+		}
+		if lse.GetChromeosMachineLse().GetDeviceLse().GetDut() == nil {
+			return nil, errors.Reason("duts[%d]: %q is not a DUT", i, lse.GetName()).Err()
+		}
+		s.duts = append(s.duts, lse)
+	}
+	if err := s.validate(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func unmarshalEntity(raw json.RawMessage, pm proto.Message) error {
+	if err := protojson.Unmarshal(raw, proto.MessageV2(pm)); err != nil {
+		return err
+	}
+	if entityName(pm) == "" {
+		return errors.Reason("name is required").Err()
+	}
+	return nil
+}
+
+// validate checks that no entity is listed twice.
+//
+// Hosts and DUTs share the machineLSE namespace in UFS.
+func (s *desiredState) validate() error {
+	seen := make(map[string]bool)
+	check := func(k *kind, pm proto.Message) error {
+		key := k.collection + "/" + entityName(pm)
+		if seen[key] {
+			return errors.Reason("%s %q is listed more than once", k.name, entityName(pm)).Err()
+		}
+		seen[key] = true
+		return nil
+	}
+	for _, k := range kinds {
+		for _, pm := range s.entities(k) {
+			if err := check(k, pm); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// entities returns the desired entities of the given kind.
+func (s *desiredState) entities(k *kind) []proto.Message {
+	var pms []proto.Message
+	switch k {
+	case rackKind:
+		for _, r := range s.racks {
+			pms = append(pms, r)
+		}
+	case machineKind:
+		for _, m := range s.machines {
+			pms = append(pms, m)
+		}
+	case hostKind:
+		for _, lse := range s.hosts {
+			pms = append(pms, lse)
+		}
+	case dutKind:
+		for _, lse := range s.duts {
+			pms = append(pms, lse)
+		}
+	}
+	return pms
+}
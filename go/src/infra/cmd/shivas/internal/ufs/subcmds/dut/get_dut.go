@@ -10,6 +10,7 @@ import (
 
 	"github.com/golang/protobuf/proto"
 	"github.com/maruel/subcommands"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"go.chromium.org/luci/auth/client/authcli"
 	"go.chromium.org/luci/common/cli"
@@ -41,6 +42,8 @@ shivas get dut {name1} {name2}
 
 shivas get dut -rack rack1 -rack2 -state serving -state needs_repair
 
+shivas get dut -as-of 2024-01-02T15:04:05Z {name1}
+
 Gets the ChromeOS DUT and prints the output in user-specified format.`,
 	CommandRun: func() subcommands.CommandRun {
 		c := &getDut{}
@@ -51,6 +54,7 @@ Gets the ChromeOS DUT and prints the output in user-specified format.`,
 
 		c.Flags.IntVar(&c.pageSize, "n", 0, cmdhelp.ListPageSizeDesc)
 		c.Flags.BoolVar(&c.keysOnly, "keys", false, cmdhelp.KeysOnlyText)
+		c.Flags.Var(utils.Timestamp(&c.asOf), "as-of", cmdhelp.AsOfText)
 
 		c.Flags.Var(flag.StringSlice(&c.zones), "zone", "Name(s) of a zone to filter by. Can be specified multiple times."+cmdhelp.ZoneFilterHelpText)
 		c.Flags.Var(flag.StringSlice(&c.racks), "rack", "Name(s) of a rack to filter by. Can be specified multiple times.")
@@ -95,6 +99,7 @@ type getDut struct {
 
 	pageSize          int
 	keysOnly          bool
+	asOf              *timestamppb.Timestamp
 	wantHostInfoStore bool
 }
 
@@ -107,6 +112,12 @@ func (c *getDut) Run(a subcommands.Application, args []string, env subcommands.E
 }
 
 func (c *getDut) innerRun(a subcommands.Application, args []string, env subcommands.Env) error {
+	if c.asOf != nil && len(c.formatFilters()) > 0 {
+		return cmdlib.NewUsageError(c.Flags, "-as-of cannot be used with filters")
+	}
+	if c.asOf != nil && c.wantHostInfoStore {
+		return cmdlib.NewUsageError(c.Flags, "-as-of cannot be used with -host-info-store")
+	}
 	ctx := cli.GetContext(a, c, env)
 	ns, err := c.getNamespace()
 	if err != nil {
@@ -145,9 +156,14 @@ func (c *getDut) innerRun(a subcommands.Application, args []string, env subcomma
 	emit := !utils.NoEmitMode(c.outputFlags.NoEmit())
 	full := utils.FullMode(c.outputFlags.Full())
 	var res []proto.Message
-	if len(args) > 0 {
+	switch {
+	case len(args) > 0 && c.asOf != nil:
+		res = utils.ConcurrentGet(ctx, ic, args, c.getSingleAsOf)
+	case len(args) > 0:
 		res = utils.ConcurrentGet(ctx, ic, args, utils.GetSingleMachineLSE)
-	} else {
+	case c.asOf != nil:
+		res, err = utils.BatchList(ctx, ic, host.ListHostsAsOf(c.asOf), c.formatFilters(), c.pageSize, c.keysOnly, full, nil)
+	default:
 		res, err = utils.BatchList(ctx, ic, host.ListHosts, c.formatFilters(), c.pageSize, c.keysOnly, full, nil)
 	}
 	if err != nil {
@@ -157,6 +173,14 @@ func (c *getDut) innerRun(a subcommands.Application, args []string, env subcomma
 		c.outputFlags.JSON(), emit, full, c.outputFlags.Tsv(), c.keysOnly)
 }
 
+// getSingleAsOf gets the DUT as it was at c.asOf.
+func (c *getDut) getSingleAsOf(ctx context.Context, ic ufsAPI.FleetClient, name string) (proto.Message, error) {
+	return ic.GetMachineLSE(ctx, &ufsAPI.GetMachineLSERequest{
+		Name: ufsUtil.AddPrefix(ufsUtil.MachineLSECollection, name),
+		AsOf: c.asOf,
+	})
+}
+
 // getNamespace returns the namespace used to call UFS with appropriate
 // validation and default behavior. It is primarily separated from the main
 // function for testing purposes
@@ -9,6 +9,7 @@ import (
 
 	"github.com/golang/protobuf/proto"
 	"github.com/maruel/subcommands"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"go.chromium.org/luci/auth/client/authcli"
 	"go.chromium.org/luci/common/cli"
@@ -36,6 +37,8 @@ shivas get host {name1} {name2}
 
 shivas get host -rack rack1 -rack2 -state serving -state needs_repair
 
+shivas get host -as-of 2024-01-02T15:04:05Z {name1}
+
 Gets the host and prints the output in user-specified format.`,
 	CommandRun: func() subcommands.CommandRun {
 		c := &getHost{}
@@ -45,6 +48,7 @@ Gets the host and prints the output in user-specified format.`,
 
 		c.Flags.IntVar(&c.pageSize, "n", 0, cmdhelp.ListPageSizeDesc)
 		c.Flags.BoolVar(&c.keysOnly, "keys", false, cmdhelp.KeysOnlyText)
+		c.Flags.Var(utils.Timestamp(&c.asOf), "as-of", cmdhelp.AsOfText)
 
 		c.Flags.Var(flag.StringSlice(&c.zones), "zone", "Name(s) of a zone to filter by. Can be specified multiple times."+cmdhelp.ZoneFilterHelpText)
 		c.Flags.Var(flag.StringSlice(&c.racks), "rack", "Name(s) of a rack to filter by. Can be specified multiple times.")
@@ -82,6 +86,7 @@ type getHost struct {
 
 	pageSize int
 	keysOnly bool
+	asOf     *timestamppb.Timestamp
 }
 
 func (c *getHost) Run(a subcommands.Application, args []string, env subcommands.Env) int {
@@ -93,6 +98,9 @@ func (c *getHost) Run(a subcommands.Application, args []string, env subcommands.
 }
 
 func (c *getHost) innerRun(a subcommands.Application, args []string, env subcommands.Env) error {
+	if c.asOf != nil && len(c.formatFilters()) > 0 {
+		return cmdlib.NewUsageError(c.Flags, "-as-of cannot be used with filters")
+	}
 	ctx := cli.GetContext(a, c, env)
 	ns, err := c.envFlags.Namespace(nil, "")
 	if err != nil {
@@ -115,7 +123,11 @@ func (c *getHost) innerRun(a subcommands.Application, args []string, env subcomm
 	if len(args) > 0 {
 		res = utils.ConcurrentGet(ctx, ic, args, c.getSingle)
 	} else {
-		res, err = utils.BatchList(ctx, ic, ListHosts, c.formatFilters(), c.pageSize, c.keysOnly, full, nil)
+		listFunc := ListHosts
+		if c.asOf != nil {
+			listFunc = ListHostsAsOf(c.asOf)
+		}
+		res, err = utils.BatchList(ctx, ic, listFunc, c.formatFilters(), c.pageSize, c.keysOnly, full, nil)
 	}
 	if err != nil {
 		return err
@@ -143,6 +155,7 @@ func (c *getHost) formatFilters() []string {
 func (c *getHost) getSingle(ctx context.Context, ic ufsAPI.FleetClient, name string) (proto.Message, error) {
 	return ic.GetMachineLSE(ctx, &ufsAPI.GetMachineLSERequest{
 		Name: ufsUtil.AddPrefix(ufsUtil.MachineLSECollection, name),
+		AsOf: c.asOf,
 	})
 }
 
@@ -155,6 +168,22 @@ func ListHosts(ctx context.Context, ic ufsAPI.FleetClient, pageSize int32, pageT
 		KeysOnly:  keysOnly,
 		Full:      full,
 	}
+	return sendListHosts(ctx, ic, req)
+}
+
+// ListHostsAsOf returns a function to list the MachineLSEs as they were at asOf.
+func ListHostsAsOf(asOf *timestamppb.Timestamp) func(ctx context.Context, ic ufsAPI.FleetClient, pageSize int32, pageToken, filter string, keysOnly, full bool) ([]proto.Message, string, error) {
+	return func(ctx context.Context, ic ufsAPI.FleetClient, pageSize int32, pageToken, filter string, keysOnly, full bool) ([]proto.Message, string, error) {
+		return sendListHosts(ctx, ic, &ufsAPI.ListMachineLSEsRequest{
+			PageSize:  pageSize,
+			PageToken: pageToken,
+			KeysOnly:  keysOnly,
+			AsOf:      asOf,
+		})
+	}
+}
+
+func sendListHosts(ctx context.Context, ic ufsAPI.FleetClient, req *ufsAPI.ListMachineLSEsRequest) ([]proto.Message, string, error) {
 	res, err := ic.ListMachineLSEs(ctx, req)
 	if err != nil {
 		return nil, "", err
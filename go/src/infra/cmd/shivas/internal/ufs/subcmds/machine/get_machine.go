@@ -10,6 +10,7 @@ import (
 
 	"github.com/golang/protobuf/proto"
 	"github.com/maruel/subcommands"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"go.chromium.org/luci/auth/client/authcli"
 	"go.chromium.org/luci/common/cli"
@@ -37,6 +38,8 @@ shivas get machine {name1} {name2}
 
 shivas get machine -platform platform1 -state serving -state needs_repair -zone atl97
 
+shivas get machine -as-of 2024-01-02T15:04:05Z {name1}
+
 Gets the machine and prints the output in the user-specified format.`,
 	CommandRun: func() subcommands.CommandRun {
 		c := &getMachine{}
@@ -47,6 +50,7 @@ Gets the machine and prints the output in the user-specified format.`,
 
 		c.Flags.IntVar(&c.pageSize, "n", 0, cmdhelp.ListPageSizeDesc)
 		c.Flags.BoolVar(&c.keysOnly, "keys", false, cmdhelp.KeysOnlyText)
+		c.Flags.Var(utils.Timestamp(&c.asOf), "as-of", cmdhelp.AsOfText)
 
 		c.Flags.Var(flag.StringSlice(&c.zones), "zone", "Name(s) of a zone to filter by. Can be specified multiple times."+cmdhelp.ZoneFilterHelpText)
 		c.Flags.Var(flag.StringSlice(&c.racks), "rack", "Name(s) of a rack to filter by. Can be specified multiple times.")
@@ -90,6 +94,7 @@ type getMachine struct {
 
 	pageSize int
 	keysOnly bool
+	asOf     *timestamppb.Timestamp
 
 	machineName string
 }
@@ -135,7 +140,11 @@ func (c *getMachine) innerRun(a subcommands.Application, args []string, env subc
 	if len(args) > 0 {
 		res = utils.ConcurrentGet(ctx, ic, args, c.getSingle)
 	} else {
-		res, err = utils.BatchList(ctx, ic, listMachines, c.formatFilters(), c.pageSize, c.keysOnly, full, nil)
+		listFunc := listMachines
+		if c.asOf != nil {
+			listFunc = listMachinesAsOf(c.asOf)
+		}
+		res, err = utils.BatchList(ctx, ic, listFunc, c.formatFilters(), c.pageSize, c.keysOnly, full, nil)
 	}
 	if err != nil {
 		return err
@@ -147,6 +156,7 @@ func (c *getMachine) innerRun(a subcommands.Application, args []string, env subc
 func (c *getMachine) getSingle(ctx context.Context, ic ufsAPI.FleetClient, name string) (proto.Message, error) {
 	return ic.GetMachine(ctx, &ufsAPI.GetMachineRequest{
 		Name: ufsUtil.AddPrefix(ufsUtil.MachineCollection, name),
+		AsOf: c.asOf,
 	})
 }
 
@@ -198,6 +208,22 @@ func listMachines(ctx context.Context, ic ufsAPI.FleetClient, pageSize int32, pa
 		KeysOnly:  keysOnly,
 		Full:      full,
 	}
+	return sendListMachines(ctx, ic, req)
+}
+
+// listMachinesAsOf returns a function to list the machines as they were at asOf.
+func listMachinesAsOf(asOf *timestamppb.Timestamp) func(ctx context.Context, ic ufsAPI.FleetClient, pageSize int32, pageToken, filter string, keysOnly, full bool) ([]proto.Message, string, error) {
+	return func(ctx context.Context, ic ufsAPI.FleetClient, pageSize int32, pageToken, filter string, keysOnly, full bool) ([]proto.Message, string, error) {
+		return sendListMachines(ctx, ic, &ufsAPI.ListMachinesRequest{
+			PageSize:  pageSize,
+			PageToken: pageToken,
+			KeysOnly:  keysOnly,
+			AsOf:      asOf,
+		})
+	}
+}
+
+func sendListMachines(ctx context.Context, ic ufsAPI.FleetClient, req *ufsAPI.ListMachinesRequest) ([]proto.Message, string, error) {
 	res, err := ic.ListMachines(ctx, req)
 	if err != nil {
 		return nil, "", err
@@ -210,5 +236,8 @@ func listMachines(ctx context.Context, ic ufsAPI.FleetClient, pageSize int32, pa
 }
 
 func (c *getMachine) validateArgs() error {
+	if c.asOf != nil && len(c.formatFilters()) > 0 {
+		return cmdlib.NewUsageError(c.Flags, "-as-of cannot be used with filters")
+	}
 	return utils.ValidateNameAndPositionalArg(c.Flags, c.machineName)
 }
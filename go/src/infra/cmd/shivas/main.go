@@ -20,6 +20,7 @@ import (
 	queen_cmds "infra/cmd/shivas/internal/queen/cmds"
 	sw_cmds "infra/cmd/shivas/internal/swarming/cmds"
 	bot_cmds "infra/cmd/shivas/internal/ufs/cmds/bot"
+	"infra/cmd/shivas/internal/ufs/cmds/declarative"
 	"infra/cmd/shivas/internal/ufs/cmds/operations"
 	"infra/cmd/shivas/internal/ufs/cmds/state"
 	"infra/cmd/shivas/site"
@@ -52,6 +53,8 @@ Full documentation http://go/shivas-cli`,
 			operations.GetCmd,
 			operations.RenameCmd,
 			operations.ReplaceCmd,
+			declarative.PlanCmd,
+			declarative.ApplyCmd,
 			subcommands.Section("Repair"),
 			sw_cmds.RepairDutsCmd,
 			sw_cmds.AuditDutsCmd,
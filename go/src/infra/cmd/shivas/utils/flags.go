@@ -6,7 +6,11 @@ package utils
 
 import (
 	"flag"
+	"fmt"
 	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"infra/cmdsupport/cmdlib"
 )
@@ -73,6 +77,43 @@ func CSVStringList(s *[][]string) flag.Getter {
 	return (*CSVStringListFlag)(s)
 }
 
+// timestampFlag is a flag.Getter implementation representing a *timestamppb.Timestamp.
+type timestampFlag struct {
+	t **timestamppb.Timestamp
+}
+
+// String returns the RFC 3339 representation of the flag value.
+func (f timestampFlag) String() string {
+	if f.t == nil || *f.t == nil {
+		return ""
+	}
+	return (*f.t).AsTime().Format(time.RFC3339)
+}
+
+// Set records seeing a flag value in RFC 3339 format or as a date (YYYY-MM-DD) in UTC.
+func (f timestampFlag) Set(val string) error {
+	t, err := time.Parse(time.RFC3339, val)
+	if err != nil {
+		var derr error
+		t, derr = time.Parse("2006-01-02", val)
+		if derr != nil {
+			return fmt.Errorf("invalid time %q, want RFC 3339 (e.g. 2024-01-02T15:04:05Z) or YYYY-MM-DD: %s", val, err)
+		}
+	}
+	*f.t = timestamppb.New(t)
+	return nil
+}
+
+// Get retrieves the flag value.
+func (f timestampFlag) Get() interface{} {
+	return *f.t
+}
+
+// Timestamp returns a flag.Getter which reads flags into the given *timestamppb.Timestamp pointer.
+func Timestamp(t **timestamppb.Timestamp) flag.Getter {
+	return timestampFlag{t: t}
+}
+
 // ValidateNameAndPositionalArg validates that name and positional args cannot exist/miss together
 func ValidateNameAndPositionalArg(flags flag.FlagSet, name string) error {
 	if flags.NArg() > 0 && name != "" {
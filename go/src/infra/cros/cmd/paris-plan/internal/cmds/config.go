@@ -0,0 +1,86 @@
+// Copyright 2024 The Chromium Authors
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package cmds
+
+import (
+	"context"
+	"flag"
+	"os"
+	"strings"
+
+	"go.chromium.org/luci/common/errors"
+
+	"infra/cros/recovery"
+	"infra/cros/recovery/config"
+	"infra/cros/recovery/tlw"
+	"infra/libs/skylab/buildbucket"
+)
+
+// configFlags select the configuration and its plans.
+type configFlags struct {
+	configPath string
+	taskName   string
+	setupType  string
+	planName   string
+}
+
+// register registers the flags in the flag set.
+func (f *configFlags) register(fs *flag.FlagSet) {
+	fs.StringVar(&f.configPath, "config", "", "Path to a configuration JSON file. If not set, the default configuration selected by -task and -setup is used.")
+	fs.StringVar(&f.taskName, "task", "recovery", "Task name of the default configuration, e.g. recovery or deploy.")
+	fs.StringVar(&f.setupType, "setup", string(tlw.DUTSetupTypeCros), "DUT setup type of the default configuration, e.g. CROS or LABSTATION.")
+	fs.StringVar(&f.planName, "plan", "", "Name of the plan to use. All plans of the configuration are used if not set.")
+}
+
+// selectedPlan is a plan of the configuration with its name.
+type selectedPlan struct {
+	name string
+	plan *config.Plan
+}
+
+// plans loads the configuration and returns the selected plans in the
+// order they are run.
+func (f *configFlags) plans(ctx context.Context) ([]selectedPlan, error) {
+	c, err := f.configuration(ctx)
+	if err != nil {
+		return nil, errors.Annotate(err, "plans").Err()
+	}
+	var plans []selectedPlan
+	for _, name := range c.GetPlanNames() {
+		if f.planName != "" && name != f.planName {
+			continue
+		}
+		plan, ok := c.GetPlans()[name]
+		if !ok {
+			return nil, errors.Reason("plans: plan %q not found", name).Err()
+		}
+		plans = append(plans, selectedPlan{name: name, plan: plan})
+	}
+	if len(plans) == 0 {
+		return nil, errors.Reason("plans: plan %q not found", f.planName).Err()
+	}
+	return plans, nil
+}
+
+// configuration reads the configuration from the file or takes the
+// default one.
+func (f *configFlags) configuration(ctx context.Context) (*config.Configuration, error) {
+	if f.configPath != "" {
+		r, err := os.Open(f.configPath)
+		if err != nil {
+			return nil, errors.Annotate(err, "configuration").Err()
+		}
+		defer r.Close()
+		c, err := recovery.ParseConfiguration(ctx, r)
+		return c, errors.Annotate(err, "configuration").Err()
+	}
+	tn, err := buildbucket.NormalizeTaskName(f.taskName)
+	if err != nil {
+		return nil, errors.Annotate(err, "configuration").Err()
+	}
+	ds := tlw.DUTSetupType(strings.ToUpper(f.setupType))
+	c, err := recovery.ParsedDefaultConfiguration(ctx, tn, ds)
+	return c, errors.Annotate(err, "configuration").Err()
+}
@@ -0,0 +1,112 @@
+// Copyright 2024 The Chromium Authors
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package cmds
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/maruel/subcommands"
+
+	"go.chromium.org/luci/common/cli"
+	"go.chromium.org/luci/common/errors"
+
+	"infra/cros/recovery/simulate"
+)
+
+// DryRunCmd simulates plans with scripted exec results.
+var DryRunCmd = &subcommands.Command{
+	UsageLine: `dry-run [-config path | -task name -setup type] [-plan name] [-script path]`,
+	ShortDesc: `Simulate plans with scripted exec results`,
+	LongDesc: `Simulate plans with scripted exec results.
+
+The plans are run by the recovery engine, but no exec is executed: each exec
+returns the result given by the script and the path taken through the plan is
+printed. Execs not present in the script pass.
+
+The script is a JSON file:
+  {
+    "default": "pass",
+    "actions": {"Device is SSHable": ["fail", "pass"]},
+    "execs": {"servo_power_cycle": ["fail"]}
+  }
+Results are "pass", "fail", "start_over" or "abort". Every run of an action
+(or exec) takes the next result of its list and the last result repeats.
+
+Example:
+  paris-plan dry-run -task recovery -setup CROS -plan cros -script script.json`,
+	CommandRun: func() subcommands.CommandRun {
+		r := &dryRunCmdRun{}
+		r.config.register(&r.Flags)
+		r.Flags.StringVar(&r.scriptPath, "script", "", "Path to the script JSON file. All execs pass if not set.")
+		r.Flags.BoolVar(&r.enableRecovery, "recovery", true, "Run recovery actions of failed actions.")
+		r.Flags.BoolVar(&r.json, "json", false, "Print the traces as JSON.")
+		return r
+	},
+}
+
+// dryRunCmdRun holds the arguments for dryRunCmd.
+type dryRunCmdRun struct {
+	subcommands.CommandRunBase
+
+	config         configFlags
+	scriptPath     string
+	enableRecovery bool
+	json           bool
+}
+
+// Run is the main entrypoint for the dry-run command.
+func (c *dryRunCmdRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
+	ctx := cli.GetContext(a, c, env)
+	if err := c.innerRun(ctx, a); err != nil {
+		fmt.Fprintf(a.GetErr(), "%s: %s\n", a.GetName(), err)
+		return 1
+	}
+	return 0
+}
+
+// InnerRun is the implementation for the dry-run command.
+func (c *dryRunCmdRun) innerRun(ctx context.Context, a subcommands.Application) error {
+	script, err := c.script()
+	if err != nil {
+		return errors.Annotate(err, "dry-run").Err()
+	}
+	plans, err := c.config.plans(ctx)
+	if err != nil {
+		return errors.Annotate(err, "dry-run").Err()
+	}
+	var traces []*simulate.Trace
+	for _, p := range plans {
+		trace, err := simulate.Run(ctx, p.name, p.plan, script, c.enableRecovery)
+		if err != nil {
+			return errors.Annotate(err, "dry-run").Err()
+		}
+		traces = append(traces, trace)
+	}
+	if c.json {
+		e := json.NewEncoder(a.GetOut())
+		e.SetIndent("", "  ")
+		return errors.Annotate(e.Encode(traces), "dry-run").Err()
+	}
+	for _, t := range traces {
+		t.Print(a.GetOut())
+	}
+	return nil
+}
+
+// script reads the script file, if any.
+func (c *dryRunCmdRun) script() (*simulate.Script, error) {
+	if c.scriptPath == "" {
+		return nil, nil
+	}
+	f, err := os.Open(c.scriptPath)
+	if err != nil {
+		return nil, errors.Annotate(err, "script").Err()
+	}
+	defer f.Close()
+	return simulate.LoadScript(f)
+}
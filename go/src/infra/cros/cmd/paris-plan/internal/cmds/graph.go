@@ -0,0 +1,77 @@
+// Copyright 2024 The Chromium Authors
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package cmds
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/maruel/subcommands"
+
+	"go.chromium.org/luci/common/cli"
+	"go.chromium.org/luci/common/errors"
+
+	"infra/cros/recovery/config"
+	"infra/cros/recovery/config/graph"
+)
+
+// GraphCmd renders plans as graphs.
+var GraphCmd = &subcommands.Command{
+	UsageLine: `graph [-config path | -task name -setup type] [-plan name] [-format dot|mermaid]`,
+	ShortDesc: `Render plans as Graphviz or Mermaid graphs`,
+	LongDesc: `Render plans as Graphviz or Mermaid graphs.
+
+Every action reachable from the critical actions of the plan is a node. Edges
+are labeled with the order the actions are run in: conditions, dependencies
+and recovery actions are drawn with different styles.
+
+Example:
+  paris-plan graph -task recovery -setup CROS -plan cros | dot -Tsvg > cros.svg`,
+	CommandRun: func() subcommands.CommandRun {
+		r := &graphCmdRun{}
+		r.config.register(&r.Flags)
+		r.Flags.StringVar(&r.format, "format", "dot", "Output format: dot or mermaid.")
+		return r
+	},
+}
+
+// graphCmdRun holds the arguments for graphCmd.
+type graphCmdRun struct {
+	subcommands.CommandRunBase
+
+	config configFlags
+	format string
+}
+
+// Run is the main entrypoint for the graph command.
+func (c *graphCmdRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
+	ctx := cli.GetContext(a, c, env)
+	if err := c.innerRun(ctx, a); err != nil {
+		fmt.Fprintf(a.GetErr(), "%s: %s\n", a.GetName(), err)
+		return 1
+	}
+	return 0
+}
+
+// InnerRun is the implementation for the graph command.
+func (c *graphCmdRun) innerRun(ctx context.Context, a subcommands.Application) error {
+	var render func(string, *config.Plan) string
+	switch c.format {
+	case "dot":
+		render = graph.Dot
+	case "mermaid":
+		render = graph.Mermaid
+	default:
+		return errors.Reason("graph: unknown format %q", c.format).Err()
+	}
+	plans, err := c.config.plans(ctx)
+	if err != nil {
+		return errors.Annotate(err, "graph").Err()
+	}
+	for _, p := range plans {
+		fmt.Fprint(a.GetOut(), render(p.name, p.plan))
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+// Copyright 2024 The Chromium Authors
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"os"
+
+	"github.com/maruel/subcommands"
+
+	"go.chromium.org/luci/common/cli"
+
+	"infra/cros/cmd/paris-plan/internal/cmds"
+)
+
+// GetApplication returns the paris-plan application.
+func getApplication() *cli.Application {
+	return &cli.Application{
+		Name: "paris-plan",
+		Title: `Paris Plan
+
+Render recovery plans as graphs and dry-run them with scripted exec results`,
+		Context: func(ctx context.Context) context.Context {
+			return ctx
+		},
+		Commands: []*subcommands.Command{
+			subcommands.CmdHelp,
+			cmds.GraphCmd,
+			cmds.DryRunCmd,
+		},
+	}
+}
+
+// Main is the entrypoint to paris-plan.
+func main() {
+	os.Exit(subcommands.Run(getApplication(), nil))
+}
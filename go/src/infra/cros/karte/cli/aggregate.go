@@ -0,0 +1,148 @@
+// Copyright 2024 The Chromium Authors
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/maruel/subcommands"
+
+	"go.chromium.org/luci/auth/client/authcli"
+	"go.chromium.org/luci/common/cli"
+	"go.chromium.org/luci/common/errors"
+
+	kartepb "infra/cros/karte/api"
+	"infra/cros/karte/client"
+	"infra/cros/karte/internal/commonflags"
+	"infra/cros/karte/internal/scalars"
+	"infra/cros/karte/internal/site"
+)
+
+// maxAggregateHours is the longest window aggregated by the server, a week.
+const maxAggregateHours = 7 * 24
+
+// Aggregate command shows statistics of the actions in Karte grouped by some fields.
+var Aggregate = &subcommands.Command{
+	UsageLine: `aggregate [-group-by kind,board] [-hours 24] [-filter 'kind == "x"']`,
+	ShortDesc: "aggregate actions",
+	LongDesc: `Show the number of actions and their duration percentiles, grouped by some fields.
+
+Only the actions that stopped in the last hours are aggregated, up to a week
+(168 hours). Longer windows are rejected by the server: query the BigQuery
+export of the actions instead, in the entities.actions table of the Karte
+project.
+
+The supported group-by fields are kind, model, board and status.
+
+The filter is checked against every action in the window, so large windows
+are slow unless the filter has an equality on kind, asset_tag, hostname, model,
+board or status, which narrows down the actions read.`,
+	CommandRun: func() subcommands.CommandRun {
+		r := &aggregateRun{}
+		r.commonFlags.Register(&r.Flags)
+		r.authFlags.Register(&r.Flags, site.DefaultAuthOptions)
+		r.Flags.StringVar(&r.groupBy, "group-by", "kind", "comma-separated list of fields to group the actions by")
+		r.Flags.IntVar(&r.hours, "hours", 24, "hours before present that the window begins, at most 168")
+		r.Flags.StringVar(&r.filter, "filter", "", "Karte query command")
+		r.Flags.BoolVar(&r.json, "json", false, "print the response as JSON")
+		return r
+	},
+}
+
+// aggregateRun runs the command.
+type aggregateRun struct {
+	subcommands.CommandRunBase
+	authFlags   authcli.Flags
+	commonFlags commonflags.Flags
+
+	groupBy string
+	hours   int
+	filter  string
+	json    bool
+}
+
+// Run runs aggregate and returns an exit status.
+func (c *aggregateRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
+	ctx := cli.GetContext(a, c, env)
+	if err := c.innerRun(ctx, a, args, env); err != nil {
+		fmt.Fprintf(a.GetErr(), "%s: %s\n", a.GetName(), err)
+		return 1
+	}
+	return 0
+}
+
+// innerRun validates arguments, performs the RPC call and prints the groups.
+func (c *aggregateRun) innerRun(ctx context.Context, a subcommands.Application, args []string, env subcommands.Env) error {
+	if len(args) > 0 {
+		return errors.Reason("aggregate: positional arguments not supported").Err()
+	}
+	if c.hours <= 0 {
+		return errors.Reason("aggregate: hours must be positive").Err()
+	}
+	if c.hours > maxAggregateHours {
+		return errors.Reason("aggregate: hours must be at most %d, use the BigQuery export for longer windows", maxAggregateHours).Err()
+	}
+	authOptions, err := c.authFlags.Options()
+	if err != nil {
+		return errors.Annotate(err, "aggregate").Err()
+	}
+	kClient, err := client.NewClient(ctx, c.commonFlags.MustSelectKarteConfig(authOptions))
+	if err != nil {
+		return errors.Annotate(err, "aggregate").Err()
+	}
+	var groupBy []string
+	if c.groupBy != "" {
+		groupBy = strings.Split(c.groupBy, ",")
+	}
+	now := time.Now().UTC()
+	res, err := kClient.AggregateActions(ctx, &kartepb.AggregateActionsRequest{
+		StartTime: scalars.ConvertTimeToTimestampPtr(now.Add(-time.Duration(c.hours) * time.Hour)),
+		StopTime:  scalars.ConvertTimeToTimestampPtr(now),
+		GroupBy:   groupBy,
+		Filter:    c.filter,
+	})
+	if err != nil {
+		return errors.Annotate(err, "aggregate").Err()
+	}
+	if c.json {
+		marshalIndent := jsonpb.Marshaler{
+			Indent: "  ",
+		}
+		return errors.Annotate(marshalIndent.Marshal(a.GetOut(), res), "marshal JSON").Err()
+	}
+	return errors.Annotate(printGroups(a.GetOut(), groupBy, res.GetGroups()), "aggregate").Err()
+}
+
+// printGroups prints the groups as a table.
+func printGroups(w io.Writer, groupBy []string, groups []*kartepb.ActionGroup) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	header := append(append([]string{}, groupBy...), "count", "success", "fail", "skip", "success rate", "p50 (s)", "p90 (s)", "p99 (s)")
+	fmt.Fprintln(tw, strings.Join(header, "\t"))
+	for _, g := range groups {
+		row := append([]string{}, g.GetKeys()...)
+		rate := 0.0
+		if g.GetCount() > 0 {
+			rate = 100 * float64(g.GetSuccessCount()) / float64(g.GetCount())
+		}
+		row = append(row,
+			fmt.Sprintf("%d", g.GetCount()),
+			fmt.Sprintf("%d", g.GetSuccessCount()),
+			fmt.Sprintf("%d", g.GetFailCount()),
+			fmt.Sprintf("%d", g.GetSkipCount()),
+			fmt.Sprintf("%.1f%%", rate),
+			fmt.Sprintf("%.1f", g.GetDurationP50Seconds()),
+			fmt.Sprintf("%.1f", g.GetDurationP90Seconds()),
+			fmt.Sprintf("%.1f", g.GetDurationP99Seconds()),
+		)
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+	return tw.Flush()
+}
@@ -28,6 +28,7 @@ func newApplication() *cli.Application {
 		},
 		Commands: []*subcommands.Command{
 			subcommands.Section("main API"),
+			kartecli.Aggregate,
 			kartecli.Backfill,
 			kartecli.CheckServer,
 			kartecli.CreateAction,
@@ -41,6 +41,14 @@ func ApplyConditions(q *datastore.Query, conditions []Expression) (*datastore.Qu
 		switch r.comparator {
 		case "_==_":
 			q = q.Eq(r.field, r.value)
+		case "_<_":
+			q = q.Lt(r.field, r.value)
+		case "_<=_":
+			q = q.Lte(r.field, r.value)
+		case "_>_":
+			q = q.Gt(r.field, r.value)
+		case "_>=_":
+			q = q.Gte(r.field, r.value)
 		default:
 			return nil, errors.Reason("apply conditions: comparator %q not yet implemented", r.comparator).Err()
 		}
@@ -54,7 +62,7 @@ func ApplyConditions(q *datastore.Query, conditions []Expression) (*datastore.Qu
 type comparisonParseResult struct {
 	comparator string
 	field      string
-	// Supported types: string
+	// Supported types: string, int64
 	value interface{}
 }
 
@@ -86,14 +94,15 @@ func validateComparison(e Expression) (*comparisonParseResult, error) {
 	}
 	value := valueExpr.Value
 
-	v, ok := value.(string)
-	if !ok {
+	switch value.(type) {
+	case string, int64:
+	default:
 		return nil, status.Errorf(codes.Internal, "validate comparison: constant type not yet implemented")
 	}
 
 	return &comparisonParseResult{
 		comparator: appl.Head,
 		field:      field,
-		value:      v,
+		value:      value,
 	}, nil
 }
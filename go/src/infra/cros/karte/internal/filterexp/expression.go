@@ -203,6 +203,8 @@ func extractConstantValue(e *exprpb.Constant) (Expression, error) {
 	switch v := e.ConstantKind.(type) {
 	case *exprpb.Constant_StringValue:
 		return NewConstant(v.StringValue), nil
+	case *exprpb.Constant_Int64Value:
+		return NewConstant(v.Int64Value), nil
 	default:
 		return nil, errors.Reason("extract constant value: type %q not implemented", reflect.TypeOf(v).Name()).Err()
 	}
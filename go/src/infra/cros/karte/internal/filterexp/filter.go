@@ -0,0 +1,577 @@
+// Copyright 2024 The Chromium Authors
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package filterexp
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/google/cel-go/cel"
+	exprpb "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/gae/service/datastore"
+)
+
+// maxAlternatives is the maximum number of alternatives in a filter.
+// Every alternative is a separate datastore query.
+const maxAlternatives = 16
+
+// containsFunction is the CEL function used for full-text conditions,
+// e.g. `fail_reason.contains("timeout")`.
+const containsFunction = "contains"
+
+// notFunction is the CEL function for the logical "not".
+const notFunction = "!_"
+
+// negatedComparisons maps comparisons to their negation.
+var negatedComparisons = map[string]string{
+	"_<_":  "_>=_",
+	"_<=_": "_>_",
+	"_>_":  "_<=_",
+	"_>=_": "_<_",
+	"_==_": "_!=_",
+	"_!=_": "_==_",
+}
+
+// aipKeywords maps the logical operators of AIP-160 to the ones of CEL.
+var aipKeywords = map[string]string{
+	"AND": "&&",
+	"OR":  "||",
+	"NOT": "!",
+}
+
+// A Schema describes the properties of the entities a filter applies to.
+type Schema struct {
+	// Unindexed are the properties excluded from the datastore indexes.
+	// Conditions on them are checked in memory, like full-text conditions.
+	Unindexed []string
+	// Enums maps properties to the values of the names of their enum,
+	// so that conditions can use the names, e.g. `status == FAIL`.
+	Enums map[string]map[string]int32
+	// CompositeIndexes lists the properties of the composite indexes, in order.
+	// A query with equalities on some properties and an inequality or an order
+	// on another one needs such an index.
+	CompositeIndexes [][]string
+}
+
+// A Filter is a filter expression in disjunctive normal form.
+//
+// The filter matches an entity if any of its alternatives matches the entity.
+// An alternative is a list of conditions implicitly joined together with "and".
+type Filter struct {
+	Alternatives [][]Expression
+	// schema is the schema of the entities, if any.
+	schema *Schema
+	// unindexed are the unindexed properties of the schema.
+	unindexed map[string]bool
+}
+
+// ParseFilter parses a program into a filter for entities with the given schema,
+// which may be nil.
+//
+// In addition to the programs supported by Parse, the program can join
+// conditions with "||" (or "OR"), negate them with "!" (or "NOT"), compare
+// with "!=" and have full-text conditions, e.g. `fail_reason.contains("timeout")`.
+// "&&" can also be written "AND".
+func ParseFilter(program string, schema *Schema) (*Filter, error) {
+	f := &Filter{
+		schema:    schema,
+		unindexed: make(map[string]bool),
+	}
+	if schema != nil {
+		for _, field := range schema.Unindexed {
+			f.unindexed[field] = true
+		}
+	}
+	if program == "" {
+		f.Alternatives = [][]Expression{nil}
+		return f, nil
+	}
+	env, err := cel.NewEnv()
+	if err != nil {
+		return nil, errors.Annotate(err, "parse filter %q", program).Err()
+	}
+	ast, issues := env.Parse(translateKeywords(program))
+	if err := issues.Err(); err != nil {
+		return nil, errors.Annotate(err, "parse filter %q", program).Err()
+	}
+	alternatives, err := toDisjunctiveNormalForm(ast.Expr(), false)
+	if err != nil {
+		return nil, errors.Annotate(err, "parse filter %q", program).Err()
+	}
+	for _, conds := range alternatives {
+		for i, cond := range conds {
+			if conds[i], err = resolveEnum(schema, cond); err != nil {
+				return nil, errors.Annotate(err, "parse filter %q", program).Err()
+			}
+		}
+	}
+	f.Alternatives = alternatives
+	return f, nil
+}
+
+// translateKeywords replaces the AIP-160 logical operators outside of string
+// literals with the CEL ones.
+func translateKeywords(program string) string {
+	var b strings.Builder
+	for i := 0; i < len(program); {
+		c := program[i]
+		switch {
+		case c == '"' || c == '\'':
+			j := i + 1
+			for j < len(program) && program[j] != c {
+				if program[j] == '\\' {
+					j++
+				}
+				j++
+			}
+			if j < len(program) {
+				j++
+			} else {
+				j = len(program)
+			}
+			b.WriteString(program[i:j])
+			i = j
+		case isIdentifierByte(c):
+			j := i
+			for j < len(program) && isIdentifierByte(program[j]) {
+				j++
+			}
+			word := program[i:j]
+			if kw, ok := aipKeywords[word]; ok {
+				word = kw
+			}
+			b.WriteString(word)
+			i = j
+		default:
+			b.WriteByte(c)
+			i++
+		}
+	}
+	return b.String()
+}
+
+// isIdentifierByte checks whether the byte can be part of an identifier.
+func isIdentifierByte(c byte) bool {
+	return c == '_' || c == '.' || ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || ('0' <= c && c <= '9')
+}
+
+// resolveEnum replaces the enum name compared with a property by its value.
+func resolveEnum(schema *Schema, e Expression) (Expression, error) {
+	appl, ok := e.(*Application)
+	if !ok || len(appl.Tail) != 2 {
+		return e, nil
+	}
+	field, ok := appl.Tail[0].(*Identifier)
+	if !ok {
+		return e, nil
+	}
+	name, ok := appl.Tail[1].(*Identifier)
+	if !ok {
+		return e, nil
+	}
+	var values map[string]int32
+	if schema != nil {
+		values = schema.Enums[field.Value]
+	}
+	v, ok := values[name.Value]
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "resolve enum: unknown value %q of %q", name.Value, field.Value)
+	}
+	return NewApplication(appl.Head, field, NewConstant(int64(v))), nil
+}
+
+// toDisjunctiveNormalForm converts the expression, negated if requested, to a list of alternatives.
+//
+// Negations are pushed down to the conditions, and "!=" is split into "<" or ">", so
+// every alternative can be applied to a datastore query.
+func toDisjunctiveNormalForm(e *exprpb.Expr, negate bool) ([][]Expression, error) {
+	v, ok := e.ExprKind.(*exprpb.Expr_CallExpr)
+	if !ok {
+		return nil, errors.Reason("unexpected expression kind %q", reflect.TypeOf(e.ExprKind).Elem().Name()).Err()
+	}
+	c := v.CallExpr
+	switch {
+	case c.Function == notFunction:
+		if len(c.Args) != 1 {
+			return nil, errors.Reason("%q expects 1 argument", c.Function).Err()
+		}
+		return toDisjunctiveNormalForm(c.Args[0], !negate)
+	case c.Function == "_&&_" && !negate, c.Function == "_||_" && negate:
+		return conjoin(c.Args, negate)
+	case c.Function == "_||_" && !negate, c.Function == "_&&_" && negate:
+		return disjoin(c.Args, negate)
+	case c.Function == containsFunction:
+		cond, err := processContains(c)
+		if err != nil {
+			return nil, errors.Annotate(err, "to disjunctive normal form").Err()
+		}
+		if negate {
+			cond = NewApplication(notFunction, cond)
+		}
+		return [][]Expression{{cond}}, nil
+	case negatedComparisons[c.Function] != "":
+		cond, err := processComparison(nil, c)
+		if err != nil {
+			return nil, errors.Annotate(err, "to disjunctive normal form").Err()
+		}
+		return comparisonAlternatives(cond.(*Application), negate), nil
+	}
+	return nil, errors.Reason("unsupported function %q", c.Function).Err()
+}
+
+// conjoin combines the alternatives of the arguments joined by "and".
+func conjoin(args []*exprpb.Expr, negate bool) ([][]Expression, error) {
+	out := [][]Expression{nil}
+	for _, arg := range args {
+		alternatives, err := toDisjunctiveNormalForm(arg, negate)
+		if err != nil {
+			return nil, err
+		}
+		var next [][]Expression
+		for _, left := range out {
+			for _, right := range alternatives {
+				conds := make([]Expression, 0, len(left)+len(right))
+				conds = append(conds, left...)
+				next = append(next, append(conds, right...))
+			}
+		}
+		if len(next) > maxAlternatives {
+			return nil, errors.Reason("filter has more than %d alternatives", maxAlternatives).Err()
+		}
+		out = next
+	}
+	return out, nil
+}
+
+// disjoin combines the alternatives of the arguments joined by "or".
+func disjoin(args []*exprpb.Expr, negate bool) ([][]Expression, error) {
+	var out [][]Expression
+	for _, arg := range args {
+		alternatives, err := toDisjunctiveNormalForm(arg, negate)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, alternatives...)
+		if len(out) > maxAlternatives {
+			return nil, errors.Reason("filter has more than %d alternatives", maxAlternatives).Err()
+		}
+	}
+	return out, nil
+}
+
+// comparisonAlternatives returns the alternatives of the comparison, negated if requested.
+// Datastore has no "!=" operator, so `a != b` is split into `a < b` or `a > b`.
+func comparisonAlternatives(cond *Application, negate bool) [][]Expression {
+	head := cond.Head
+	if negate {
+		head = negatedComparisons[head]
+	}
+	if head == "_!=_" {
+		return [][]Expression{
+			{NewApplication("_<_", cond.Tail...)},
+			{NewApplication("_>_", cond.Tail...)},
+		}
+	}
+	return [][]Expression{{NewApplication(head, cond.Tail...)}}
+}
+
+// processContains converts a call like `field.contains("text")` into a condition.
+func processContains(e *exprpb.Expr_Call) (Expression, error) {
+	target, ok := e.GetTarget().GetExprKind().(*exprpb.Expr_IdentExpr)
+	if !ok || len(e.Args) != 1 {
+		return nil, errors.Reason("process contains: expected field.contains(\"text\")").Err()
+	}
+	text, ok := e.Args[0].GetConstExpr().GetConstantKind().(*exprpb.Constant_StringValue)
+	if !ok {
+		return nil, errors.Reason("process contains: text must be a string").Err()
+	}
+	return NewApplication(containsFunction, NewIdentifier(target.IdentExpr.GetName()), NewConstant(text.StringValue)), nil
+}
+
+// inMemory checks whether the condition is a full-text condition or a condition on
+// an unindexed property, which cannot be applied to a datastore query.
+func (f *Filter) inMemory(e Expression) bool {
+	appl, ok := e.(*Application)
+	if !ok {
+		return false
+	}
+	if appl.Head == notFunction && len(appl.Tail) == 1 {
+		return f.inMemory(appl.Tail[0])
+	}
+	if appl.Head == containsFunction {
+		return true
+	}
+	if len(appl.Tail) > 0 {
+		if field, ok := appl.Tail[0].(*Identifier); ok {
+			return f.unindexed[field.Value]
+		}
+	}
+	return false
+}
+
+// HasInMemoryConditions checks whether the filter has full-text conditions or
+// conditions on unindexed properties.
+// Such a filter must be checked with Matches against every entity returned by its queries.
+func (f *Filter) HasInMemoryConditions() bool {
+	for _, conds := range f.Alternatives {
+		for _, cond := range conds {
+			if f.inMemory(cond) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Equality returns the value the field is compared with if every alternative
+// of the filter has the same equality condition on the field.
+func (f *Filter) Equality(field string) (interface{}, bool) {
+	var value interface{}
+	for i, conds := range f.Alternatives {
+		found := false
+		for _, cond := range conds {
+			if f.inMemory(cond) {
+				continue
+			}
+			r, err := validateComparison(cond)
+			if err != nil || r.comparator != "_==_" || r.field != field {
+				continue
+			}
+			if i > 0 && r.value != value {
+				return nil, false
+			}
+			value = r.value
+			found = true
+			break
+		}
+		if !found {
+			return nil, false
+		}
+	}
+	return value, value != nil
+}
+
+// Queries returns one query per alternative of the filter, based on the given query.
+//
+// The results of the queries are meant to be merged by datastore.RunMulti, which
+// requires the same order for all queries. So a filter with several alternatives
+// can only have inequality conditions on a single field, and every query is ordered by it.
+//
+// If the filter has a schema, a query with equalities on some fields and an inequality
+// or an order on another one is rejected unless the schema has a composite index for it.
+func (f *Filter) Queries(q *datastore.Query) ([]*datastore.Query, error) {
+	orderField := ""
+	for _, conds := range f.Alternatives {
+		for _, cond := range conds {
+			if f.inMemory(cond) {
+				continue
+			}
+			r, err := validateComparison(cond)
+			if err != nil {
+				return nil, errors.Annotate(err, "queries").Err()
+			}
+			if r.comparator == "_==_" || len(f.Alternatives) == 1 {
+				continue
+			}
+			if orderField != "" && orderField != r.field {
+				return nil, status.Errorf(codes.InvalidArgument, "queries: filter with alternatives has inequalities on fields %q and %q", orderField, r.field)
+			}
+			orderField = r.field
+		}
+	}
+	var out []*datastore.Query
+	seen := make(map[string]bool)
+	for _, conds := range f.Alternatives {
+		var dsConds []Expression
+		for _, cond := range conds {
+			if !f.inMemory(cond) {
+				dsConds = append(dsConds, cond)
+			}
+		}
+		// Alternatives differing only in their in-memory conditions share a query.
+		key, err := conditionsKey(dsConds)
+		if err != nil {
+			return nil, errors.Annotate(err, "queries").Err()
+		}
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		if err := f.checkIndex(dsConds, orderField); err != nil {
+			return nil, errors.Annotate(err, "queries").Err()
+		}
+		altQuery, err := ApplyConditions(q, dsConds)
+		if err != nil {
+			return nil, errors.Annotate(err, "queries").Err()
+		}
+		if orderField != "" {
+			altQuery = altQuery.Order(orderField)
+		}
+		out = append(out, altQuery)
+	}
+	return out, nil
+}
+
+// conditionsKey returns a string identifying the datastore conditions.
+func conditionsKey(conds []Expression) (string, error) {
+	var b strings.Builder
+	for _, cond := range conds {
+		r, err := validateComparison(cond)
+		if err != nil {
+			return "", errors.Annotate(err, "conditions key").Err()
+		}
+		fmt.Fprintf(&b, "%s %s %#v\n", r.field, r.comparator, r.value)
+	}
+	return b.String(), nil
+}
+
+// checkIndex checks that the schema has a composite index for a query with the
+// given datastore conditions, ordered by orderField if it is not empty.
+func (f *Filter) checkIndex(conds []Expression, orderField string) error {
+	if f.schema == nil {
+		return nil
+	}
+	equalities := make(map[string]bool)
+	inequalities := make(map[string]bool)
+	if orderField != "" {
+		inequalities[orderField] = true
+	}
+	for _, cond := range conds {
+		r, err := validateComparison(cond)
+		if err != nil {
+			return errors.Annotate(err, "check index").Err()
+		}
+		if r.comparator == "_==_" {
+			equalities[r.field] = true
+		} else {
+			inequalities[r.field] = true
+		}
+	}
+	if len(equalities) == 0 || len(inequalities) == 0 {
+		return nil
+	}
+	for _, index := range f.schema.CompositeIndexes {
+		last := len(index) - 1
+		if len(inequalities) != 1 || !inequalities[index[last]] || len(equalities) != last {
+			continue
+		}
+		ok := true
+		for _, field := range index[:last] {
+			ok = ok && equalities[field]
+		}
+		if ok {
+			return nil
+		}
+	}
+	return status.Errorf(codes.InvalidArgument, "check index: no index for equalities on %v and inequalities on %v, see filter_syntax.md", sortedKeys(equalities), sortedKeys(inequalities))
+}
+
+// sortedKeys returns the keys of a set in order.
+func sortedKeys(set map[string]bool) []string {
+	out := make([]string, 0, len(set))
+	for k := range set {
+		out = append(out, k)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// Matches checks whether the properties of an entity match the filter.
+//
+// Values are compared as in datastore queries, e.g. times as microseconds since
+// the epoch, and values of different types never match.
+func (f *Filter) Matches(pm datastore.PropertyMap) (bool, error) {
+	for _, conds := range f.Alternatives {
+		ok, err := matchesAll(pm, conds)
+		if err != nil {
+			return false, errors.Annotate(err, "matches").Err()
+		}
+		if ok {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// matchesAll checks whether the properties match all conditions.
+func matchesAll(pm datastore.PropertyMap, conds []Expression) (bool, error) {
+	for _, cond := range conds {
+		ok, err := matchesCondition(pm, cond)
+		if err != nil {
+			return false, err
+		}
+		if !ok {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// matchesCondition checks whether any value of the field matches the condition.
+func matchesCondition(pm datastore.PropertyMap, cond Expression) (bool, error) {
+	if appl, ok := cond.(*Application); ok && appl.Head == notFunction && len(appl.Tail) == 1 {
+		ok, err := matchesCondition(pm, appl.Tail[0])
+		return !ok, err
+	}
+	r, err := validateComparison(cond)
+	if err != nil {
+		return false, errors.Annotate(err, "match condition").Err()
+	}
+	for _, p := range pm.Slice(r.field) {
+		_, v := p.IndexTypeAndValue()
+		if compareValues(r.comparator, v, r.value) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// compareValues applies the comparator to the value of a property and a constant.
+func compareValues(comparator string, value, constant interface{}) bool {
+	var cmp int
+	switch c := constant.(type) {
+	case string:
+		v, ok := value.(string)
+		if !ok {
+			return false
+		}
+		if comparator == containsFunction {
+			return strings.Contains(v, c)
+		}
+		cmp = strings.Compare(v, c)
+	case int64:
+		v, ok := value.(int64)
+		if !ok {
+			return false
+		}
+		switch {
+		case v < c:
+			cmp = -1
+		case v > c:
+			cmp = 1
+		}
+	default:
+		return false
+	}
+	switch comparator {
+	case "_<_":
+		return cmp < 0
+	case "_<=_":
+		return cmp <= 0
+	case "_>_":
+		return cmp > 0
+	case "_>=_":
+		return cmp >= 0
+	case "_==_":
+		return cmp == 0
+	}
+	return false
+}
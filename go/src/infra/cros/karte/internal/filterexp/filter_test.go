@@ -0,0 +1,317 @@
+// Copyright 2024 The Chromium Authors
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package filterexp
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"go.chromium.org/luci/gae/service/datastore"
+)
+
+// testSchema is the schema of the entities in the tests.
+var testSchema = &Schema{
+	Unindexed: []string{"fail_reason"},
+	Enums: map[string]map[string]int32{
+		"status": {"PASS": 1, "FAIL": 2},
+	},
+	CompositeIndexes: [][]string{
+		{"b", "a"},
+		{"kind", "stop_time"},
+	},
+}
+
+// TestParseFilter tests parsing filter expressions into alternatives.
+func TestParseFilter(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name   string
+		input  string
+		output [][]Expression
+		ok     bool
+	}{
+		{
+			name:   "empty",
+			input:  "",
+			output: [][]Expression{nil},
+			ok:     true,
+		},
+		{
+			name:  "or",
+			input: `a == "A" || b == "B"`,
+			output: [][]Expression{
+				{NewApplication("_==_", NewIdentifier("a"), NewConstant("A"))},
+				{NewApplication("_==_", NewIdentifier("b"), NewConstant("B"))},
+			},
+			ok: true,
+		},
+		{
+			name:  "and distributes over or",
+			input: `(a == "A" || a == "B") && c > 4`,
+			output: [][]Expression{
+				{
+					NewApplication("_==_", NewIdentifier("a"), NewConstant("A")),
+					NewApplication("_>_", NewIdentifier("c"), NewConstant(int64(4))),
+				},
+				{
+					NewApplication("_==_", NewIdentifier("a"), NewConstant("B")),
+					NewApplication("_>_", NewIdentifier("c"), NewConstant(int64(4))),
+				},
+			},
+			ok: true,
+		},
+		{
+			name:  "not is pushed down",
+			input: `!(a < "A" || b == "B")`,
+			output: [][]Expression{
+				{
+					NewApplication("_>=_", NewIdentifier("a"), NewConstant("A")),
+					NewApplication("_<_", NewIdentifier("b"), NewConstant("B")),
+				},
+				{
+					NewApplication("_>=_", NewIdentifier("a"), NewConstant("A")),
+					NewApplication("_>_", NewIdentifier("b"), NewConstant("B")),
+				},
+			},
+			ok: true,
+		},
+		{
+			name:  "not equal",
+			input: `a != "A"`,
+			output: [][]Expression{
+				{NewApplication("_<_", NewIdentifier("a"), NewConstant("A"))},
+				{NewApplication("_>_", NewIdentifier("a"), NewConstant("A"))},
+			},
+			ok: true,
+		},
+		{
+			name:  "negated contains",
+			input: `a == "A" && !b.contains("text")`,
+			output: [][]Expression{
+				{
+					NewApplication("_==_", NewIdentifier("a"), NewConstant("A")),
+					NewApplication("!_", NewApplication("contains", NewIdentifier("b"), NewConstant("text"))),
+				},
+			},
+			ok: true,
+		},
+		{
+			name:  "keywords",
+			input: `a == "OR" OR NOT (b < 4)`,
+			output: [][]Expression{
+				{NewApplication("_==_", NewIdentifier("a"), NewConstant("OR"))},
+				{NewApplication("_>=_", NewIdentifier("b"), NewConstant(int64(4)))},
+			},
+			ok: true,
+		},
+		{
+			name:  "enum",
+			input: `status == FAIL`,
+			output: [][]Expression{
+				{NewApplication("_==_", NewIdentifier("status"), NewConstant(int64(2)))},
+			},
+			ok: true,
+		},
+		{
+			name:  "unknown enum value",
+			input: `status == BROKEN`,
+			ok:    false,
+		},
+		{
+			name:  "enum value of another field",
+			input: `a == FAIL`,
+			ok:    false,
+		},
+		{
+			name:  "too many alternatives",
+			input: `(a == "1" || a == "2" || a == "3") && (b == "1" || b == "2" || b == "3") && (c == "1" || c == "2")`,
+			ok:    false,
+		},
+		{
+			name:  "unsupported function",
+			input: `size(a) == 4`,
+			ok:    false,
+		},
+		{
+			name:  "contains with non-string",
+			input: `a.contains(4)`,
+			ok:    false,
+		},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			f, err := ParseFilter(tt.input, testSchema)
+			if tt.ok {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				if diff := cmp.Diff(tt.output, f.Alternatives, cmpopts...); diff != "" {
+					t.Errorf("unexpected diff: %s", diff)
+				}
+			} else if err == nil {
+				t.Errorf("expected error to not be nil")
+			}
+		})
+	}
+}
+
+// TestFilterQueries tests that alternatives with inequalities on different fields
+// and queries without a composite index are rejected.
+func TestFilterQueries(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		name    string
+		input   string
+		queries int
+		ok      bool
+	}{
+		{
+			name:    "single alternative",
+			input:   `a > "A" && b < "B"`,
+			queries: 1,
+			ok:      true,
+		},
+		{
+			name:    "inequalities on one field",
+			input:   `a != "A" && b == "B"`,
+			queries: 2,
+			ok:      true,
+		},
+		{
+			name:  "inequalities on two fields",
+			input: `a > "A" || b < "B"`,
+			ok:    false,
+		},
+		{
+			name:    "equalities only",
+			input:   `kind == "repair" && b == "B"`,
+			queries: 1,
+			ok:      true,
+		},
+		{
+			name:    "inequality with an index",
+			input:   `kind == "repair" && stop_time > 1000`,
+			queries: 1,
+			ok:      true,
+		},
+		{
+			name:  "inequality without an index",
+			input: `kind == "repair" && start_time > 1000`,
+			ok:    false,
+		},
+		{
+			name:  "order without an index",
+			input: `(kind == "repair" && b == "B") || a > "A"`,
+			ok:    false,
+		},
+		{
+			name:    "unindexed field",
+			input:   `kind == "repair" AND (status == FAIL OR fail_reason != "")`,
+			queries: 2,
+			ok:      true,
+		},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			f, err := ParseFilter(tt.input, testSchema)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			qs, err := f.Queries(datastore.NewQuery("Kind"))
+			if tt.ok && err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+			if tt.ok && len(qs) != tt.queries {
+				t.Errorf("got %d queries, want %d", len(qs), tt.queries)
+			}
+			if !tt.ok && err == nil {
+				t.Errorf("expected error to not be nil")
+			}
+		})
+	}
+}
+
+// TestFilterMatches tests checking entity properties against a filter.
+func TestFilterMatches(t *testing.T) {
+	t.Parallel()
+	pm := datastore.PropertyMap{
+		"kind":        datastore.MkProperty("ssh"),
+		"fail_reason": datastore.MkProperty("connection timeout after 10s"),
+		"stop_time":   datastore.MkProperty(time.UnixMicro(1000).UTC()),
+		"status":      datastore.MkProperty(int64(2)),
+	}
+	cases := []struct {
+		name   string
+		filter string
+		want   bool
+	}{
+		{
+			name:   "contains",
+			filter: `fail_reason.contains("timeout")`,
+			want:   true,
+		},
+		{
+			name:   "negated contains",
+			filter: `!fail_reason.contains("timeout")`,
+			want:   false,
+		},
+		{
+			name:   "second alternative",
+			filter: `kind == "servo" || stop_time >= 1000`,
+			want:   true,
+		},
+		{
+			name:   "no alternative",
+			filter: `kind == "servo" || stop_time < 1000`,
+			want:   false,
+		},
+		{
+			name:   "mismatched types",
+			filter: `stop_time == "1000"`,
+			want:   false,
+		},
+		{
+			name:   "time",
+			filter: `stop_time > 999 && stop_time <= 1000`,
+			want:   true,
+		},
+		{
+			name:   "enum",
+			filter: `kind == "ssh" AND (status == PASS OR fail_reason != "")`,
+			want:   true,
+		},
+		{
+			name:   "missing field",
+			filter: `status.contains("FAIL")`,
+			want:   false,
+		},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			f, err := ParseFilter(tt.filter, testSchema)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			got, err := f.Matches(pm)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if got != tt.want {
+				t.Errorf("Matches(%q) = %t, want %t", tt.filter, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,209 @@
+// Copyright 2024 The Chromium Authors
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package frontend
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/gae/service/datastore"
+
+	kartepb "infra/cros/karte/api"
+	"infra/cros/karte/internal/filterexp"
+	"infra/cros/karte/internal/scalars"
+)
+
+// defaultAggregateWindow is the window of stop times used when the request has no start time.
+const defaultAggregateWindow = 24 * time.Hour
+
+// maxAggregateWindow is the longest window of stop times that is aggregated directly from datastore.
+// Longer windows should be aggregated from the BigQuery export.
+const maxAggregateWindow = 7 * 24 * time.Hour
+
+// maxAggregatedActions is the maximum number of actions read from datastore by a single request.
+const maxAggregatedActions = 200000
+
+// actionGroupKeys maps the supported group_by fields to the value of an action entity.
+var actionGroupKeys = map[string]func(e *ActionEntity) string{
+	"kind":   func(e *ActionEntity) string { return e.Kind },
+	"model":  func(e *ActionEntity) string { return e.Model },
+	"board":  func(e *ActionEntity) string { return e.Board },
+	"status": func(e *ActionEntity) string { return scalars.ConvertActionStatusIntToString(e.Status) },
+}
+
+// AggregateActions groups the actions that stopped in a time window and returns statistics per group.
+func (k *karteFrontend) AggregateActions(ctx context.Context, req *kartepb.AggregateActionsRequest) (*kartepb.AggregateActionsResponse, error) {
+	start, stop, err := aggregateWindow(req, time.Now().UTC())
+	if err != nil {
+		return nil, errors.Annotate(err, "aggregate actions").Err()
+	}
+	f, err := filterexp.ParseFilter(req.GetFilter(), actionSchema)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "aggregate actions: %s", err)
+	}
+	a, err := newActionAggregator(req.GetGroupBy())
+	if err != nil {
+		return nil, errors.Annotate(err, "aggregate actions").Err()
+	}
+	logging.Infof(ctx, "Aggregating actions stopped in [%s, %s) by %v", start, stop, req.GetGroupBy())
+	// The filter is checked in memory, as its inequalities may be on other fields than the stop time.
+	// One of its equalities narrows down the query if there is an index for it.
+	q := datastore.NewQuery(ActionKind).Gte("stop_time", start).Lt("stop_time", stop)
+	if field, value, ok := aggregateEquality(f); ok {
+		logging.Infof(ctx, "Reading only actions with %s == %v", field, value)
+		q = q.Eq(field, value)
+	}
+	read := 0
+	err = datastore.Run(ctx, q, func(ent *ActionEntity) error {
+		read++
+		if read > maxAggregatedActions {
+			return status.Errorf(codes.ResourceExhausted, "more than %d actions in window, use a smaller window or the BigQuery export", maxAggregatedActions)
+		}
+		pm, err := datastore.GetPLS(ent).Save(false)
+		if err != nil {
+			return errors.Annotate(err, "action %q", ent.ID).Err()
+		}
+		ok, err := f.Matches(pm)
+		if err != nil {
+			return errors.Annotate(err, "action %q", ent.ID).Err()
+		}
+		if ok {
+			a.add(ent)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Annotate(err, "aggregate actions").Err()
+	}
+	return &kartepb.AggregateActionsResponse{
+		Groups: a.groups(),
+	}, nil
+}
+
+// aggregateEquality returns an equality condition of every alternative of the filter
+// that can be added to the query of actions by stop time, as actionSchema has an index
+// for it.
+func aggregateEquality(f *filterexp.Filter) (string, interface{}, bool) {
+	for _, index := range actionSchema.CompositeIndexes {
+		if len(index) != 2 || index[1] != "stop_time" {
+			continue
+		}
+		if value, ok := f.Equality(index[0]); ok {
+			return index[0], value, true
+		}
+	}
+	return "", nil, false
+}
+
+// aggregateWindow returns the window of stop times of the request, applying the defaults.
+func aggregateWindow(req *kartepb.AggregateActionsRequest, now time.Time) (time.Time, time.Time, error) {
+	var zero time.Time
+	stop := now
+	if req.GetStopTime() != nil {
+		stop = scalars.ConvertTimestampPtrToTime(req.GetStopTime())
+	}
+	start := stop.Add(-defaultAggregateWindow)
+	if req.GetStartTime() != nil {
+		start = scalars.ConvertTimestampPtrToTime(req.GetStartTime())
+	}
+	if !start.Before(stop) {
+		return zero, zero, status.Errorf(codes.InvalidArgument, "aggregate window: start time %s is not before stop time %s", start, stop)
+	}
+	if d := stop.Sub(start); d > maxAggregateWindow {
+		return zero, zero, status.Errorf(codes.InvalidArgument, "aggregate window: window of %s is longer than %s, use the BigQuery export instead", d, maxAggregateWindow)
+	}
+	return start, stop, nil
+}
+
+// actionGroupStats is the statistics of a group of actions being collected.
+type actionGroupStats struct {
+	group     *kartepb.ActionGroup
+	durations []float64
+}
+
+// actionAggregator collects the statistics of actions per group.
+type actionAggregator struct {
+	groupBy []string
+	stats   map[string]*actionGroupStats
+}
+
+// newActionAggregator makes an aggregator grouping actions by the given fields.
+func newActionAggregator(groupBy []string) (*actionAggregator, error) {
+	for _, field := range groupBy {
+		if _, ok := actionGroupKeys[field]; !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "new action aggregator: unsupported group_by field %q", field)
+		}
+	}
+	return &actionAggregator{
+		groupBy: groupBy,
+		stats:   make(map[string]*actionGroupStats),
+	}, nil
+}
+
+// add adds an action to its group.
+func (a *actionAggregator) add(e *ActionEntity) {
+	keys := make([]string, len(a.groupBy))
+	for i, field := range a.groupBy {
+		keys[i] = actionGroupKeys[field](e)
+	}
+	id := strings.Join(keys, "\x00")
+	s, ok := a.stats[id]
+	if !ok {
+		s = &actionGroupStats{group: &kartepb.ActionGroup{Keys: keys}}
+		a.stats[id] = s
+	}
+	s.group.Count++
+	switch scalars.ConvertInt32ToActionStatus(e.Status) {
+	case kartepb.Action_SUCCESS:
+		s.group.SuccessCount++
+	case kartepb.Action_FAIL:
+		s.group.FailCount++
+	case kartepb.Action_SKIP:
+		s.group.SkipCount++
+	}
+	if !e.StartTime.IsZero() && !e.StopTime.IsZero() && !e.StopTime.Before(e.StartTime) {
+		s.durations = append(s.durations, e.StopTime.Sub(e.StartTime).Seconds())
+	}
+}
+
+// groups returns the statistics of every group, ordered by their keys.
+func (a *actionAggregator) groups() []*kartepb.ActionGroup {
+	ids := make([]string, 0, len(a.stats))
+	for id := range a.stats {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	out := make([]*kartepb.ActionGroup, 0, len(ids))
+	for _, id := range ids {
+		s := a.stats[id]
+		sort.Float64s(s.durations)
+		s.group.DurationP50Seconds = percentile(s.durations, 50)
+		s.group.DurationP90Seconds = percentile(s.durations, 90)
+		s.group.DurationP99Seconds = percentile(s.durations, 99)
+		out = append(out, s.group)
+	}
+	return out
+}
+
+// percentile returns the p-th percentile of sorted values using the nearest-rank method.
+// It returns zero if there are no values.
+func percentile(sorted []float64, p float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
@@ -0,0 +1,184 @@
+// Copyright 2024 The Chromium Authors
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package frontend
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"go.chromium.org/luci/gae/service/datastore"
+
+	kartepb "infra/cros/karte/api"
+	"infra/cros/karte/internal/filterexp"
+	"infra/cros/karte/internal/scalars"
+	"infra/cros/karte/internal/testsupport"
+)
+
+// TestAggregateActions tests grouping actions in a window and computing their statistics.
+func TestAggregateActions(t *testing.T) {
+	t.Parallel()
+	ctx := testsupport.NewTestingContext(context.Background())
+	datastore.GetTestable(ctx).Consistent(true)
+	// The query uses the composite indexes of the app.
+	defs, err := datastore.FindAndParseIndexYAML("../../cmd/karteserver")
+	if err != nil {
+		t.Fatalf("parsing indexes: %s", err)
+	}
+	datastore.GetTestable(ctx).AddIndexes(defs...)
+	k := NewKarteFrontend("")
+
+	base := time.Unix(1000000, 0).UTC()
+	action := func(id string, board string, status kartepb.Action_Status, seconds int, stopOffset time.Duration) *ActionEntity {
+		stop := base.Add(stopOffset)
+		return &ActionEntity{
+			ID:        id,
+			Kind:      "repair",
+			Board:     board,
+			Status:    scalars.ConvertActionStatusToInt32(status),
+			StartTime: stop.Add(-time.Duration(seconds) * time.Second),
+			StopTime:  stop,
+		}
+	}
+	if err := PutActionEntities(
+		ctx,
+		action("a1", "octopus", kartepb.Action_SUCCESS, 10, time.Minute),
+		action("a2", "octopus", kartepb.Action_FAIL, 30, 2*time.Minute),
+		action("a3", "octopus", kartepb.Action_SUCCESS, 20, 3*time.Minute),
+		action("a4", "nami", kartepb.Action_SKIP, 5, 4*time.Minute),
+		// Outside of the window.
+		action("a5", "nami", kartepb.Action_FAIL, 5, 2*time.Hour),
+		&ActionEntity{ID: "a6", Kind: "audit", Board: "nami", StopTime: base.Add(time.Minute)},
+	); err != nil {
+		t.Fatalf("putting entities: %s", err)
+	}
+
+	resp, err := k.AggregateActions(ctx, &kartepb.AggregateActionsRequest{
+		StartTime: scalars.ConvertTimeToTimestampPtr(base),
+		StopTime:  scalars.ConvertTimeToTimestampPtr(base.Add(time.Hour)),
+		GroupBy:   []string{"board"},
+		Filter:    `kind == "repair"`,
+	})
+	if err != nil {
+		t.Fatalf("aggregate actions: %s", err)
+	}
+	want := &kartepb.AggregateActionsResponse{
+		Groups: []*kartepb.ActionGroup{
+			{
+				Keys:               []string{"nami"},
+				Count:              1,
+				SkipCount:          1,
+				DurationP50Seconds: 5,
+				DurationP90Seconds: 5,
+				DurationP99Seconds: 5,
+			},
+			{
+				Keys:               []string{"octopus"},
+				Count:              3,
+				SuccessCount:       2,
+				FailCount:          1,
+				DurationP50Seconds: 20,
+				DurationP90Seconds: 30,
+				DurationP99Seconds: 30,
+			},
+		},
+	}
+	if diff := cmp.Diff(want, resp, protocmp.Transform()); diff != "" {
+		t.Errorf("unexpected diff (-want +got): %s", diff)
+	}
+}
+
+// TestAggregateActionsBadRequest tests that invalid windows and group_by fields are rejected.
+func TestAggregateActionsBadRequest(t *testing.T) {
+	t.Parallel()
+	ctx := testsupport.NewTestingContext(context.Background())
+	k := NewKarteFrontend("")
+	base := time.Unix(1000000, 0).UTC()
+	cases := []struct {
+		name string
+		req  *kartepb.AggregateActionsRequest
+	}{
+		{
+			name: "window too long",
+			req: &kartepb.AggregateActionsRequest{
+				StartTime: scalars.ConvertTimeToTimestampPtr(base.Add(-8 * 24 * time.Hour)),
+				StopTime:  scalars.ConvertTimeToTimestampPtr(base),
+			},
+		},
+		{
+			name: "start after stop",
+			req: &kartepb.AggregateActionsRequest{
+				StartTime: scalars.ConvertTimeToTimestampPtr(base.Add(time.Hour)),
+				StopTime:  scalars.ConvertTimeToTimestampPtr(base),
+			},
+		},
+		{
+			name: "unknown group_by field",
+			req: &kartepb.AggregateActionsRequest{
+				GroupBy: []string{"hostname"},
+			},
+		},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if _, err := k.AggregateActions(ctx, tt.req); err == nil {
+				t.Error("expected error to not be nil")
+			}
+		})
+	}
+}
+
+// TestAggregateEquality tests picking the equality added to the query of AggregateActions.
+func TestAggregateEquality(t *testing.T) {
+	t.Parallel()
+	cases := []struct {
+		filter string
+		field  string
+		value  interface{}
+		ok     bool
+	}{
+		{filter: `kind == "repair"`, field: "kind", value: "repair", ok: true},
+		{filter: `board == "nami" && status == FAIL`, field: "board", value: "nami", ok: true},
+		{filter: `status == FAIL || (status == FAIL && kind == "repair")`, field: "status", value: int64(2), ok: true},
+		{filter: `kind == "repair" || kind == "audit"`},
+		{filter: `kind == "repair" || board == "nami"`},
+		{filter: `hostname == "h" && stop_time > 1000`, field: "hostname", value: "h", ok: true},
+		{filter: `fail_reason == ""`},
+		{filter: ``},
+	}
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.filter, func(t *testing.T) {
+			t.Parallel()
+			f, err := filterexp.ParseFilter(tt.filter, actionSchema)
+			if err != nil {
+				t.Fatalf("parsing filter: %s", err)
+			}
+			field, value, ok := aggregateEquality(f)
+			if field != tt.field || value != tt.value || ok != tt.ok {
+				t.Errorf("aggregateEquality(%q) = %q, %v, %t, want %q, %v, %t", tt.filter, field, value, ok, tt.field, tt.value, tt.ok)
+			}
+		})
+	}
+}
+
+// TestPercentile tests the nearest-rank percentile.
+func TestPercentile(t *testing.T) {
+	t.Parallel()
+	values := []float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	for p, want := range map[float64]float64{0: 1, 50: 5, 90: 9, 99: 10, 100: 10} {
+		if got := percentile(values, p); got != want {
+			t.Errorf("percentile(%v) = %v, want %v", p, got, want)
+		}
+	}
+	if got := percentile(nil, 50); got != 0 {
+		t.Errorf("percentile of no values = %v, want 0", got)
+	}
+}
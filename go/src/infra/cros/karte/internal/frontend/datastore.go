@@ -27,6 +27,12 @@ import (
 // defaultBatchSize is the default size of a batch for a datastore query.
 const defaultBatchSize = 50_000
 
+// maxScannedEntities is the default number of entities a single request scans
+// at most. Entities skipped by in-memory filter conditions count towards it,
+// so that a filter matching few entities doesn't scan a whole kind in one
+// request.
+const maxScannedEntities = 100_000
+
 // ActionKind is the kind of an action
 const ActionKind = "ActionKind"
 
@@ -63,6 +69,32 @@ type ActionEntity struct {
 	ErrorReason string `gae:"error_reason,noindex"` // succeeded by "fail_reason'.
 }
 
+// actionSchema describes the properties of action entities to filters.
+var actionSchema = &filterexp.Schema{
+	Unindexed: []string{"fail_reason", "error_reason"},
+	Enums: map[string]map[string]int32{
+		"status":      kartepb.Action_Status_value,
+		"allow_fail":  kartepb.Action_AllowFail_value,
+		"action_type": kartepb.Action_ActionType_value,
+	},
+	// Keep in sync with cmd/karteserver/index.yaml.
+	CompositeIndexes: [][]string{
+		{"kind", "start_time"},
+		{"kind", "stop_time"},
+		{"kind", "receive_time"},
+		{"asset_tag", "start_time"},
+		{"asset_tag", "stop_time"},
+		{"asset_tag", "receive_time"},
+		{"hostname", "start_time"},
+		{"hostname", "stop_time"},
+		{"hostname", "receive_time"},
+		// Used by AggregateActions.
+		{"model", "stop_time"},
+		{"board", "stop_time"},
+		{"status", "stop_time"},
+	},
+}
+
 // maxStringFieldLength b:267100941
 const maxStringFieldLength = 1400
 
@@ -165,6 +197,14 @@ type ObservationEntity struct {
 	ValueNumber float64 `gae:"value_number"`
 }
 
+// observationSchema describes the properties of observation entities to filters.
+var observationSchema = &filterexp.Schema{
+	// Keep in sync with cmd/karteserver/index.yaml.
+	CompositeIndexes: [][]string{
+		{"metric_kind", "value_number"},
+	},
+}
+
 // GetType returns whether an observation record is a number or a string.
 func (e *ObservationEntity) GetType() string {
 	if e.ValueString != "" {
@@ -260,6 +300,29 @@ type ActionEntitiesQuery struct {
 	Token string
 	// Query is a wrapped datastore query.
 	Query *datastore.Query
+	// alternatives are the queries of a filter with several alternatives.
+	// Their results are merged and Query is not used.
+	alternatives []*datastore.Query
+	// filter is checked against every entity if it has in-memory conditions.
+	filter *filterexp.Filter
+	// maxScanned overrides maxScannedEntities if positive.
+	maxScanned int
+}
+
+// scanLimit returns the number of entities a call to Next scans at most.
+func (q *ActionEntitiesQuery) scanLimit() int {
+	if q.maxScanned > 0 {
+		return q.maxScanned
+	}
+	return maxScannedEntities
+}
+
+// queries returns the datastore queries to run.
+func (q *ActionEntitiesQuery) queries() []*datastore.Query {
+	if len(q.alternatives) > 0 {
+		return q.alternatives
+	}
+	return []*datastore.Query{q.Query}
 }
 
 // ActionQueryAncillaryData returns ancillary data computed as part of advancing through
@@ -331,46 +394,55 @@ func (q *ActionEntitiesQuery) Next(ctx context.Context, batchSize int32) ([]*Act
 		batchSize = defaultBatchSize
 		logging.Debugf(ctx, "applied default batch size %d\n", defaultBatchSize)
 	}
-	// A rootedQuery is rooted at the position implied by the pagination token.
-	rootedQuery := q.Query
-	if q.Token != "" {
-		cursor, err := datastore.DecodeCursor(ctx, q.Token)
-		if err != nil {
-			return nil, ActionQueryAncillaryData{}, errors.Annotate(err, "next action entity: decoding cursor").Err()
-		}
-		rootedQuery = q.Query.Start(cursor)
+	rootedQueries, err := rootQueries(ctx, q.queries(), q.Token, q.filter, batchSize)
+	if err != nil {
+		return nil, ActionQueryAncillaryData{}, errors.Annotate(err, "next action entity: decoding cursor").Err()
 	}
-	rootedQuery = rootedQuery.Limit(batchSize)
 	var entities []*ActionEntity
-	err := datastore.Run(ctx, rootedQuery, func(ent *ActionEntity, cb datastore.CursorCB) error {
-		// Record the ancillary info! What versions did we see?
-		version := identifiers.GetIDVersion(ent.ID)
-		d.updateWith(&ActionQueryAncillaryData{
-			SmallestVersion: version,
-			BiggestVersion:  version,
-			SmallestID:      ent.ID,
-			BiggestID:       ent.ID,
-		})
-		entities = append(entities, ent)
+	scanned := 0
+	err = datastore.RunMulti(ctx, rootedQueries, func(ent *ActionEntity, cb datastore.CursorCB) error {
+		// Skipped entities are results too, so the token must not stay the same.
+		q.Token = stopToken
+		scanned++
+		ok, err := matchesFilter(q.filter, ent)
+		if err != nil {
+			return errors.Annotate(err, "next action entity").Err()
+		}
+		if ok {
+			// Record the ancillary info! What versions did we see?
+			version := identifiers.GetIDVersion(ent.ID)
+			d.updateWith(&ActionQueryAncillaryData{
+				SmallestVersion: version,
+				BiggestVersion:  version,
+				SmallestID:      ent.ID,
+				BiggestID:       ent.ID,
+			})
+			entities = append(entities, ent)
+		}
 		// This inequality is weak because this block must run on the last iteration
 		// when the query is successful.
 		// If the query stops early, we can assume that we have reached the end of the result set
 		// and therefore the response token should be empty.
-		if len(entities) >= int(batchSize) {
+		// At the scan limit, the page may have fewer entities than the batch size, or none.
+		if len(entities) >= int(batchSize) || scanned >= q.scanLimit() {
 			tok, err := cb()
 			if err != nil {
 				return errors.Annotate(err, "next action entity (entities: %d)", len(entities)).Err()
 			}
 			q.Token = tok.String()
-			return nil
+			return datastore.Stop
 		}
-		q.Token = stopToken
 		return nil
 	})
 	logging.Infof(ctx, "Version range for batch %v", d)
 	if err != nil {
 		return nil, d, errors.Annotate(err, "next action entity: after running query").Err()
 	}
+	// A page token from the scan limit may be at the end of the input, so an empty
+	// page must not return the same token again.
+	if scanned == 0 && q.Token != "" {
+		q.Token = stopToken
+	}
 	return entities, d, nil
 }
 
@@ -378,22 +450,25 @@ func (q *ActionEntitiesQuery) Next(ctx context.Context, batchSize int32) ([]*Act
 // by the given token and lists all action entities matching the condition described in the
 // filter.
 func newActionEntitiesQuery(token string, filter string) (*ActionEntitiesQuery, error) {
-	expr, err := filterexp.Parse(filter)
+	f, err := filterexp.ParseFilter(filter, actionSchema)
 	if err != nil {
 		// TODO(gregorynisbet): Pick more consistent strategy for assigning error statuses.
 		return nil, status.Errorf(codes.InvalidArgument, "make action entities query: %s", err)
 	}
-	q, err := filterexp.ApplyConditions(
-		datastore.NewQuery(ActionKind),
-		expr,
-	)
+	qs, err := f.Queries(datastore.NewQuery(ActionKind))
 	if err != nil {
 		return nil, errors.Annotate(err, "make action entities query").Err()
 	}
-	return &ActionEntitiesQuery{
-		Token: token,
-		Query: q,
-	}, nil
+	q := &ActionEntitiesQuery{
+		Token:  token,
+		filter: f,
+	}
+	if len(qs) == 1 {
+		q.Query = qs[0]
+	} else {
+		q.alternatives = qs
+	}
+	return q, nil
 }
 
 // newActionNameRangeQuery takes a beginning name and an end name and produces a query.
@@ -428,6 +503,29 @@ type ObservationEntitiesQuery struct {
 	Token string
 	// Query is a wrapped datastore query.
 	Query *datastore.Query
+	// alternatives are the queries of a filter with several alternatives.
+	// Their results are merged and Query is not used.
+	alternatives []*datastore.Query
+	// filter is checked against every entity if it has in-memory conditions.
+	filter *filterexp.Filter
+	// maxScanned overrides maxScannedEntities if positive.
+	maxScanned int
+}
+
+// scanLimit returns the number of entities a call to Next scans at most.
+func (q *ObservationEntitiesQuery) scanLimit() int {
+	if q.maxScanned > 0 {
+		return q.maxScanned
+	}
+	return maxScannedEntities
+}
+
+// queries returns the datastore queries to run.
+func (q *ObservationEntitiesQuery) queries() []*datastore.Query {
+	if len(q.alternatives) > 0 {
+		return q.alternatives
+	}
+	return []*datastore.Query{q.Query}
 }
 
 // Next takes a batch size and returns the next batch of observation entities from a query.
@@ -437,29 +535,33 @@ func (q *ObservationEntitiesQuery) Next(ctx context.Context, batchSize int32) ([
 		logging.Debugf(ctx, "applied default batch size %d\n", defaultBatchSize)
 	}
 	var nextToken string
-	// A rootedQuery is rooted at the position implied by the pagination token.
-	rootedQuery := q.Query
-	if q.Token != "" {
-		cursor, err := datastore.DecodeCursor(ctx, q.Token)
-		if err != nil {
-			return nil, errors.Annotate(err, "next observation entity").Err()
-		}
-		rootedQuery = q.Query.Start(cursor)
+	rootedQueries, err := rootQueries(ctx, q.queries(), q.Token, q.filter, batchSize)
+	if err != nil {
+		return nil, errors.Annotate(err, "next observation entity").Err()
 	}
-	rootedQuery = rootedQuery.Limit(batchSize)
 	var entities []*ObservationEntity
-	err := datastore.Run(ctx, rootedQuery, func(ent *ObservationEntity, cb datastore.CursorCB) error {
-		entities = append(entities, ent)
+	scanned := 0
+	err = datastore.RunMulti(ctx, rootedQueries, func(ent *ObservationEntity, cb datastore.CursorCB) error {
+		scanned++
+		ok, err := matchesFilter(q.filter, ent)
+		if err != nil {
+			return errors.Annotate(err, "next observation entity").Err()
+		}
+		if ok {
+			entities = append(entities, ent)
+		}
 		// This inequality is weak because this block must run on the last iteration
 		// when the query is successful.
 		// If the query stops early, we can assume that we have reached the end of the result set
 		// and therefore the response token should be empty.
-		if len(entities) >= int(batchSize) {
+		// At the scan limit, the page may have fewer entities than the batch size, or none.
+		if len(entities) >= int(batchSize) || scanned >= q.scanLimit() {
 			tok, err := cb()
 			if err != nil {
 				return errors.Annotate(err, "next observation entity").Err()
 			}
 			nextToken = tok.String()
+			return datastore.Stop
 		}
 		return nil
 	})
@@ -473,21 +575,60 @@ func (q *ObservationEntitiesQuery) Next(ctx context.Context, batchSize int32) ([
 // newObservationEntitiesQuery makes an action entities query that starts at the position
 // implied by the page token and lists all action entities.
 func newObservationEntitiesQuery(token string, filter string) (*ObservationEntitiesQuery, error) {
-	expr, err := filterexp.Parse(filter)
+	f, err := filterexp.ParseFilter(filter, observationSchema)
 	if err != nil {
 		return nil, errors.Annotate(err, "make observation entities query").Err()
 	}
-	q, err := filterexp.ApplyConditions(
-		datastore.NewQuery(ObservationKind),
-		expr,
-	)
+	qs, err := f.Queries(datastore.NewQuery(ObservationKind))
 	if err != nil {
 		return nil, errors.Annotate(err, "make observation entities query").Err()
 	}
-	return &ObservationEntitiesQuery{
-		Token: token,
-		Query: q,
-	}, nil
+	q := &ObservationEntitiesQuery{
+		Token:  token,
+		filter: f,
+	}
+	if len(qs) == 1 {
+		q.Query = qs[0]
+	} else {
+		q.alternatives = qs
+	}
+	return q, nil
+}
+
+// rootQueries roots the queries at the position implied by the pagination token.
+//
+// The queries are limited to the batch size unless entities are checked against
+// the filter, as skipped entities do not count towards the batch. Next bounds
+// those queries by the scan limit instead.
+func rootQueries(ctx context.Context, queries []*datastore.Query, token string, filter *filterexp.Filter, batchSize int32) ([]*datastore.Query, error) {
+	if token != "" {
+		var err error
+		queries, err = datastore.ApplyCursorString(ctx, queries, token)
+		if err != nil {
+			return nil, errors.Annotate(err, "root queries").Err()
+		}
+	}
+	if filter != nil && filter.HasInMemoryConditions() {
+		return queries, nil
+	}
+	limited := make([]*datastore.Query, len(queries))
+	for i, q := range queries {
+		limited[i] = q.Limit(batchSize)
+	}
+	return limited, nil
+}
+
+// matchesFilter checks the entity against the filter if the filter has in-memory conditions.
+// The other conditions are already applied by the datastore queries.
+func matchesFilter(filter *filterexp.Filter, entity interface{}) (bool, error) {
+	if filter == nil || !filter.HasInMemoryConditions() {
+		return true, nil
+	}
+	pm, err := datastore.GetPLS(entity).Save(false)
+	if err != nil {
+		return false, errors.Annotate(err, "matches filter").Err()
+	}
+	return filter.Matches(pm)
 }
 
 // convertActionToActionEntity takes an action and converts it to an action entity.
@@ -8,6 +8,9 @@ import (
 	"context"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
+
+	kartepb "infra/cros/karte/api"
 	"infra/cros/karte/internal/testsupport"
 )
 
@@ -35,3 +38,159 @@ func TestListActionsWithFilter(t *testing.T) {
 		t.Errorf("unexpected entities: %v", es)
 	}
 }
+
+// TestListActionsWithAlternativesAcrossPages tests that the results of a filter with
+// several alternatives are merged across pages without duplicates.
+func TestListActionsWithAlternativesAcrossPages(t *testing.T) {
+	t.Parallel()
+	ctx := testsupport.NewTestingContext(context.Background())
+	if err := PutActionEntities(
+		ctx,
+		&ActionEntity{ID: "hi", Kind: "w"},
+		&ActionEntity{ID: "hi2", Kind: "w"},
+		&ActionEntity{ID: "hi3", Kind: "a"},
+		&ActionEntity{ID: "hi4", Kind: "b"},
+	); err != nil {
+		t.Errorf("putting entities: %s", err)
+	}
+	seen := make(map[string]bool)
+	token := ""
+	for i := 0; i < 10; i++ {
+		q, err := newActionEntitiesQuery(token, `kind == "w" || kind == "a"`)
+		if err != nil {
+			t.Fatalf("building query: %s", err)
+		}
+		es, _, err := q.Next(ctx, 1)
+		if err != nil {
+			t.Fatalf("running query: %s", err)
+		}
+		for _, e := range es {
+			if seen[e.ID] {
+				t.Errorf("duplicate entity: %q", e.ID)
+			}
+			seen[e.ID] = true
+		}
+		if q.Token == stopToken {
+			break
+		}
+		token = q.Token
+	}
+	if len(seen) != 3 {
+		t.Errorf("unexpected entities: %v", seen)
+	}
+}
+
+// TestListActionsWithTextCondition tests listing actions with a full-text condition.
+func TestListActionsWithTextCondition(t *testing.T) {
+	t.Parallel()
+	ctx := testsupport.NewTestingContext(context.Background())
+	if err := PutActionEntities(
+		ctx,
+		&ActionEntity{ID: "hi", Kind: "w", FailReason: "ssh: connection timeout"},
+		&ActionEntity{ID: "hi2", Kind: "w", FailReason: "servo: not present"},
+		&ActionEntity{ID: "hi3", Kind: "a", FailReason: "read timeout"},
+	); err != nil {
+		t.Errorf("putting entities: %s", err)
+	}
+	q, err := newActionEntitiesQuery("", `kind == "w" && fail_reason.contains("timeout")`)
+	if err != nil {
+		t.Fatalf("building query: %s", err)
+	}
+	es, _, err := q.Next(ctx, 10)
+	if err != nil {
+		t.Fatalf("running query: %s", err)
+	}
+	if len(es) != 1 || es[0].ID != "hi" {
+		t.Errorf("unexpected entities: %v", es)
+	}
+}
+
+// TestListActionsWithScanLimit tests that a filter with in-memory conditions stops
+// at the scan limit and returns a page token, even if no entity matched yet.
+func TestListActionsWithScanLimit(t *testing.T) {
+	t.Parallel()
+	ctx := testsupport.NewTestingContext(context.Background())
+	if err := PutActionEntities(
+		ctx,
+		&ActionEntity{ID: "hi", Kind: "w"},
+		&ActionEntity{ID: "hi2", Kind: "w"},
+		&ActionEntity{ID: "hi3", Kind: "w"},
+		&ActionEntity{ID: "hi4", Kind: "w", FailReason: "ssh: connection timeout"},
+	); err != nil {
+		t.Errorf("putting entities: %s", err)
+	}
+	var pages [][]string
+	token := ""
+	for i := 0; i < 10; i++ {
+		q, err := newActionEntitiesQuery(token, `kind == "w" && fail_reason.contains("timeout")`)
+		if err != nil {
+			t.Fatalf("building query: %s", err)
+		}
+		q.maxScanned = 2
+		es, _, err := q.Next(ctx, 10)
+		if err != nil {
+			t.Fatalf("running query: %s", err)
+		}
+		var ids []string
+		for _, e := range es {
+			ids = append(ids, e.ID)
+		}
+		pages = append(pages, ids)
+		if q.Token == stopToken {
+			break
+		}
+		token = q.Token
+	}
+	if diff := cmp.Diff([][]string{nil, {"hi4"}, nil}, pages); diff != "" {
+		t.Errorf("unexpected diff (-want +got): %s", diff)
+	}
+}
+
+// TestListActionsWithUnindexedField tests a filter with a condition on an unindexed field,
+// which is checked in memory.
+func TestListActionsWithUnindexedField(t *testing.T) {
+	t.Parallel()
+	ctx := testsupport.NewTestingContext(context.Background())
+	if err := PutActionEntities(
+		ctx,
+		&ActionEntity{ID: "hi", Kind: "repair", Status: int32(kartepb.Action_FAIL)},
+		&ActionEntity{ID: "hi2", Kind: "repair", Status: int32(kartepb.Action_SUCCESS), FailReason: "servo: not present"},
+		&ActionEntity{ID: "hi3", Kind: "repair", Status: int32(kartepb.Action_SUCCESS)},
+		&ActionEntity{ID: "hi4", Kind: "audit", Status: int32(kartepb.Action_FAIL)},
+	); err != nil {
+		t.Errorf("putting entities: %s", err)
+	}
+	q, err := newActionEntitiesQuery("", `kind == "repair" AND (status == FAIL OR fail_reason != "")`)
+	if err != nil {
+		t.Fatalf("building query: %s", err)
+	}
+	es, _, err := q.Next(ctx, 10)
+	if err != nil {
+		t.Fatalf("running query: %s", err)
+	}
+	var ids []string
+	for _, e := range es {
+		ids = append(ids, e.ID)
+	}
+	if diff := cmp.Diff([]string{"hi", "hi2"}, ids); diff != "" {
+		t.Errorf("unexpected diff (-want +got): %s", diff)
+	}
+}
+
+// TestListActionsWithoutIndex tests that filters needing a composite index
+// that does not exist are rejected.
+func TestListActionsWithoutIndex(t *testing.T) {
+	t.Parallel()
+	if _, err := newActionEntitiesQuery("", `kind == "repair" && status > 1`); err == nil {
+		t.Error("expected error to not be nil")
+	}
+}
+
+// TestListActionsWithInequalitiesOnTwoFields tests that alternatives with inequalities on
+// different fields are rejected.
+func TestListActionsWithInequalitiesOnTwoFields(t *testing.T) {
+	t.Parallel()
+	if _, err := newActionEntitiesQuery("", `kind > "a" || stop_time > 4`); err == nil {
+		t.Error("expected error to not be nil")
+	}
+}
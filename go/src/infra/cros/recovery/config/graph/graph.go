@@ -0,0 +1,187 @@
+// Copyright 2024 The Chromium Authors
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+// Package graph renders recovery plans as Graphviz or Mermaid graphs.
+package graph
+
+import (
+	"fmt"
+	"strings"
+
+	"infra/cros/recovery/config"
+)
+
+// edgeKind is the relation between an action and the next action.
+type edgeKind string
+
+const (
+	edgeCritical   edgeKind = "critical"
+	edgeCondition  edgeKind = "condition"
+	edgeDependency edgeKind = "dependency"
+	edgeRecovery   edgeKind = "recovery"
+)
+
+// node is an action of the plan.
+type node struct {
+	id    string
+	label []string
+}
+
+// edge links an action to the action it runs.
+type edge struct {
+	from  string
+	to    string
+	kind  edgeKind
+	order int
+}
+
+// label returns the label of the edge, which is the order the action is
+// run in within its kind.
+func (e edge) label() string {
+	if e.kind == edgeCritical {
+		return fmt.Sprintf("%d", e.order)
+	}
+	return fmt.Sprintf("%s %d", e.kind, e.order)
+}
+
+// graph is a plan with actions as nodes.
+type graph struct {
+	name  string
+	nodes []*node
+	edges []edge
+	// byAction is used to visit every action once.
+	byAction map[string]*node
+}
+
+// planNodeID is the ID of the node representing the plan itself.
+const planNodeID = "plan"
+
+// newGraph collects the actions reachable from the critical actions of the plan.
+func newGraph(name string, plan *config.Plan) *graph {
+	g := &graph{
+		name:     name,
+		byAction: make(map[string]*node),
+	}
+	for i, a := range plan.GetCriticalActions() {
+		to := g.visit(plan, a)
+		g.edges = append(g.edges, edge{from: planNodeID, to: to.id, kind: edgeCritical, order: i + 1})
+	}
+	return g
+}
+
+// visit adds the action and the actions it runs to the graph.
+func (g *graph) visit(plan *config.Plan, name string) *node {
+	if n, ok := g.byAction[name]; ok {
+		return n
+	}
+	n := &node{
+		id:    fmt.Sprintf("a%d", len(g.nodes)),
+		label: []string{name},
+	}
+	g.byAction[name] = n
+	g.nodes = append(g.nodes, n)
+	a, ok := plan.GetActions()[name]
+	if !ok {
+		n.label = append(n.label, "(missing)")
+		return n
+	}
+	if a.GetExecName() != name {
+		n.label = append(n.label, fmt.Sprintf("exec: %s", a.GetExecName()))
+	}
+	if a.GetAllowFailAfterRecovery() {
+		n.label = append(n.label, "(allow to fail)")
+	}
+	if a.GetRunControl() != config.RunControl_RERUN_AFTER_RECOVERY {
+		n.label = append(n.label, fmt.Sprintf("(%s)", a.GetRunControl()))
+	}
+	if a.GetRunDependenciesInParallel() {
+		n.label = append(n.label, "(parallel dependencies)")
+	}
+	link := func(kind edgeKind, names []string) {
+		for i, next := range names {
+			to := g.visit(plan, next)
+			g.edges = append(g.edges, edge{from: n.id, to: to.id, kind: kind, order: i + 1})
+		}
+	}
+	link(edgeCondition, a.GetConditions())
+	link(edgeDependency, a.GetDependencies())
+	link(edgeRecovery, a.GetRecoveryActions())
+	return n
+}
+
+// Dot renders the plan in the Graphviz DOT language.
+func Dot(name string, plan *config.Plan) string {
+	g := newGraph(name, plan)
+	var b strings.Builder
+	fmt.Fprintf(&b, "digraph %s {\n", dotQuote(g.name))
+	b.WriteString("  rankdir=LR;\n")
+	b.WriteString("  node [shape=box];\n")
+	planLabel := []string{fmt.Sprintf("Plan: %s", g.name)}
+	if plan.GetAllowFail() {
+		planLabel = append(planLabel, "(allow to fail)")
+	}
+	fmt.Fprintf(&b, "  %s [label=%s, style=bold];\n", planNodeID, dotQuote(strings.Join(planLabel, "\n")))
+	for _, n := range g.nodes {
+		fmt.Fprintf(&b, "  %s [label=%s];\n", n.id, dotQuote(strings.Join(n.label, "\n")))
+	}
+	for _, e := range g.edges {
+		attrs := []string{fmt.Sprintf("label=%s", dotQuote(e.label()))}
+		switch e.kind {
+		case edgeCondition:
+			attrs = append(attrs, "style=dashed")
+		case edgeRecovery:
+			attrs = append(attrs, "style=dotted", "color=red")
+		}
+		fmt.Fprintf(&b, "  %s -> %s [%s];\n", e.from, e.to, strings.Join(attrs, ", "))
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// Mermaid renders the plan as a Mermaid flowchart.
+func Mermaid(name string, plan *config.Plan) string {
+	g := newGraph(name, plan)
+	var b strings.Builder
+	b.WriteString("flowchart LR\n")
+	planLabel := []string{fmt.Sprintf("Plan: %s", g.name)}
+	if plan.GetAllowFail() {
+		planLabel = append(planLabel, "(allow to fail)")
+	}
+	fmt.Fprintf(&b, "  %s[%s]\n", planNodeID, mermaidQuote(planLabel))
+	for _, n := range g.nodes {
+		fmt.Fprintf(&b, "  %s[%s]\n", n.id, mermaidQuote(n.label))
+	}
+	for _, e := range g.edges {
+		arrow := "-->"
+		switch e.kind {
+		case edgeCondition, edgeRecovery:
+			arrow = "-.->"
+		}
+		fmt.Fprintf(&b, "  %s %s|%s| %s\n", e.from, arrow, mermaidEscape(e.label()), e.to)
+	}
+	return b.String()
+}
+
+// dotQuote quotes s as a DOT string.
+func dotQuote(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	s = strings.ReplaceAll(s, "\n", `\n`)
+	return `"` + s + `"`
+}
+
+// mermaidQuote quotes the lines as a Mermaid node label.
+func mermaidQuote(lines []string) string {
+	escaped := make([]string, len(lines))
+	for i, l := range lines {
+		escaped[i] = mermaidEscape(l)
+	}
+	return `"` + strings.Join(escaped, "<br/>") + `"`
+}
+
+// mermaidEscape escapes the characters which end a Mermaid label.
+func mermaidEscape(s string) string {
+	r := strings.NewReplacer(`"`, "#quot;", "|", "#124;", "<", "#lt;", ">", "#gt;")
+	return r.Replace(s)
+}
@@ -0,0 +1,77 @@
+// Copyright 2024 The Chromium Authors
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package graph
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"infra/cros/recovery/config"
+)
+
+var testPlan = &config.Plan{
+	CriticalActions: []string{"a1", "a2"},
+	Actions: map[string]*config.Action{
+		"a1": {
+			ExecName:        "sample_pass",
+			Conditions:      []string{"c1"},
+			Dependencies:    []string{"a2"},
+			RecoveryActions: []string{"r1"},
+		},
+		"a2": {
+			ExecName:               "a2",
+			AllowFailAfterRecovery: true,
+		},
+		"c1": {
+			ExecName:   "sample_pass",
+			RunControl: config.RunControl_RUN_ONCE,
+		},
+		"r1": {
+			ExecName: `say "hi"`,
+		},
+	},
+}
+
+func TestDot(t *testing.T) {
+	t.Parallel()
+	want := `digraph "repair" {
+  rankdir=LR;
+  node [shape=box];
+  plan [label="Plan: repair", style=bold];
+  a0 [label="a1\nexec: sample_pass"];
+  a1 [label="c1\nexec: sample_pass\n(RUN_ONCE)"];
+  a2 [label="a2\n(allow to fail)"];
+  a3 [label="r1\nexec: say \"hi\""];
+  a0 -> a1 [label="condition 1", style=dashed];
+  a0 -> a2 [label="dependency 1"];
+  a0 -> a3 [label="recovery 1", style=dotted, color=red];
+  plan -> a0 [label="1"];
+  plan -> a2 [label="2"];
+}
+`
+	if diff := cmp.Diff(want, Dot("repair", testPlan)); diff != "" {
+		t.Errorf("Dot() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestMermaid(t *testing.T) {
+	t.Parallel()
+	want := `flowchart LR
+  plan["Plan: repair"]
+  a0["a1<br/>exec: sample_pass"]
+  a1["c1<br/>exec: sample_pass<br/>(RUN_ONCE)"]
+  a2["a2<br/>(allow to fail)"]
+  a3["r1<br/>exec: say #quot;hi#quot;"]
+  a0 -.->|condition 1| a1
+  a0 -->|dependency 1| a2
+  a0 -.->|recovery 1| a3
+  plan -->|1| a0
+  plan -->|2| a2
+`
+	if diff := cmp.Diff(want, Mermaid("repair", testPlan)); diff != "" {
+		t.Errorf("Mermaid() mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -100,6 +100,9 @@ func (t *planConverter) convertAcion(name string, excludeRecoveries, shortVersio
 	if action.GetExecTimeout() != nil {
 		a.Name = fmt.Sprintf("%s (time:'%s')", a.Name, action.GetExecTimeout().AsDuration().String())
 	}
+	if action.GetRunDependenciesInParallel() {
+		a.Name = fmt.Sprintf("%s (Parallel dependencies)", a.Name)
+	}
 	if !shortVersion {
 		a.Docs = action.GetDocs()
 		a.ExecName = action.GetExecName()
@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"io"
 	"strings"
+	"sync"
 	"time"
 
 	"go.chromium.org/luci/common/errors"
@@ -29,9 +30,16 @@ type recoveryEngine struct {
 	plan        *config.Plan
 	args        *execs.RunArgs
 	metricSaver metrics.MetricSaver
+	// mu guards the caches, the started recoveries and the running actions
+	// as dependencies of an action can run in parallel.
+	mu sync.Mutex
+	// metricMu serializes the calls of the metric saver.
+	metricMu sync.Mutex
 	// Caches
 	actionResultsCache map[string]error
 	recoveryUsageCache map[recoveryUsageKey]error
+	// runningActions holds the actions running in parallel dependencies.
+	runningActions map[string]*runningAction
 	// Tracker the plan iterations.
 	planRunTally int32
 	// Track how many recoevry actin was used in the plan.
@@ -96,7 +104,7 @@ func (r *recoveryEngine) runPlan(ctx context.Context) (rErr error) {
 			)
 			metric.Restarts = r.planRunTally
 			metric.UpdateStatus(rErr)
-			if err := r.metricSaver(metric); err != nil {
+			if err := r.saveMetric(metric); err != nil {
 				log.Debugf(ctx, "Fail to save plan %q metrics with error: %s", r.planName, err)
 			}
 		}()
@@ -176,6 +184,15 @@ func (r *recoveryEngine) runAction(ctx context.Context, actionName, parentAction
 			rErr = nil
 		}
 	}()
+	if isParallelRun(ctx) {
+		// Wait if the action is run by another parallel dependency, so the
+		// action runs once and its result is taken from the cache.
+		unlock, err := r.lockAction(actionName)
+		if err != nil {
+			return nil, actionFail, errors.Annotate(err, "run action %q", actionName).Err()
+		}
+		defer func() { unlock(rErr) }()
+	}
 	var step *build.Step
 	act := r.getAction(actionName)
 	if r.args != nil {
@@ -186,7 +203,8 @@ func (r *recoveryEngine) runAction(ctx context.Context, actionName, parentAction
 			stepLogCloser := log.AddStepLog(ctx, r.args.Logger, step, "execution details")
 			defer func() { stepLogCloser() }()
 		}
-		if i, ok := r.args.Logger.(logger.LogIndenter); ok {
+		// Indentation makes no sense for logs of parallel dependencies.
+		if i, ok := r.args.Logger.(logger.LogIndenter); ok && !isParallelRun(ctx) {
 			i.Indent()
 			defer func() { i.Dedent() }()
 		}
@@ -244,13 +262,13 @@ func (r *recoveryEngine) runAction(ctx context.Context, actionName, parentAction
 			policy := act.GetMetricsConfig().GetUploadPolicy()
 			switch policy {
 			case config.MetricsConfig_DEFAULT_UPLOAD_POLICY:
-				if err := r.metricSaver(metric); err != nil {
+				if err := r.saveMetric(metric); err != nil {
 					log.Debugf(ctx, "Fail to save %q metrics with error: %s", actionName, err)
 				}
 			case config.MetricsConfig_UPLOAD_ON_ERROR:
 				log.Debugf(ctx, "Action %q requires save metrics only when fail.", actionName)
 				if rErr != nil {
-					if err := r.metricSaver(metric); err != nil {
+					if err := r.saveMetric(metric); err != nil {
 						log.Debugf(ctx, "Fail to save %q metrics with error: %s", actionName, err)
 					}
 				}
@@ -373,7 +391,7 @@ func (r *recoveryEngine) runActionExecWithTimeout(ctx context.Context, actionNam
 				additionalMetric.Observations = append(additionalMetric.Observations,
 					metrics.NewInt64Observation("plan_run_tally", int64(r.planRunTally)),
 				)
-				if err := r.metricSaver(additionalMetric); err != nil {
+				if err := r.saveMetric(additionalMetric); err != nil {
 					log.Debugf(ctx, "Fail to save %q additional metrics error: %s", r, r.planName, err)
 				}
 			}
@@ -382,8 +400,8 @@ func (r *recoveryEngine) runActionExecWithTimeout(ctx context.Context, actionNam
 	cw := make(chan error, 1)
 	go func() {
 		// Populate default metric action to be available by context.
-		ctx = metrics.WithAction(ctx, metric)
-		err := execs.Run(ctx, execInfo)
+		ctx := metrics.WithAction(ctx, metric)
+		err := r.runExec(ctx, actionName, execInfo)
 		cw <- err
 	}()
 	defer func() {
@@ -402,6 +420,20 @@ func (r *recoveryEngine) runActionExecWithTimeout(ctx context.Context, actionNam
 	}
 }
 
+// runExec runs the exec of the action.
+//
+// The exec is provided by the exec registry of the run if present.
+func (r *recoveryEngine) runExec(ctx context.Context, actionName string, ei *execs.ExecInfo) error {
+	if r.args == nil || r.args.ExecRegistry == nil {
+		return execs.Run(ctx, ei)
+	}
+	e, ok := r.args.ExecRegistry.Exec(actionName, ei.GetExecName())
+	if !ok {
+		return errors.Reason("exec %q: not found", ei.GetExecName()).Err()
+	}
+	return execs.RunFunc(ctx, ei, e)
+}
+
 // runActionConditions checks if action is applicable based on condition actions.
 // If return err then not applicable, if nil then applicable.
 func (r *recoveryEngine) runActionConditions(ctx context.Context, actionName string, actionLevel int64) (conditionName string, err error) {
@@ -429,6 +461,9 @@ func (r *recoveryEngine) runDependencies(ctx context.Context, actionName string,
 		log.Debugf(ctx, "Action %q: no dependencies.", actionName)
 		return nil
 	}
+	if a.GetRunDependenciesInParallel() {
+		return r.runDependenciesInParallel(ctx, actionName, actionType, enableRecovery, actionLevel)
+	}
 	log.Debugf(ctx, "Action %q: starting running dependencies...", actionName)
 	for _, dependencyName := range a.GetDependencies() {
 		if _, _, err := r.runAction(ctx, dependencyName, actionName, enableRecovery, "Dependency", actionType, actionLevel); err != nil {
@@ -440,6 +475,52 @@ func (r *recoveryEngine) runDependencies(ctx context.Context, actionName string,
 	return nil
 }
 
+// runDependenciesInParallel runs action's dependencies concurrently.
+//
+// All dependencies run to the end even if any of them fails, so their
+// results are cached as when they run one by one.
+// Requests to abort or to start over the plan take priority over other errors.
+func (r *recoveryEngine) runDependenciesInParallel(ctx context.Context, actionName string, actionType metrics.ActionType, enableRecovery bool, actionLevel int64) error {
+	a := r.getAction(actionName)
+	log.Debugf(ctx, "Action %q: starting running dependencies in parallel...", actionName)
+	ctx = withParallelRun(ctx)
+	errs := make([]error, len(a.GetDependencies()))
+	var wg sync.WaitGroup
+	for i, dependencyName := range a.GetDependencies() {
+		wg.Add(1)
+		go func(i int, dependencyName string) {
+			defer wg.Done()
+			if _, _, err := r.runAction(ctx, dependencyName, actionName, enableRecovery, "Dependency", actionType, actionLevel); err != nil {
+				log.Debugf(ctx, "Action %q: dependency %q fails. Errors: %s", actionName, dependencyName, err)
+				errs[i] = err
+			}
+		}(i, dependencyName)
+	}
+	wg.Wait()
+	var firstErr, startOverErr error
+	for _, err := range errs {
+		switch {
+		case err == nil:
+		case execs.PlanAbortTag.In(err):
+			return errors.Annotate(err, "dependencies").Err()
+		case execs.PlanStartOverTag.In(err):
+			if startOverErr == nil {
+				startOverErr = err
+			}
+		case firstErr == nil:
+			firstErr = err
+		}
+	}
+	if startOverErr != nil {
+		return errors.Annotate(startOverErr, "dependencies").Err()
+	}
+	if firstErr != nil {
+		return errors.Annotate(firstErr, "dependencies").Err()
+	}
+	log.Debugf(ctx, "Action %q: all dependencies passed.", actionName)
+	return nil
+}
+
 // runRecoveries runs an action's recoveries.
 //
 // Note that we mutate the metric action!
@@ -458,8 +539,16 @@ func (r *recoveryEngine) runRecoveries(ctx context.Context, actionName string, m
 			log.Infof(ctx, "Recovery %q skipped as already used before for %q.", recoveryName, actionName)
 			continue
 		}
-		r.startedRecoveries += 1
 		recoveryMetric, status, err := r.runAction(ctx, recoveryName, actionName, false, "Recovery", metrics.ActionTypeRecovery, recoveryLevel)
+		if status == actionPassCache || status == actionFailCache {
+			// The recovery was run by a parallel dependency since the check above.
+			log.Infof(ctx, "Recovery %q skipped as already used before for %q.", recoveryName, actionName)
+			r.registerRecoveryUsage(actionName, recoveryName, err)
+			continue
+		}
+		r.mu.Lock()
+		r.startedRecoveries += 1
+		r.mu.Unlock()
 		if err != nil {
 			log.Infof(ctx, "Recovery %q: fail", recoveryName)
 			log.Debugf(ctx, "Recovery %q: fail. Error: %s", recoveryName, err)
@@ -513,12 +602,16 @@ func (r *recoveryEngine) initCache() {
 
 // actionResultFromCache reads action's result from cache.
 func (r *recoveryEngine) actionResultFromCache(actionName string) (err error, ok bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
 	err, ok = r.actionResultsCache[actionName]
 	return err, ok
 }
 
 // cacheActionResult sets action's result to the cache.
 func (r *recoveryEngine) cacheActionResult(actionName string, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
 	switch r.getAction(actionName).GetRunControl() {
 	case config.RunControl_RERUN_AFTER_RECOVERY, config.RunControl_RUN_ONCE:
 		r.actionResultsCache[actionName] = err
@@ -530,6 +623,8 @@ func (r *recoveryEngine) cacheActionResult(actionName string, err error) {
 // resetCacheAfterSuccessfulRecoveryAction resets cache for actions
 // with run-control=RERUN_AFTER_RECOVERY.
 func (r *recoveryEngine) resetCacheAfterSuccessfulRecoveryAction() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
 	for name, a := range r.plan.GetActions() {
 		if a.GetRunControl() == config.RunControl_RERUN_AFTER_RECOVERY {
 			delete(r.actionResultsCache, name)
@@ -539,6 +634,8 @@ func (r *recoveryEngine) resetCacheAfterSuccessfulRecoveryAction() {
 
 // isRecoveryUsed checks if recovery action is used in plan or action level scope.
 func (r *recoveryEngine) isRecoveryUsed(actionName, recoveryName string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
 	k := recoveryUsageKey{
 		action:   actionName,
 		recovery: recoveryName,
@@ -554,6 +651,8 @@ func (r *recoveryEngine) isRecoveryUsed(actionName, recoveryName string) bool {
 
 // registerRecoveryUsage sets recovery action usage to the cache.
 func (r *recoveryEngine) registerRecoveryUsage(actionName, recoveryName string, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
 	r.recoveryUsageCache[recoveryUsageKey{
 		action:   actionName,
 		recovery: recoveryName,
@@ -565,3 +664,70 @@ type recoveryUsageKey struct {
 	action   string
 	recovery string
 }
+
+// runningAction is an action running in parallel dependencies.
+type runningAction struct {
+	// done is closed when the action is finished.
+	done chan struct{}
+	// interruptErr is the request to start over or to abort the plan
+	// received by the action.
+	interruptErr error
+}
+
+// lockAction waits for the action run by a parallel dependency to finish
+// and marks the action as running.
+// The returned function marks the action as finished with the error.
+//
+// If the waited run requested to start over or to abort the plan then the
+// request is returned, as the result of the action is not cached.
+// The plan is acyclic, so the action cannot wait for itself.
+func (r *recoveryEngine) lockAction(actionName string) (func(error), error) {
+	for {
+		r.mu.Lock()
+		if r.runningActions == nil {
+			r.runningActions = make(map[string]*runningAction)
+		}
+		ra, ok := r.runningActions[actionName]
+		if !ok {
+			ra = &runningAction{done: make(chan struct{})}
+			r.runningActions[actionName] = ra
+			r.mu.Unlock()
+			return func(err error) {
+				if execs.PlanStartOverTag.In(err) || execs.PlanAbortTag.In(err) {
+					ra.interruptErr = err
+				}
+				r.mu.Lock()
+				delete(r.runningActions, actionName)
+				r.mu.Unlock()
+				close(ra.done)
+			}, nil
+		}
+		r.mu.Unlock()
+		<-ra.done
+		if ra.interruptErr != nil {
+			return nil, ra.interruptErr
+		}
+	}
+}
+
+// saveMetric saves the metric by metric saver.
+// Metrics of parallel dependencies are saved one by one.
+func (r *recoveryEngine) saveMetric(metric *metrics.Action) error {
+	r.metricMu.Lock()
+	defer r.metricMu.Unlock()
+	return r.metricSaver(metric)
+}
+
+// parallelRunKey marks the context of actions running as parallel dependencies.
+type parallelRunKey struct{}
+
+// withParallelRun marks the context as running parallel dependencies.
+func withParallelRun(ctx context.Context) context.Context {
+	return context.WithValue(ctx, parallelRunKey{}, true)
+}
+
+// isParallelRun checks if the context runs parallel dependencies.
+func isParallelRun(ctx context.Context) bool {
+	v, _ := ctx.Value(parallelRunKey{}).(bool)
+	return v
+}
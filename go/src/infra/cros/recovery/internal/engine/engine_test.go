@@ -6,11 +6,15 @@ package engine
 
 import (
 	"context"
+	"sort"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
 
+	"go.chromium.org/luci/common/errors"
+
 	"infra/cros/recovery/config"
 	"infra/cros/recovery/internal/execs"
 	"infra/cros/recovery/logger/metrics"
@@ -955,3 +959,216 @@ func TestCallMetricsWithExistentAction(t *testing.T) {
 		t.Errorf("unexpected diff (-want +got): %s", diff)
 	}
 }
+
+// newBarrier returns an exec function which passes only when all n
+// actions run it at the same time.
+func newBarrier(n int) func(int) error {
+	var mu sync.Mutex
+	arrived := 0
+	all := make(chan struct{})
+	return func(int) error {
+		mu.Lock()
+		arrived++
+		if arrived == n {
+			close(all)
+		}
+		mu.Unlock()
+		select {
+		case <-all:
+			return nil
+		case <-time.After(5 * time.Second):
+			return errors.Reason("barrier: not all actions arrived").Err()
+		}
+	}
+}
+
+func failOnRuns(runs ...int) func(int) error {
+	return func(run int) error {
+		for _, r := range runs {
+			if r == run {
+				return errors.Reason("failed on run %d", run).Err()
+			}
+		}
+		return nil
+	}
+}
+
+var parallelDependenciesTestCases = []struct {
+	name       string
+	actions    map[string]*config.Action
+	execs      func() map[string]func(int) error
+	expSuccess bool
+	expRuns    map[string]int
+}{
+	{
+		"dependencies run concurrently",
+		map[string]*config.Action{
+			"a":  {Dependencies: []string{"d1", "d2", "d3"}, RunDependenciesInParallel: true},
+			"d1": {},
+			"d2": {},
+			"d3": {},
+		},
+		func() map[string]func(int) error {
+			b := newBarrier(3)
+			return map[string]func(int) error{"d1": b, "d2": b, "d3": b}
+		},
+		true,
+		map[string]int{"a": 1, "d1": 1, "d2": 1, "d3": 1},
+	},
+	{
+		"shared dependency runs once",
+		map[string]*config.Action{
+			"a":  {Dependencies: []string{"d1", "d2"}, RunDependenciesInParallel: true},
+			"d1": {Dependencies: []string{"s"}},
+			"d2": {Dependencies: []string{"s"}},
+			"s":  {},
+		},
+		nil,
+		true,
+		map[string]int{"a": 1, "d1": 1, "d2": 1, "s": 1},
+	},
+	{
+		"all dependencies run when one fails",
+		map[string]*config.Action{
+			"a":  {Dependencies: []string{"d1", "d2", "d3"}, RunDependenciesInParallel: true},
+			"d1": {},
+			"d2": {},
+			"d3": {},
+		},
+		func() map[string]func(int) error {
+			return map[string]func(int) error{"d2": failOnRuns(1)}
+		},
+		false,
+		map[string]int{"d1": 1, "d2": 1, "d3": 1},
+	},
+	{
+		"recovered dependency starts plan over",
+		map[string]*config.Action{
+			"a":  {Dependencies: []string{"d1", "d2"}, RunDependenciesInParallel: true},
+			"d1": {RecoveryActions: []string{"r"}},
+			"d2": {},
+			"r":  {},
+		},
+		func() map[string]func(int) error {
+			return map[string]func(int) error{"d1": failOnRuns(1)}
+		},
+		true,
+		map[string]int{"a": 1, "d1": 2, "d2": 2, "r": 1},
+	},
+	{
+		"recovery shared by dependencies runs once",
+		map[string]*config.Action{
+			"a":  {Dependencies: []string{"d1", "d2"}, RunDependenciesInParallel: true},
+			"d1": {RecoveryActions: []string{"r"}},
+			"d2": {RecoveryActions: []string{"r"}},
+			"r":  {},
+		},
+		func() map[string]func(int) error {
+			return map[string]func(int) error{"d1": failOnRuns(1), "d2": failOnRuns(1)}
+		},
+		true,
+		map[string]int{"a": 1, "d1": 2, "d2": 2, "r": 1},
+	},
+}
+
+func TestRunDependenciesInParallel(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	for _, c := range parallelDependenciesTestCases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+			var e map[string]func(int) error
+			if c.execs != nil {
+				e = c.execs()
+			}
+			registry := newFakeExecRegistry(e)
+			plan := &config.Plan{
+				CriticalActions: []string{"a"},
+				Actions:         c.actions,
+			}
+			args := &execs.RunArgs{
+				EnableRecovery: true,
+				ExecRegistry:   registry,
+			}
+			err := Run(ctx, c.name, plan, args, nil)
+			if c.expSuccess && err != nil {
+				t.Errorf("Case %q fail but expected to pass. Received error: %s", c.name, err)
+			} else if !c.expSuccess && err == nil {
+				t.Errorf("Case %q expected to fail but pass", c.name)
+			}
+			if diff := cmp.Diff(c.expRuns, registry.getRuns()); diff != "" {
+				t.Errorf("Case %q runs mismatch (-want +got):\n%s", c.name, diff)
+			}
+		})
+	}
+}
+
+// TestCallMetricsWithParallelDependencies tests that every dependency run in parallel has own metrics.
+func TestCallMetricsWithParallelDependencies(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	m := newFakeMetrics()
+	plan := &config.Plan{
+		CriticalActions: []string{"a"},
+		Actions: map[string]*config.Action{
+			"a":  {Dependencies: []string{"d1", "d2"}, RunDependenciesInParallel: true},
+			"d1": {RecoveryActions: []string{"r"}},
+			"d2": {},
+			"r":  {},
+		},
+	}
+	args := &execs.RunArgs{
+		Metrics:        m,
+		EnableRecovery: true,
+		ExecRegistry: newFakeExecRegistry(map[string]func(int) error{
+			"d1": failOnRuns(1),
+		}),
+	}
+	saver := func(metric *metrics.Action) error {
+		return m.Create(ctx, metric)
+	}
+	if err := Run(ctx, "parallel", plan, args, saver); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+	type record struct {
+		kind, parent, status, recoveredBy string
+	}
+	var got []record
+	var startedRecoveries string
+	for _, a := range m.actions {
+		rec := record{kind: a.ActionKind, status: string(a.Status), recoveredBy: a.RecoveredBy}
+		for _, o := range a.Observations {
+			switch o.MetricKind {
+			case "parent_action_name":
+				rec.parent = o.Value
+			case "started_recoveries":
+				startedRecoveries = o.Value
+			}
+		}
+		got = append(got, rec)
+	}
+	// Metrics of parallel dependencies are saved in any order.
+	sort.Slice(got, func(i, j int) bool {
+		if got[i].kind != got[j].kind {
+			return got[i].kind < got[j].kind
+		}
+		return got[i].status < got[j].status
+	})
+	expected := []record{
+		{kind: "action:a", parent: "plan", status: "fail"},
+		{kind: "action:a", parent: "plan", status: "success"},
+		{kind: "action:d1", parent: "a", status: "fail", recoveredBy: "r"},
+		{kind: "action:d1", parent: "a", status: "success"},
+		{kind: "action:d2", parent: "a", status: "success"},
+		{kind: "action:d2", parent: "a", status: "success"},
+		{kind: "action:r", parent: "d1", status: "success"},
+		{kind: "plan:parallel", status: "success"},
+	}
+	if diff := cmp.Diff(expected, got, cmp.AllowUnexported(record{})); diff != "" {
+		t.Errorf("unexpected diff (-want +got): %s", diff)
+	}
+	if startedRecoveries != "1" {
+		t.Errorf("started recoveries: got %q, want %q", startedRecoveries, "1")
+	}
+}
@@ -6,7 +6,9 @@ package engine
 
 import (
 	"context"
+	"sync"
 
+	"infra/cros/recovery/internal/execs"
 	"infra/cros/recovery/logger/metrics"
 )
 
@@ -43,3 +45,50 @@ func (m *fakeMetrics) Update(ctx context.Context, action *metrics.Action) error
 func (m *fakeMetrics) Search(ctx context.Context, q *metrics.Query) (*metrics.QueryResult, error) {
 	panic("not implemented")
 }
+
+// fakeExecRegistry provides exec functions by action name and counts
+// their runs.
+type fakeExecRegistry struct {
+	mu sync.Mutex
+	// execs maps action names to exec functions called with the number
+	// of the run, starting from 1.
+	execs map[string]func(run int) error
+	runs  map[string]int
+}
+
+// Check that fakeExecRegistry satisfies the exec registry interface.
+var _ execs.ExecRegistry = &fakeExecRegistry{}
+
+// newFakeExecRegistry makes a new fake exec registry instance.
+func newFakeExecRegistry(e map[string]func(run int) error) *fakeExecRegistry {
+	return &fakeExecRegistry{
+		execs: e,
+		runs:  make(map[string]int),
+	}
+}
+
+// Exec returns the exec function of the action.
+// Actions without exec function pass.
+func (r *fakeExecRegistry) Exec(actionName, execName string) (execs.ExecFunction, bool) {
+	return func(ctx context.Context, _ *execs.ExecInfo) error {
+		r.mu.Lock()
+		r.runs[actionName]++
+		run := r.runs[actionName]
+		r.mu.Unlock()
+		if f, ok := r.execs[actionName]; ok {
+			return f(run)
+		}
+		return nil
+	}, true
+}
+
+// getRuns returns the number of runs per action.
+func (r *fakeExecRegistry) getRuns() map[string]int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	runs := make(map[string]int, len(r.runs))
+	for k, v := range r.runs {
+		runs[k] = v
+	}
+	return runs
+}
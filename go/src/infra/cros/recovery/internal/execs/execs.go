@@ -40,6 +40,15 @@ func Register(name string, f ExecFunction) {
 	knownExecMap[name] = f
 }
 
+// ExecRegistry provides the exec functions to run actions.
+//
+// It overrides the exec functions registered by Register(), to simulate
+// plans with scripted results of execs.
+type ExecRegistry interface {
+	// Exec returns the exec function to run for the action.
+	Exec(actionName, execName string) (ExecFunction, bool)
+}
+
 // RunArgs holds plan input arguments.
 //
 // Keep this type up to date with recovery.go:RunArgs .
@@ -63,6 +72,8 @@ type RunArgs struct {
 	BuildbucketID string
 	// LogRoot is an absolute path to a directory that contains logs.
 	LogRoot string
+	// ExecRegistry overrides the registered exec functions if provided.
+	ExecRegistry ExecRegistry
 }
 
 // ExecInfo holds all data required to run exec.
@@ -129,6 +140,11 @@ func (ei *ExecInfo) GetMetrics() metrics.Metrics {
 	return ei.runArgs.Metrics
 }
 
+// GetExecName returns the name of the exec.
+func (ei *ExecInfo) GetExecName() string {
+	return ei.name
+}
+
 // GetExecArgs returns list of arguments provided for an exec.
 func (ei *ExecInfo) GetExecArgs() []string {
 	return ei.actionArgs
@@ -145,7 +161,16 @@ func (ei *ExecInfo) GetAccess() tlw.Access {
 }
 
 // Run runs exec function provided by this package by name.
-func Run(ctx context.Context, ei *ExecInfo) (rErr error) {
+func Run(ctx context.Context, ei *ExecInfo) error {
+	e, ok := knownExecMap[ei.name]
+	if !ok {
+		return errors.Reason("exec %q: not found", ei.name).Err()
+	}
+	return RunFunc(ctx, ei, e)
+}
+
+// RunFunc runs the exec function and recovers from its panic.
+func RunFunc(ctx context.Context, ei *ExecInfo, e ExecFunction) (rErr error) {
 	defer func() {
 		// Recovery from panic if it happened.
 		if r := recover(); r != nil {
@@ -153,10 +178,6 @@ func Run(ctx context.Context, ei *ExecInfo) (rErr error) {
 			rErr = errors.Reason("panic: %v", r).Err()
 		}
 	}()
-	e, ok := knownExecMap[ei.name]
-	if !ok {
-		return errors.Reason("exec %q: not found", ei.name).Err()
-	}
 	return e(ctx, ei)
 }
 
@@ -219,6 +219,11 @@ func ParsedDefaultConfiguration(ctx context.Context, tn buildbucket.TaskName, ds
 	}
 }
 
+// ParseConfiguration parses and validates configuration provided by the reader.
+func ParseConfiguration(ctx context.Context, cr io.Reader) (*config.Configuration, error) {
+	return parseConfiguration(ctx, cr)
+}
+
 // parseConfiguration parses configuration to configuration proto instance.
 func parseConfiguration(ctx context.Context, cr io.Reader) (*config.Configuration, error) {
 	if c, err := config.Load(ctx, cr, execs.Exist); err != nil {
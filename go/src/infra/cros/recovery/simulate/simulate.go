@@ -0,0 +1,226 @@
+// Copyright 2024 The Chromium Authors
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+// Package simulate runs recovery plans with scripted results of execs.
+//
+// It shows which path the recovery engine takes for a set of exec
+// outcomes, so config authors can check that a new recovery path is
+// reachable without running it against a device.
+package simulate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+
+	"go.chromium.org/luci/common/errors"
+
+	"infra/cros/recovery/config"
+	"infra/cros/recovery/internal/engine"
+	"infra/cros/recovery/internal/execs"
+)
+
+// Result is the scripted result of an exec.
+type Result string
+
+const (
+	// Pass makes the exec pass.
+	Pass Result = "pass"
+	// Fail makes the exec fail.
+	Fail Result = "fail"
+	// StartOver makes the exec fail with a request to start the plan over.
+	StartOver Result = "start_over"
+	// Abort makes the exec fail with a request to abort the plan.
+	Abort Result = "abort"
+)
+
+// validate checks that the result is known.
+func (r Result) validate() error {
+	switch r {
+	case Pass, Fail, StartOver, Abort:
+		return nil
+	}
+	return errors.Reason("unknown result %q", r).Err()
+}
+
+// Script describes the results of the execs run by the plan.
+//
+// The result of an exec is looked up by action name first, then by exec
+// name. Each run of the same action (or exec) takes the next result of its
+// list, and the last result is repeated once the list is exhausted.
+type Script struct {
+	// Default is the result of execs not present in the script.
+	// Pass if not set.
+	Default Result `json:"default,omitempty"`
+	// Actions maps action names to the results of their execs.
+	Actions map[string][]Result `json:"actions,omitempty"`
+	// Execs maps exec names to their results.
+	Execs map[string][]Result `json:"execs,omitempty"`
+}
+
+// LoadScript reads the script from JSON.
+func LoadScript(r io.Reader) (*Script, error) {
+	s := &Script{}
+	if err := json.NewDecoder(r).Decode(s); err != nil {
+		return nil, errors.Annotate(err, "load script").Err()
+	}
+	if err := s.validate(); err != nil {
+		return nil, errors.Annotate(err, "load script").Err()
+	}
+	return s, nil
+}
+
+// validate checks all results of the script.
+func (s *Script) validate() error {
+	if s.Default != "" {
+		if err := s.Default.validate(); err != nil {
+			return errors.Annotate(err, "default").Err()
+		}
+	}
+	for kind, m := range map[string]map[string][]Result{"action": s.Actions, "exec": s.Execs} {
+		for name, results := range m {
+			if len(results) == 0 {
+				return errors.Reason("%s %q: no results", kind, name).Err()
+			}
+			for _, r := range results {
+				if err := r.validate(); err != nil {
+					return errors.Annotate(err, "%s %q", kind, name).Err()
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// Step is a run of an exec during the simulation.
+type Step struct {
+	// Action is the name of the action running the exec.
+	Action string `json:"action"`
+	// Exec is the name of the exec.
+	Exec string `json:"exec"`
+	// Result is the scripted result of the exec.
+	Result Result `json:"result"`
+}
+
+// Trace is the record of a simulated plan run.
+type Trace struct {
+	// Plan is the name of the plan.
+	Plan string `json:"plan"`
+	// Steps are the exec runs, in order.
+	Steps []Step `json:"steps"`
+	// Error is the error the plan finished with, empty if it passed.
+	Error string `json:"error,omitempty"`
+}
+
+// Print prints the trace in a human readable form.
+func (t *Trace) Print(w io.Writer) {
+	fmt.Fprintf(w, "Plan %q:\n", t.Plan)
+	for i, s := range t.Steps {
+		if s.Action == s.Exec {
+			fmt.Fprintf(w, "%4d. %s: %s\n", i+1, s.Action, s.Result)
+		} else {
+			fmt.Fprintf(w, "%4d. %s (exec: %s): %s\n", i+1, s.Action, s.Exec, s.Result)
+		}
+	}
+	if t.Error != "" {
+		fmt.Fprintf(w, "Plan %q: fail: %s\n", t.Plan, t.Error)
+	} else {
+		fmt.Fprintf(w, "Plan %q: pass\n", t.Plan)
+	}
+}
+
+// maxSteps limits the simulation of plans which never finish with the
+// given script, e.g. an exec always requesting to start over.
+const maxSteps = 10000
+
+// registry provides scripted exec functions to the engine.
+type registry struct {
+	script *Script
+	mu     sync.Mutex
+	// actionRuns and execRuns count the runs per action and per exec.
+	actionRuns map[string]int
+	execRuns   map[string]int
+	trace      *Trace
+}
+
+// Exec implements execs.ExecRegistry.
+func (r *registry) Exec(actionName, execName string) (execs.ExecFunction, bool) {
+	return func(ctx context.Context, _ *execs.ExecInfo) error {
+		return r.run(actionName, execName)
+	}, true
+}
+
+// run records the run of the exec and returns its scripted error.
+func (r *registry) run(actionName, execName string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.trace.Steps) >= maxSteps {
+		return errors.Reason("simulation stopped after %d steps", maxSteps).Tag(execs.PlanAbortTag).Err()
+	}
+	result := r.next(actionName, execName)
+	r.trace.Steps = append(r.trace.Steps, Step{
+		Action: actionName,
+		Exec:   execName,
+		Result: result,
+	})
+	switch result {
+	case Fail:
+		return errors.Reason("scripted fail").Err()
+	case StartOver:
+		return errors.Reason("scripted start over").Tag(execs.PlanStartOverTag).Err()
+	case Abort:
+		return errors.Reason("scripted abort").Tag(execs.PlanAbortTag).Err()
+	}
+	return nil
+}
+
+// next returns the next scripted result for the action.
+func (r *registry) next(actionName, execName string) Result {
+	pick := func(results []Result, runs map[string]int, key string) Result {
+		i := runs[key]
+		runs[key]++
+		if i >= len(results) {
+			i = len(results) - 1
+		}
+		return results[i]
+	}
+	if results, ok := r.script.Actions[actionName]; ok {
+		return pick(results, r.actionRuns, actionName)
+	}
+	if results, ok := r.script.Execs[execName]; ok {
+		return pick(results, r.execRuns, execName)
+	}
+	if r.script.Default != "" {
+		return r.script.Default
+	}
+	return Pass
+}
+
+// Run runs the plan with the recovery engine, where execs return the
+// results provided by the script.
+func Run(ctx context.Context, planName string, plan *config.Plan, script *Script, enableRecovery bool) (*Trace, error) {
+	if script == nil {
+		script = &Script{}
+	}
+	if err := script.validate(); err != nil {
+		return nil, errors.Annotate(err, "simulate").Err()
+	}
+	r := &registry{
+		script:     script,
+		actionRuns: make(map[string]int),
+		execRuns:   make(map[string]int),
+		trace:      &Trace{Plan: planName},
+	}
+	args := &execs.RunArgs{
+		ResourceName:   "simulated",
+		EnableRecovery: enableRecovery,
+		ExecRegistry:   r,
+	}
+	if err := engine.Run(ctx, planName, plan, args, nil); err != nil {
+		r.trace.Error = err.Error()
+	}
+	return r.trace, nil
+}
@@ -0,0 +1,137 @@
+// Copyright 2024 The Chromium Authors
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package simulate
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"infra/cros/recovery/config"
+)
+
+var testPlan = &config.Plan{
+	CriticalActions: []string{"a1", "a2"},
+	Actions: map[string]*config.Action{
+		"a1": {
+			ExecName:        "cros_ping",
+			RecoveryActions: []string{"r1", "r2"},
+		},
+		"a2": {
+			ExecName:   "cros_ssh",
+			Conditions: []string{"c1"},
+		},
+		"c1": {
+			ExecName: "is_servo_present",
+		},
+		"r1": {
+			ExecName: "servo_power_cycle",
+		},
+		"r2": {
+			ExecName: "servo_reboot",
+		},
+	},
+}
+
+var runCases = []struct {
+	name           string
+	script         *Script
+	enableRecovery bool
+	wantSteps      []Step
+	wantErr        bool
+}{
+	{
+		name:           "all pass",
+		enableRecovery: true,
+		wantSteps: []Step{
+			{Action: "a1", Exec: "cros_ping", Result: Pass},
+			{Action: "c1", Exec: "is_servo_present", Result: Pass},
+			{Action: "a2", Exec: "cros_ssh", Result: Pass},
+		},
+	},
+	{
+		name: "second recovery fixes the action",
+		script: &Script{
+			Actions: map[string][]Result{
+				"a1": {Fail, Pass},
+				"r1": {Fail},
+			},
+			Execs: map[string][]Result{
+				"is_servo_present": {Fail},
+			},
+		},
+		enableRecovery: true,
+		wantSteps: []Step{
+			{Action: "a1", Exec: "cros_ping", Result: Fail},
+			{Action: "r1", Exec: "servo_power_cycle", Result: Fail},
+			{Action: "r2", Exec: "servo_reboot", Result: Pass},
+			{Action: "a1", Exec: "cros_ping", Result: Pass},
+			{Action: "c1", Exec: "is_servo_present", Result: Fail},
+		},
+	},
+	{
+		name: "recovery disabled",
+		script: &Script{
+			Actions: map[string][]Result{"a1": {Fail}},
+		},
+		wantSteps: []Step{
+			{Action: "a1", Exec: "cros_ping", Result: Fail},
+		},
+		wantErr: true,
+	},
+	{
+		name: "abort",
+		script: &Script{
+			Default: Abort,
+		},
+		enableRecovery: true,
+		wantSteps: []Step{
+			{Action: "a1", Exec: "cros_ping", Result: Abort},
+			{Action: "r1", Exec: "servo_power_cycle", Result: Abort},
+		},
+		wantErr: true,
+	},
+}
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	for _, c := range runCases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+			trace, err := Run(ctx, "repair", testPlan, c.script, c.enableRecovery)
+			if err != nil {
+				t.Fatalf("Run() failed: %s", err)
+			}
+			if diff := cmp.Diff(c.wantSteps, trace.Steps); diff != "" {
+				t.Errorf("Run() steps mismatch (-want +got):\n%s", diff)
+			}
+			if gotErr := trace.Error != ""; gotErr != c.wantErr {
+				t.Errorf("Run() error = %q, want error: %t", trace.Error, c.wantErr)
+			}
+		})
+	}
+}
+
+func TestLoadScript(t *testing.T) {
+	t.Parallel()
+	s, err := LoadScript(strings.NewReader(`{"default": "fail", "actions": {"a1": ["pass"]}}`))
+	if err != nil {
+		t.Fatalf("LoadScript() failed: %s", err)
+	}
+	want := &Script{
+		Default: Fail,
+		Actions: map[string][]Result{"a1": {Pass}},
+	}
+	if diff := cmp.Diff(want, s); diff != "" {
+		t.Errorf("LoadScript() mismatch (-want +got):\n%s", diff)
+	}
+	if _, err := LoadScript(strings.NewReader(`{"execs": {"cros_ping": ["maybe"]}}`)); err == nil {
+		t.Errorf("LoadScript() with unknown result succeeded, want error")
+	}
+}
@@ -0,0 +1,199 @@
+// Copyright 2024 The Chromium Authors
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package system
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cgroupStats is the resource accounting of a cgroup v2.
+//
+// Assign the accounting of optional controllers as pointers to differentiate
+// between a disabled controller and 0.
+type cgroupStats struct {
+	// Path of the cgroup relative to the root of the hierarchy,
+	// e.g. "system.slice/swarming.service".
+	Path string
+
+	// Inode of the cgroup directory. A cgroup recreated at the same path has
+	// a new inode.
+	Inode uint64
+	// Time the cgroup was created, from which the CPU and IO accounting is
+	// cumulative.
+	Created time.Time
+
+	// CPU time used by the cgroup, in seconds.
+	CPUUsage float64
+	// CPU time the cgroup was throttled for, in seconds.
+	CPUThrottled *float64
+
+	// Memory used by the cgroup and its limit, in bytes.
+	MemoryUsed  *int64
+	MemoryLimit *int64
+
+	// Bytes read and written by the cgroup on all devices.
+	IORead  *int64
+	IOWrite *int64
+}
+
+// cgroupInstance is a cgroup seen by an update of the cgroup metrics.
+type cgroupInstance struct {
+	Inode   uint64
+	Created time.Time
+}
+
+// trackCgroups compares the cgroups read by an update with the ones of the
+// last update, by path.
+//
+// The cgroups that were not recreated keep the creation time of the last
+// update, as the creation time is approximated by the modification time of
+// the cgroup directory, which changes when child cgroups are created.
+//
+// Returns the cgroups of this update, and whether a cgroup of the last update
+// was recreated or removed since.
+func trackCgroups(last map[string]cgroupInstance, cgroups []cgroupStats) (map[string]cgroupInstance, bool) {
+	cur := make(map[string]cgroupInstance, len(cgroups))
+	changed := false
+	for i := range cgroups {
+		cg := &cgroups[i]
+		if l, ok := last[cg.Path]; ok {
+			if l.Inode == cg.Inode {
+				cg.Created = l.Created
+			} else {
+				changed = true
+			}
+		}
+		cur[cg.Path] = cgroupInstance{Inode: cg.Inode, Created: cg.Created}
+	}
+	for path := range last {
+		if _, ok := cur[path]; !ok {
+			changed = true
+		}
+	}
+	return cur, changed
+}
+
+// pressureStats is a line of a pressure stall information (PSI) file.
+type pressureStats struct {
+	// Resource is cpu, memory or io.
+	Resource string
+	// Kind is "some" (some tasks were stalled) or "full" (all tasks were stalled).
+	Kind string
+
+	// Percentage of time stalled over the last 10, 60 and 300 seconds.
+	Avg10  float64
+	Avg60  float64
+	Avg300 float64
+	// Total time stalled, in seconds.
+	Total float64
+}
+
+// parseFlatKeyed parses a file with "key value" lines, like cpu.stat.
+func parseFlatKeyed(contents string) (map[string]int64, error) {
+	ret := map[string]int64{}
+	for _, line := range strings.Split(contents, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("malformed line %q", line)
+		}
+		v, err := strconv.ParseInt(fields[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("malformed line %q: %s", line, err)
+		}
+		ret[fields[0]] = v
+	}
+	return ret, nil
+}
+
+// parseMemoryMax parses memory.max, which is "max" when there is no limit.
+// Returns nil if there is no limit.
+func parseMemoryMax(contents string) (*int64, error) {
+	contents = strings.TrimSpace(contents)
+	if contents == "max" {
+		return nil, nil
+	}
+	v, err := strconv.ParseInt(contents, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("malformed memory limit %q: %s", contents, err)
+	}
+	return &v, nil
+}
+
+// parseIOStat parses io.stat and returns the bytes read and written on all
+// devices.
+//
+// Every line is a device followed by "key=value" pairs, e.g.
+// "8:0 rbytes=1024 wbytes=2048 rios=1 wios=2 dbytes=0 dios=0".
+func parseIOStat(contents string) (read, write int64, err error) {
+	for _, line := range strings.Split(contents, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		for _, kv := range fields[1:] {
+			i := strings.IndexByte(kv, '=')
+			if i < 0 {
+				return 0, 0, fmt.Errorf("malformed line %q", line)
+			}
+			switch kv[:i] {
+			case "rbytes", "wbytes":
+				v, err := strconv.ParseInt(kv[i+1:], 10, 64)
+				if err != nil {
+					return 0, 0, fmt.Errorf("malformed line %q: %s", line, err)
+				}
+				if kv[:i] == "rbytes" {
+					read += v
+				} else {
+					write += v
+				}
+			}
+		}
+	}
+	return read, write, nil
+}
+
+// parsePressure parses a PSI file, which has lines like
+// "some avg10=0.12 avg60=0.05 avg300=0.01 total=123456".
+//
+// See https://docs.kernel.org/accounting/psi.html.
+func parsePressure(resource, contents string) ([]pressureStats, error) {
+	var ret []pressureStats
+	for _, line := range strings.Split(contents, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		p := pressureStats{Resource: resource, Kind: fields[0]}
+		for _, kv := range fields[1:] {
+			i := strings.IndexByte(kv, '=')
+			if i < 0 {
+				return nil, fmt.Errorf("malformed line %q", line)
+			}
+			v, err := strconv.ParseFloat(kv[i+1:], 64)
+			if err != nil {
+				return nil, fmt.Errorf("malformed line %q: %s", line, err)
+			}
+			switch kv[:i] {
+			case "avg10":
+				p.Avg10 = v
+			case "avg60":
+				p.Avg60 = v
+			case "avg300":
+				p.Avg300 = v
+			case "total":
+				// The total is in microseconds.
+				p.Total = v / 1e6
+			}
+		}
+		ret = append(ret, p)
+	}
+	return ret, nil
+}
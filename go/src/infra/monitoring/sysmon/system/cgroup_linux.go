@@ -0,0 +1,204 @@
+// Copyright 2024 The Chromium Authors
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package system
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+)
+
+const (
+	cgroupRoot   = "/sys/fs/cgroup"
+	pressureRoot = "/proc/pressure"
+
+	// Only the slices and their direct children (e.g.
+	// "system.slice/swarming.service") are reported, to keep the number of
+	// metric streams bounded.
+	maxCgroupDepth = 2
+)
+
+// persistentUnitSuffixes are the suffixes of the systemd units reported below
+// the top level. Transient cgroups, e.g. "system.slice/docker-<id>.scope" or
+// "user.slice/session-<n>.scope", come and go with containers and sessions,
+// and would leave a metric stream behind each. They are accounted for in
+// their parent cgroup instead.
+var persistentUnitSuffixes = []string{".service", ".slice"}
+
+var pressureResources = []string{"cpu", "memory", "io"}
+
+func readCgroups() ([]cgroupStats, error) {
+	return readCgroupsFrom(cgroupRoot, maxCgroupDepth)
+}
+
+func readPressure() ([]pressureStats, error) {
+	return readPressureFrom(pressureRoot)
+}
+
+// readCgroupsFrom reads the accounting of the cgroups under root, up to
+// maxDepth levels deep. Below the top level, only persistent units are read.
+// The root cgroup itself is covered by the host metrics.
+//
+// Returns nothing if root is not a cgroup v2 hierarchy.
+func readCgroupsFrom(root string, maxDepth int) ([]cgroupStats, error) {
+	// Only the unified hierarchy has cgroup.controllers at its root.
+	if _, err := os.Stat(filepath.Join(root, "cgroup.controllers")); os.IsNotExist(err) {
+		return nil, nil
+	}
+
+	var ret []cgroupStats
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			// Cgroups come and go while we walk the hierarchy.
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if !info.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+		if rel == "." {
+			return nil
+		}
+		rel = filepath.ToSlash(rel)
+		depth := strings.Count(rel, "/") + 1
+		if depth > 1 && !isPersistentUnit(info.Name()) {
+			return filepath.SkipDir
+		}
+
+		s, err := readCgroup(path, rel)
+		if st, ok := info.Sys().(*syscall.Stat_t); ok {
+			s.Inode = st.Ino
+		}
+		// cgroupfs doesn't record when a cgroup was created. The modification
+		// time of its directory is the creation time until child cgroups are
+		// created, see trackCgroups.
+		s.Created = info.ModTime()
+		switch {
+		case os.IsNotExist(err):
+			return filepath.SkipDir
+		case err != nil:
+			return fmt.Errorf("failed to read cgroup %q: %s", rel, err)
+		}
+		ret = append(ret, s)
+
+		if depth >= maxDepth {
+			return filepath.SkipDir
+		}
+		return nil
+	})
+	return ret, err
+}
+
+// isPersistentUnit checks whether the cgroup is a persistent systemd unit.
+func isPersistentUnit(name string) bool {
+	for _, suffix := range persistentUnitSuffixes {
+		if strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// readCgroup reads the accounting of a single cgroup. Only cpu.stat is
+// required, the files of other controllers are read if the controllers are
+// enabled.
+func readCgroup(dir, name string) (cgroupStats, error) {
+	s := cgroupStats{Path: name}
+
+	contents, err := ioutil.ReadFile(filepath.Join(dir, "cpu.stat"))
+	if err != nil {
+		return s, err
+	}
+	cpu, err := parseFlatKeyed(string(contents))
+	if err != nil {
+		return s, fmt.Errorf("cpu.stat: %s", err)
+	}
+	s.CPUUsage = float64(cpu["usage_usec"]) / 1e6
+	if v, ok := cpu["throttled_usec"]; ok {
+		throttled := float64(v) / 1e6
+		s.CPUThrottled = &throttled
+	}
+
+	contents, err = readOptionalFile(filepath.Join(dir, "memory.current"))
+	if err != nil {
+		return s, err
+	}
+	if contents != nil {
+		used, err := strconv.ParseInt(strings.TrimSpace(string(contents)), 10, 64)
+		if err != nil {
+			return s, fmt.Errorf("memory.current: %s", err)
+		}
+		s.MemoryUsed = &used
+	}
+
+	contents, err = readOptionalFile(filepath.Join(dir, "memory.max"))
+	if err != nil {
+		return s, err
+	}
+	if contents != nil {
+		if s.MemoryLimit, err = parseMemoryMax(string(contents)); err != nil {
+			return s, fmt.Errorf("memory.max: %s", err)
+		}
+	}
+
+	contents, err = readOptionalFile(filepath.Join(dir, "io.stat"))
+	if err != nil {
+		return s, err
+	}
+	if contents != nil {
+		read, write, err := parseIOStat(string(contents))
+		if err != nil {
+			return s, fmt.Errorf("io.stat: %s", err)
+		}
+		s.IORead = &read
+		s.IOWrite = &write
+	}
+
+	return s, nil
+}
+
+// readOptionalFile returns the contents of a file, or nil if it does not
+// exist.
+func readOptionalFile(path string) ([]byte, error) {
+	contents, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	return contents, err
+}
+
+// readPressureFrom reads the PSI files of all resources in dir.
+//
+// Resources the kernel doesn't report pressure for are skipped: PSI needs
+// Linux 4.20 and may be disabled with the psi=0 boot option.
+func readPressureFrom(dir string) ([]pressureStats, error) {
+	var ret []pressureStats
+	for _, resource := range pressureResources {
+		contents, err := ioutil.ReadFile(filepath.Join(dir, resource))
+		switch {
+		case os.IsNotExist(err), errors.Is(err, syscall.EOPNOTSUPP):
+			continue
+		case err != nil:
+			return nil, err
+		}
+		stats, err := parsePressure(resource, string(contents))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s pressure: %s", resource, err)
+		}
+		ret = append(ret, stats...)
+	}
+	return ret, nil
+}
@@ -0,0 +1,142 @@
+// Copyright 2024 The Chromium Authors
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package system
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestReadCgroups(t *testing.T) {
+	t.Parallel()
+
+	float := func(v float64) *float64 { return &v }
+	int64p := func(v int64) *int64 { return &v }
+
+	Convey("Persistent cgroups are read up to the max depth", t, func() {
+		cgroups, err := readCgroupsFrom("testdata/cgroup", 2)
+		So(err, ShouldBeNil)
+		for i := range cgroups {
+			So(cgroups[i].Inode, ShouldNotEqual, 0)
+			So(cgroups[i].Created.IsZero(), ShouldBeFalse)
+			cgroups[i].Inode = 0
+			cgroups[i].Created = time.Time{}
+		}
+		So(cgroups, ShouldResemble, []cgroupStats{
+			{
+				Path:         "system.slice",
+				CPUUsage:     12.5,
+				CPUThrottled: float(0),
+				MemoryUsed:   int64p(2147483648),
+				IORead:       int64p(5120),
+				IOWrite:      int64p(8192),
+			},
+			{
+				Path:         "system.slice/swarming.service",
+				CPUUsage:     3,
+				CPUThrottled: float(1.5),
+				MemoryUsed:   int64p(1073741824),
+				MemoryLimit:  int64p(4294967296),
+				IORead:       int64p(512),
+				IOWrite:      int64p(256),
+			},
+			{
+				Path:     "user.slice",
+				CPUUsage: 0.25,
+			},
+		})
+	})
+
+	Convey("Only the top cgroups are read with depth 1", t, func() {
+		cgroups, err := readCgroupsFrom("testdata/cgroup", 1)
+		So(err, ShouldBeNil)
+		So(len(cgroups), ShouldEqual, 2)
+		So(cgroups[0].Path, ShouldEqual, "system.slice")
+		So(cgroups[1].Path, ShouldEqual, "user.slice")
+	})
+
+	Convey("Nothing is read from a cgroup v1 hierarchy", t, func() {
+		cgroups, err := readCgroupsFrom("testdata/pressure", 2)
+		So(err, ShouldBeNil)
+		So(cgroups, ShouldBeEmpty)
+	})
+}
+
+func TestReadPressure(t *testing.T) {
+	t.Parallel()
+
+	Convey("Missing resources are skipped", t, func() {
+		pressure, err := readPressureFrom("testdata/pressure")
+		So(err, ShouldBeNil)
+		So(pressure, ShouldResemble, []pressureStats{
+			{Resource: "cpu", Kind: "some", Avg10: 1.5, Avg60: 0.75, Avg300: 0.25, Total: 2.5},
+			{Resource: "cpu", Kind: "full"},
+			{Resource: "memory", Kind: "some", Avg10: 12.34, Avg60: 5.67, Avg300: 1, Total: 30},
+			{Resource: "memory", Kind: "full", Avg10: 10, Avg60: 4, Avg300: 0.5, Total: 20},
+		})
+	})
+
+	Convey("Nothing is read without PSI", t, func() {
+		pressure, err := readPressureFrom("testdata/does-not-exist")
+		So(err, ShouldBeNil)
+		So(pressure, ShouldBeEmpty)
+	})
+
+	Convey("Malformed lines are rejected", t, func() {
+		_, err := parsePressure("cpu", "some avg10=abc")
+		So(err, ShouldNotBeNil)
+		_, _, err = parseIOStat("8:0 rbytes")
+		So(err, ShouldNotBeNil)
+		_, err = parseFlatKeyed("usage_usec")
+		So(err, ShouldNotBeNil)
+	})
+}
+
+func TestTrackCgroups(t *testing.T) {
+	t.Parallel()
+
+	t0 := time.Unix(1700000000, 0)
+	t1 := t0.Add(time.Hour)
+
+	Convey("New cgroups keep their creation time", t, func() {
+		cgroups := []cgroupStats{{Path: "a.slice", Inode: 1, Created: t0}}
+		cur, changed := trackCgroups(nil, cgroups)
+		So(changed, ShouldBeFalse)
+		So(cur, ShouldResemble, map[string]cgroupInstance{"a.slice": {Inode: 1, Created: t0}})
+	})
+
+	Convey("Existing cgroups keep the creation time of the last update", t, func() {
+		last := map[string]cgroupInstance{"a.slice": {Inode: 1, Created: t0}}
+		cgroups := []cgroupStats{
+			{Path: "a.slice", Inode: 1, Created: t1},
+			{Path: "b.slice", Inode: 2, Created: t1},
+		}
+		cur, changed := trackCgroups(last, cgroups)
+		So(changed, ShouldBeFalse)
+		So(cgroups[0].Created, ShouldEqual, t0)
+		So(cur, ShouldResemble, map[string]cgroupInstance{
+			"a.slice": {Inode: 1, Created: t0},
+			"b.slice": {Inode: 2, Created: t1},
+		})
+	})
+
+	Convey("Recreated cgroups get their new creation time", t, func() {
+		last := map[string]cgroupInstance{"a.slice": {Inode: 1, Created: t0}}
+		cgroups := []cgroupStats{{Path: "a.slice", Inode: 3, Created: t1}}
+		cur, changed := trackCgroups(last, cgroups)
+		So(changed, ShouldBeTrue)
+		So(cgroups[0].Created, ShouldEqual, t1)
+		So(cur, ShouldResemble, map[string]cgroupInstance{"a.slice": {Inode: 3, Created: t1}})
+	})
+
+	Convey("Removed cgroups are reported", t, func() {
+		last := map[string]cgroupInstance{"a.slice": {Inode: 1, Created: t0}}
+		cur, changed := trackCgroups(last, nil)
+		So(changed, ShouldBeTrue)
+		So(cur, ShouldBeEmpty)
+	})
+}
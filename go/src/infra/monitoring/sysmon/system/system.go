@@ -135,6 +135,45 @@ var (
 		&types.MetricMetadata{Units: types.DegreeCelsiusUnit},
 		field.String("core"))
 
+	cgroupCPUUsage = metric.NewFloatCounter("dev/cgroup/cpu/usage",
+		"CPU time used by a cgroup (linux only).",
+		&types.MetricMetadata{Units: types.Seconds},
+		field.String("cgroup"))
+	cgroupCPUThrottled = metric.NewFloatCounter("dev/cgroup/cpu/throttled",
+		"Time a cgroup was throttled for by its CPU limit (linux only).",
+		&types.MetricMetadata{Units: types.Seconds},
+		field.String("cgroup"))
+	cgroupMemUsed = metric.NewInt("dev/cgroup/mem/used",
+		"Memory used by a cgroup, including page cache (linux only).",
+		&types.MetricMetadata{Units: types.Bytes},
+		field.String("cgroup"))
+	cgroupMemLimit = metric.NewInt("dev/cgroup/mem/limit",
+		"Memory limit of a cgroup, if it has one (linux only).",
+		&types.MetricMetadata{Units: types.Bytes},
+		field.String("cgroup"))
+	cgroupIORead = metric.NewCounter("dev/cgroup/io/read",
+		"Number of Bytes read on all disks by a cgroup (linux only).",
+		&types.MetricMetadata{Units: types.Bytes},
+		field.String("cgroup"))
+	cgroupIOWrite = metric.NewCounter("dev/cgroup/io/write",
+		"Number of Bytes written on all disks by a cgroup (linux only).",
+		&types.MetricMetadata{Units: types.Bytes},
+		field.String("cgroup"))
+
+	// See https://docs.kernel.org/accounting/psi.html for the meaning of
+	// "some" and "full".
+	pressureAverage = metric.NewFloat("dev/pressure/average",
+		"Percentage of time some or all tasks were stalled on a resource, averaged over a window (linux only).",
+		nil,
+		field.String("resource"),
+		field.String("kind"),
+		field.Int("seconds"))
+	pressureTotal = metric.NewFloatCounter("dev/pressure/total",
+		"Total time some or all tasks were stalled on a resource (linux only).",
+		&types.MetricMetadata{Units: types.Seconds},
+		field.String("resource"),
+		field.String("kind"))
+
 	// tsmon pipeline uses backend clocks when assigning timestamps to metric
 	// points. By comparing point timestamp to the point value (i.e. time by
 	// machine's local clock), we can potentially detect some anomalies (clock
@@ -162,6 +201,8 @@ var (
 		nil)
 
 	lastCPUTimes cpu.TimesStat
+	// lastCgroups are the cgroups of the last update of the cgroup metrics.
+	lastCgroups map[string]cgroupInstance
 )
 
 func init() {
@@ -183,6 +224,7 @@ func init() {
 	netErrDown.SetFixedResetTime(bootTime)
 	netDropUp.SetFixedResetTime(bootTime)
 	netDropDown.SetFixedResetTime(bootTime)
+	pressureTotal.SetFixedResetTime(bootTime)
 
 	cpuTimes, err := cpu.Times(false)
 	if err != nil {
@@ -221,6 +263,12 @@ func Register() {
 		if err := updateSystemTemps(c); err != nil {
 			logging.Warningf(c, "Failed to update system temperatures: %v", err)
 		}
+		if err := updateCgroupMetrics(c); err != nil {
+			logging.Warningf(c, "Failed to update cgroup metrics: %v", err)
+		}
+		if err := updatePressureMetrics(c); err != nil {
+			logging.Warningf(c, "Failed to update pressure stall metrics: %v", err)
+		}
 
 		// Should be done last.
 		if err := updateUnixTimeMetrics(c); err != nil {
@@ -421,3 +469,57 @@ func updateSystemTemps(c context.Context) error {
 	}
 	return nil
 }
+
+func updateCgroupMetrics(c context.Context) error {
+	cgroups, err := readCgroups()
+	if err != nil {
+		return err
+	}
+	// The CPU and IO accounting is cumulative since each cgroup was created,
+	// so it is reported with the creation time as reset time. The store keeps
+	// the reset time of existing cells, so the cells of all the cgroups are
+	// reset when a cgroup is recreated, which also drops removed cgroups.
+	var changed bool
+	lastCgroups, changed = trackCgroups(lastCgroups, cgroups)
+	store := tsmon.Store(c)
+	if changed {
+		store.Reset(c, cgroupCPUUsage)
+		store.Reset(c, cgroupCPUThrottled)
+		store.Reset(c, cgroupIORead)
+		store.Reset(c, cgroupIOWrite)
+	}
+	for _, cg := range cgroups {
+		fields := []any{cg.Path}
+		store.Set(c, cgroupCPUUsage, cg.Created, fields, cg.CPUUsage)
+		if cg.CPUThrottled != nil {
+			store.Set(c, cgroupCPUThrottled, cg.Created, fields, *cg.CPUThrottled)
+		}
+		if cg.MemoryUsed != nil {
+			cgroupMemUsed.Set(c, *cg.MemoryUsed, cg.Path)
+		}
+		if cg.MemoryLimit != nil {
+			cgroupMemLimit.Set(c, *cg.MemoryLimit, cg.Path)
+		}
+		if cg.IORead != nil {
+			store.Set(c, cgroupIORead, cg.Created, fields, *cg.IORead)
+		}
+		if cg.IOWrite != nil {
+			store.Set(c, cgroupIOWrite, cg.Created, fields, *cg.IOWrite)
+		}
+	}
+	return nil
+}
+
+func updatePressureMetrics(c context.Context) error {
+	pressure, err := readPressure()
+	if err != nil {
+		return err
+	}
+	for _, p := range pressure {
+		pressureAverage.Set(c, p.Avg10, p.Resource, p.Kind, 10)
+		pressureAverage.Set(c, p.Avg60, p.Resource, p.Kind, 60)
+		pressureAverage.Set(c, p.Avg300, p.Resource, p.Kind, 300)
+		pressureTotal.Set(c, p.Total, p.Resource, p.Kind)
+	}
+	return nil
+}
@@ -0,0 +1,159 @@
+// Copyright 2024 The Chromium Authors
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/maruel/subcommands"
+	"google.golang.org/protobuf/encoding/prototext"
+
+	"go.chromium.org/luci/common/cli"
+
+	"infra/qscheduler/qslib/protos"
+	"infra/qscheduler/qslib/scheduler"
+	"infra/qscheduler/qslib/simulator"
+)
+
+// Simulate subcommand: Simulate a qscheduler pool configuration.
+var Simulate = &subcommands.Command{
+	UsageLine: "simulate -events EVENTS_FILE -config CONFIG_FILE",
+	ShortDesc: "Simulate a scheduler configuration against recorded events",
+	LongDesc: `Simulate a scheduler configuration against recorded events.
+
+Replays the task arrivals and worker idles recorded in EVENTS_FILE, which
+contains one JSON encoded TaskEvent per line, against the SchedulerConfig in
+text proto format in CONFIG_FILE. Reports per-account wait time statistics,
+usage and fairness, to evaluate configuration changes before deploying them.`,
+	CommandRun: func() subcommands.CommandRun {
+		c := &simulateRun{}
+		c.Flags.StringVar(&c.events, "events", "", "Path to the recorded task events, in JSON lines format.")
+		c.Flags.StringVar(&c.config, "config", "", "Path to the SchedulerConfig to simulate, in text proto format.")
+		c.Flags.DurationVar(&c.tick, "tick", 10*time.Second, "Interval between scheduler passes.")
+		c.Flags.DurationVar(&c.drain, "drain", time.Hour, "How long to keep scheduling queued tasks after the last recorded event.")
+		c.Flags.DurationVar(&c.defaultDuration, "default-duration", 10*time.Minute, "Duration of tasks whose completion is not recorded.")
+		c.Flags.BoolVar(&c.json, "json", false, "Print the result in JSON format.")
+		return c
+	},
+}
+
+type simulateRun struct {
+	subcommands.CommandRunBase
+	events          string
+	config          string
+	tick            time.Duration
+	drain           time.Duration
+	defaultDuration time.Duration
+	json            bool
+}
+
+func (c *simulateRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
+	ctx := cli.GetContext(a, c, env)
+
+	if len(args) > 0 {
+		fmt.Fprintf(a.GetErr(), "too many arguments\n")
+		c.Flags.Usage()
+		return 1
+	}
+
+	if c.events == "" || c.config == "" {
+		fmt.Fprintf(a.GetErr(), "both -events and -config are required\n")
+		c.Flags.Usage()
+		return 1
+	}
+
+	config, err := readSchedulerConfig(c.config)
+	if err != nil {
+		fmt.Fprintf(a.GetErr(), "qscheduler: Unable to read config, due to error: %s\n", err.Error())
+		return 1
+	}
+
+	f, err := os.Open(c.events)
+	if err != nil {
+		fmt.Fprintf(a.GetErr(), "qscheduler: Unable to open events, due to error: %s\n", err.Error())
+		return 1
+	}
+	defer f.Close()
+	events, err := simulator.ReadEvents(f)
+	if err != nil {
+		fmt.Fprintf(a.GetErr(), "qscheduler: Unable to read events, due to error: %s\n", err.Error())
+		return 1
+	}
+
+	tr := simulator.TraceFromEvents(events, c.defaultDuration)
+	res, err := simulator.Simulate(ctx, tr, simulator.Options{
+		Config: config,
+		Tick:   c.tick,
+		Drain:  c.drain,
+	})
+	if err != nil {
+		fmt.Fprintf(a.GetErr(), "qscheduler: Unable to simulate, due to error: %s\n", err.Error())
+		return 1
+	}
+
+	if c.json {
+		enc := json.NewEncoder(a.GetOut())
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(res); err != nil {
+			fmt.Fprintf(a.GetErr(), "qscheduler: Unable to print result, due to error: %s\n", err.Error())
+			return 1
+		}
+		return 0
+	}
+	printSimulationResult(a.GetOut(), res)
+	return 0
+}
+
+// readSchedulerConfig reads a SchedulerConfig in text proto format.
+func readSchedulerConfig(path string) (*scheduler.Config, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	p := &protos.SchedulerConfig{}
+	if err := prototext.Unmarshal(b, p); err != nil {
+		return nil, err
+	}
+	return scheduler.NewConfigFromProto(p), nil
+}
+
+func printSimulationResult(w io.Writer, res *simulator.Result) {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "Simulation from %s to %s\n", res.Start.UTC().Format(time.RFC3339), res.End.UTC().Format(time.RFC3339))
+	fmt.Fprintln(tw, "================================================================")
+	header := row{"Account", "Tasks", "Assigned", "Preempted", "Unassigned", "WaitP50", "WaitP90", "WaitP99", "WaitMax", "Share", "Entitlement"}
+	header.print(tw)
+	fmt.Fprintln(tw)
+	t := make(table, 0, len(res.Accounts)+1)
+	for _, ar := range res.Accounts {
+		t = append(t, simulationRow(string(ar.AccountID), ar))
+	}
+	t = append(t, simulationRow("(total)", res.Total))
+	t.print(tw)
+	fmt.Fprintln(tw)
+	fmt.Fprintf(tw, "Fairness (Jain's index of share/entitlement): %.3f\n", res.Fairness)
+	tw.Flush()
+}
+
+func simulationRow(name string, ar *simulator.AccountResult) row {
+	return row{
+		name,
+		fmt.Sprint(ar.Tasks),
+		fmt.Sprint(ar.Assigned),
+		fmt.Sprint(ar.Preempted),
+		fmt.Sprint(ar.Unassigned),
+		ar.WaitP50.Round(time.Second).String(),
+		ar.WaitP90.Round(time.Second).String(),
+		ar.WaitP99.Round(time.Second).String(),
+		ar.WaitMax.Round(time.Second).String(),
+		fmt.Sprintf("%.3f", ar.Share),
+		fmt.Sprintf("%.3f", ar.Entitlement),
+	}
+}
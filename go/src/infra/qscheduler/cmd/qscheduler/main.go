@@ -43,6 +43,9 @@ func getApplication() *cli.Application {
 
 			subcommands.Section("View"),
 			cmd.Inspect,
+
+			subcommands.Section("Simulation"),
+			cmd.Simulate,
 		},
 	}
 }
@@ -0,0 +1,325 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulator
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	"go.chromium.org/luci/common/data/stringset"
+	"go.chromium.org/luci/common/errors"
+
+	"infra/qscheduler/qslib/scheduler"
+)
+
+// Options are the options of a simulation.
+type Options struct {
+	// Config is the scheduler configuration to evaluate.
+	// It is not modified by the simulation.
+	Config *scheduler.Config
+
+	// Tick is the interval between scheduler passes.
+	//
+	// If 0, defaults to 10 seconds.
+	Tick time.Duration
+
+	// Drain is how long the simulation continues after the last recorded
+	// event, for queued tasks to be assigned. Running tasks always run to
+	// completion.
+	//
+	// If 0, defaults to 1 hour.
+	Drain time.Duration
+}
+
+// AccountResult is the result of a simulation for an account.
+type AccountResult struct {
+	AccountID scheduler.AccountID `json:"account_id"`
+
+	// Tasks is the number of tasks that arrived.
+	Tasks int `json:"tasks"`
+	// Assigned is the number of tasks assigned to a worker.
+	Assigned int `json:"assigned"`
+	// Preempted is the number of tasks preempted by other tasks.
+	Preempted int `json:"preempted"`
+	// Unassigned is the number of tasks never assigned to a worker.
+	Unassigned int `json:"unassigned"`
+
+	// Wait time statistics of assigned tasks, from arrival to assignment.
+	// They are encoded in nanoseconds in JSON.
+	WaitP50  time.Duration `json:"wait_p50_ns"`
+	WaitP90  time.Duration `json:"wait_p90_ns"`
+	WaitP99  time.Duration `json:"wait_p99_ns"`
+	WaitMax  time.Duration `json:"wait_max_ns"`
+	WaitMean time.Duration `json:"wait_mean_ns"`
+
+	// UsageSeconds is the worker time used by the tasks of the account.
+	UsageSeconds float64 `json:"usage_seconds"`
+	// Share is the ratio of UsageSeconds to the total worker time used.
+	Share float64 `json:"share"`
+	// Entitlement is the ratio of the total charge rate of the account to
+	// the total charge rate of all accounts.
+	Entitlement float64 `json:"entitlement"`
+
+	waits []time.Duration
+}
+
+// Result is the result of a simulation.
+type Result struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+
+	// Accounts are the results per account, ordered by account ID.
+	Accounts []*AccountResult `json:"accounts"`
+	// Total is the result of all accounts together. Its AccountID is empty.
+	Total *AccountResult `json:"total"`
+
+	// Fairness is Jain's fairness index of Share/Entitlement of the
+	// accounts with positive entitlement that ran tasks.
+	// It is 1 if the usage is exactly proportional to the entitlement, and
+	// 1/n at worst for n accounts. It is 0 if there are no such accounts.
+	Fairness float64 `json:"fairness"`
+}
+
+// running is a task running on a worker in the simulation.
+type running struct {
+	arrival *Arrival
+	worker  scheduler.WorkerID
+	start   time.Time
+	end     time.Time
+}
+
+// Simulate replays the trace against the scheduler configuration.
+func Simulate(ctx context.Context, tr *Trace, opts Options) (*Result, error) {
+	if opts.Config == nil {
+		return nil, errors.Reason("config is required").Err()
+	}
+	tick := opts.Tick
+	if tick == 0 {
+		tick = 10 * time.Second
+	}
+	drain := opts.Drain
+	if drain == 0 {
+		drain = time.Hour
+	}
+	tr.sort()
+	if len(tr.Arrivals) == 0 {
+		return nil, errors.Reason("no task arrivals in the trace").Err()
+	}
+
+	start := tr.Arrivals[0].Time
+	last := tr.Arrivals[len(tr.Arrivals)-1].Time
+	if len(tr.Idles) > 0 {
+		if tr.Idles[0].Time.Before(start) {
+			start = tr.Idles[0].Time
+		}
+		if tr.Idles[len(tr.Idles)-1].Time.After(last) {
+			last = tr.Idles[len(tr.Idles)-1].Time
+		}
+	}
+
+	// Do not modify the given config; AddAccount etc. mutate it.
+	config := scheduler.NewConfigFromProto(opts.Config.ToProto())
+	s := scheduler.NewWithConfig(start, config)
+
+	accounts := make(map[scheduler.AccountID]*AccountResult)
+	account := func(id scheduler.AccountID) *AccountResult {
+		a, ok := accounts[id]
+		if !ok {
+			a = &AccountResult{AccountID: id}
+			accounts[id] = a
+		}
+		return a
+	}
+	arrivals := make(map[scheduler.RequestID]*Arrival, len(tr.Arrivals))
+	workers := make(map[scheduler.WorkerID]stringset.Set)
+	runs := make(map[scheduler.RequestID]*running)
+	busy := make(map[scheduler.WorkerID]bool)
+	stop := func(r *running, t time.Time) {
+		account(r.arrival.AccountID).UsageSeconds += t.Sub(r.start).Seconds()
+		delete(runs, r.arrival.RequestID)
+		delete(busy, r.worker)
+	}
+
+	nextArrival, nextIdle := 0, 0
+	t := start
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		// Complete finished tasks. The worker becomes idle.
+		for _, r := range runs {
+			if r.end.After(t) {
+				continue
+			}
+			stop(r, r.end)
+			s.MarkIdle(ctx, r.worker, workers[r.worker], t, scheduler.NullEventSink)
+		}
+		for ; nextArrival < len(tr.Arrivals) && !tr.Arrivals[nextArrival].Time.After(t); nextArrival++ {
+			a := tr.Arrivals[nextArrival]
+			if _, ok := arrivals[a.RequestID]; ok {
+				continue
+			}
+			arrivals[a.RequestID] = a
+			account(a.AccountID).Tasks++
+			req := scheduler.NewTaskRequest(a.RequestID, a.AccountID,
+				stringset.NewFromSlice(a.ProvisionableLabels...),
+				stringset.NewFromSlice(a.BaseLabels...), a.Time)
+			s.AddRequest(ctx, req, t, nil, scheduler.NullEventSink)
+		}
+		for ; nextIdle < len(tr.Idles) && !tr.Idles[nextIdle].Time.After(t); nextIdle++ {
+			idle := tr.Idles[nextIdle]
+			workers[idle.WorkerID] = stringset.NewFromSlice(idle.Labels...)
+		}
+		// Idle workers poll the scheduler, otherwise they expire.
+		for id, labels := range workers {
+			if !busy[id] {
+				s.MarkIdle(ctx, id, labels, t, scheduler.NullEventSink)
+			}
+		}
+
+		s.UpdateTime(ctx, t)
+		for _, as := range s.RunOnce(ctx, scheduler.NullEventSink) {
+			if as.Type == scheduler.AssignmentPreemptWorker {
+				if r, ok := runs[as.TaskToAbort]; ok {
+					account(r.arrival.AccountID).Preempted++
+					stop(r, t)
+				}
+			}
+			a := arrivals[as.RequestID]
+			ar := account(a.AccountID)
+			ar.Assigned++
+			ar.waits = append(ar.waits, t.Sub(a.Time))
+			runs[a.RequestID] = &running{
+				arrival: a,
+				worker:  as.WorkerID,
+				start:   t,
+				end:     t.Add(a.Duration),
+			}
+			busy[as.WorkerID] = true
+		}
+
+		recorded := nextArrival < len(tr.Arrivals) || nextIdle < len(tr.Idles)
+		waiting := len(s.GetWaitingRequests()) > 0
+		switch {
+		case !recorded && len(runs) == 0 && (!waiting || t.Sub(last) >= drain):
+			return newResult(start, t, accounts, config), nil
+		case !waiting && len(runs) == 0 && recorded:
+			// Nothing to do until the next recorded event. Skip to it, keeping
+			// the phase of the ticks.
+			var next time.Time
+			if nextArrival < len(tr.Arrivals) {
+				next = tr.Arrivals[nextArrival].Time
+			}
+			if nextIdle < len(tr.Idles) && (next.IsZero() || tr.Idles[nextIdle].Time.Before(next)) {
+				next = tr.Idles[nextIdle].Time
+			}
+			if skip := next.Sub(t) / tick; skip > 1 {
+				t = t.Add((skip - 1) * tick)
+			}
+		}
+		t = t.Add(tick)
+	}
+}
+
+// newResult computes the result of a simulation.
+func newResult(start, end time.Time, accounts map[scheduler.AccountID]*AccountResult, config *scheduler.Config) *Result {
+	ret := &Result{
+		Start: start,
+		End:   end,
+		Total: &AccountResult{},
+	}
+
+	var totalRate float64
+	rates := make(map[scheduler.AccountID]float64, len(config.AccountConfigs))
+	for id, ac := range config.AccountConfigs {
+		for _, r := range ac.ChargeRate {
+			rates[id] += float64(r)
+		}
+		totalRate += rates[id]
+		// Report accounts without tasks too.
+		if _, ok := accounts[id]; !ok {
+			accounts[id] = &AccountResult{AccountID: id}
+		}
+	}
+
+	for _, a := range accounts {
+		ret.Accounts = append(ret.Accounts, a)
+		a.Unassigned = a.Tasks - a.Assigned
+		ret.Total.Tasks += a.Tasks
+		ret.Total.Assigned += a.Assigned
+		ret.Total.Preempted += a.Preempted
+		ret.Total.Unassigned += a.Unassigned
+		ret.Total.UsageSeconds += a.UsageSeconds
+		ret.Total.waits = append(ret.Total.waits, a.waits...)
+	}
+	sort.Slice(ret.Accounts, func(i, j int) bool {
+		return ret.Accounts[i].AccountID < ret.Accounts[j].AccountID
+	})
+
+	var sum, sumSquares float64
+	n := 0
+	for _, a := range append(ret.Accounts, ret.Total) {
+		a.computeWaits()
+		if ret.Total.UsageSeconds > 0 {
+			a.Share = a.UsageSeconds / ret.Total.UsageSeconds
+		}
+		if a == ret.Total {
+			continue
+		}
+		if totalRate > 0 {
+			a.Entitlement = rates[a.AccountID] / totalRate
+		}
+		if a.Entitlement > 0 && a.Tasks > 0 {
+			x := a.Share / a.Entitlement
+			sum += x
+			sumSquares += x * x
+			n++
+		}
+	}
+	if n > 0 && sumSquares > 0 {
+		ret.Fairness = sum * sum / (float64(n) * sumSquares)
+	}
+	return ret
+}
+
+// computeWaits computes the wait time statistics from a.waits.
+func (a *AccountResult) computeWaits() {
+	if len(a.waits) == 0 {
+		return
+	}
+	sort.Slice(a.waits, func(i, j int) bool { return a.waits[i] < a.waits[j] })
+	var sum time.Duration
+	for _, w := range a.waits {
+		sum += w
+	}
+	a.WaitMean = sum / time.Duration(len(a.waits))
+	a.WaitP50 = percentile(a.waits, 50)
+	a.WaitP90 = percentile(a.waits, 90)
+	a.WaitP99 = percentile(a.waits, 99)
+	a.WaitMax = a.waits[len(a.waits)-1]
+}
+
+// percentile returns the p-th percentile of the sorted durations, using
+// the nearest-rank method.
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
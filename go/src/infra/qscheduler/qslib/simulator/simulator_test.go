@@ -0,0 +1,176 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulator
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	"infra/qscheduler/qslib/protos/metrics"
+	"infra/qscheduler/qslib/scheduler"
+	"infra/qscheduler/qslib/tutils"
+)
+
+func TestSimulate(t *testing.T) {
+	Convey("Given a config with two accounts of equal rate", t, func() {
+		ctx := context.Background()
+		t0 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		config := scheduler.NewConfig()
+		config.DisablePreemption = true
+		config.AccountConfigs["a1"] = scheduler.NewAccountConfig(0, nil, 0, []float32{1}, false, "")
+		config.AccountConfigs["a2"] = scheduler.NewAccountConfig(0, nil, 0, []float32{1}, false, "")
+
+		Convey("when two tasks compete for a single worker", func() {
+			tr := &Trace{
+				Arrivals: []*Arrival{
+					{Time: t0.Add(time.Second), RequestID: "r2", AccountID: "a2", Duration: 10 * time.Minute},
+					{Time: t0, RequestID: "r1", AccountID: "a1", Duration: 10 * time.Minute},
+				},
+				Idles: []*Idle{
+					{Time: t0, WorkerID: "w1"},
+				},
+			}
+			res, err := Simulate(ctx, tr, Options{Config: config})
+			So(err, ShouldBeNil)
+
+			Convey("then the tasks run one after the other.", func() {
+				So(res.Start, ShouldEqual, t0)
+				So(res.End, ShouldEqual, t0.Add(20*time.Minute))
+				So(res.Accounts, ShouldHaveLength, 2)
+
+				a1, a2 := res.Accounts[0], res.Accounts[1]
+				So(a1.AccountID, ShouldEqual, scheduler.AccountID("a1"))
+				So(a1.Assigned, ShouldEqual, 1)
+				So(a1.WaitMax, ShouldEqual, time.Duration(0))
+				So(a2.AccountID, ShouldEqual, scheduler.AccountID("a2"))
+				So(a2.Assigned, ShouldEqual, 1)
+				So(a2.WaitMax, ShouldEqual, 10*time.Minute-time.Second)
+
+				So(res.Total.Tasks, ShouldEqual, 2)
+				So(res.Total.Unassigned, ShouldEqual, 0)
+				So(res.Total.WaitP50, ShouldEqual, time.Duration(0))
+				So(res.Total.WaitMax, ShouldEqual, 10*time.Minute-time.Second)
+				So(res.Total.UsageSeconds, ShouldEqual, 1200.0)
+			})
+
+			Convey("then the usage is proportional to the entitlement.", func() {
+				for _, a := range res.Accounts {
+					So(a.Share, ShouldEqual, 0.5)
+					So(a.Entitlement, ShouldEqual, 0.5)
+				}
+				So(res.Fairness, ShouldEqual, 1.0)
+			})
+
+			Convey("then the given config is not modified.", func() {
+				So(config.AccountConfigs, ShouldHaveLength, 2)
+			})
+		})
+
+		Convey("when a task never matches a worker", func() {
+			tr := &Trace{
+				Arrivals: []*Arrival{
+					{Time: t0, RequestID: "r1", AccountID: "a1", Duration: time.Minute},
+					{Time: t0, RequestID: "r2", AccountID: "a1", BaseLabels: []string{"missing"}, Duration: time.Minute},
+				},
+				Idles: []*Idle{
+					{Time: t0, WorkerID: "w1"},
+				},
+			}
+			res, err := Simulate(ctx, tr, Options{Config: config, Drain: 10 * time.Minute})
+			So(err, ShouldBeNil)
+
+			Convey("then it is reported unassigned after draining.", func() {
+				So(res.End, ShouldEqual, t0.Add(10*time.Minute))
+				So(res.Accounts[0].Tasks, ShouldEqual, 2)
+				So(res.Accounts[0].Assigned, ShouldEqual, 1)
+				So(res.Accounts[0].Unassigned, ShouldEqual, 1)
+				So(res.Accounts[1].Tasks, ShouldEqual, 0)
+			})
+		})
+
+		Convey("when the trace has no arrivals", func() {
+			_, err := Simulate(ctx, &Trace{}, Options{Config: config})
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
+
+func TestTraceFromEvents(t *testing.T) {
+	Convey("TraceFromEvents", t, func() {
+		t0 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		event := func(typ metrics.TaskEvent_EventType, task string, d time.Duration) *metrics.TaskEvent {
+			return &metrics.TaskEvent{
+				EventType: typ,
+				TaskId:    task,
+				AccountId: "a1",
+				Time:      tutils.TimestampProto(t0.Add(d)),
+			}
+		}
+		enqueued := event(metrics.TaskEvent_SWARMING_ENQUEUED, "r1", 0)
+		enqueued.BaseLabels = []string{"pool:foo"}
+		assigned := event(metrics.TaskEvent_QSCHEDULER_ASSIGNED, "r1", time.Minute)
+		assigned.BotId = "w1"
+		assigned.BotDimensions = []string{"pool:foo", "os:linux"}
+		completed := event(metrics.TaskEvent_SWARMING_COMPLETED, "r1", 6*time.Minute)
+		completed.BotId = "w1"
+		events := []*metrics.TaskEvent{
+			enqueued,
+			assigned,
+			completed,
+			event(metrics.TaskEvent_SWARMING_ENQUEUED, "r2", 2*time.Minute),
+		}
+
+		tr := TraceFromEvents(events, time.Hour)
+		So(tr.Arrivals, ShouldResemble, []*Arrival{
+			{
+				Time:       t0,
+				RequestID:  "r1",
+				AccountID:  "a1",
+				BaseLabels: []string{"pool:foo"},
+				Duration:   5 * time.Minute,
+			},
+			{
+				Time:      t0.Add(2 * time.Minute),
+				RequestID: "r2",
+				AccountID: "a1",
+				Duration:  time.Hour,
+			},
+		})
+		So(tr.Idles, ShouldResemble, []*Idle{
+			{
+				Time:     t0.Add(time.Minute),
+				WorkerID: "w1",
+				Labels:   []string{"pool:foo", "os:linux"},
+			},
+		})
+	})
+
+	Convey("ReadEvents", t, func() {
+		events, err := ReadEvents(strings.NewReader(
+			`{"eventType": "SWARMING_ENQUEUED", "taskId": "r1", "unknownField": 1}` + "\n\n" +
+				`{"eventType": "SWARMING_COMPLETED", "taskId": "r1"}` + "\n"))
+		So(err, ShouldBeNil)
+		So(events, ShouldHaveLength, 2)
+		So(events[0].TaskId, ShouldEqual, "r1")
+		So(events[1].EventType, ShouldEqual, metrics.TaskEvent_SWARMING_COMPLETED)
+
+		_, err = ReadEvents(strings.NewReader("not json\n"))
+		So(err, ShouldNotBeNil)
+	})
+}
@@ -0,0 +1,159 @@
+// Copyright 2024 The LUCI Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package simulator replays recorded task arrivals and worker idles against
+// a quotascheduler configuration, to evaluate configuration changes before
+// deploying them.
+package simulator
+
+import (
+	"bufio"
+	"io"
+	"sort"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"go.chromium.org/luci/common/errors"
+
+	"infra/qscheduler/qslib/protos/metrics"
+	"infra/qscheduler/qslib/scheduler"
+	"infra/qscheduler/qslib/tutils"
+)
+
+// Arrival is a task request arriving at the scheduler.
+type Arrival struct {
+	Time                time.Time
+	RequestID           scheduler.RequestID
+	AccountID           scheduler.AccountID
+	ProvisionableLabels []string
+	BaseLabels          []string
+
+	// Duration is how long the task runs once it is assigned to a worker.
+	Duration time.Duration
+}
+
+// Idle is a worker becoming available to the scheduler.
+//
+// Workers stay in the pool until the end of the simulation once they become
+// available.
+type Idle struct {
+	Time     time.Time
+	WorkerID scheduler.WorkerID
+	Labels   []string
+}
+
+// Trace is a recorded stream of task arrivals and worker idles.
+type Trace struct {
+	Arrivals []*Arrival
+	Idles    []*Idle
+}
+
+// sort sorts the arrivals and idles by time.
+func (tr *Trace) sort() {
+	sort.SliceStable(tr.Arrivals, func(i, j int) bool {
+		return tr.Arrivals[i].Time.Before(tr.Arrivals[j].Time)
+	})
+	sort.SliceStable(tr.Idles, func(i, j int) bool {
+		return tr.Idles[i].Time.Before(tr.Idles[j].Time)
+	})
+}
+
+// ReadEvents reads task events in JSON lines format, i.e. one JSON encoded
+// metrics.TaskEvent per line, as exported from the scheduler event log.
+func ReadEvents(r io.Reader) ([]*metrics.TaskEvent, error) {
+	var events []*metrics.TaskEvent
+	s := bufio.NewScanner(r)
+	s.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	line := 0
+	for s.Scan() {
+		line++
+		if len(s.Bytes()) == 0 {
+			continue
+		}
+		e := &metrics.TaskEvent{}
+		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(s.Bytes(), e); err != nil {
+			return nil, errors.Annotate(err, "line %d", line).Err()
+		}
+		events = append(events, e)
+	}
+	return events, s.Err()
+}
+
+// TraceFromEvents builds a trace from recorded task events.
+//
+// A task arrives when it is enqueued, and runs for the duration between its
+// last assignment and its completion in the recording, or defaultDuration if
+// either of them is not recorded.
+// A worker becomes available when it is first seen in the events.
+func TraceFromEvents(events []*metrics.TaskEvent, defaultDuration time.Duration) *Trace {
+	tr := &Trace{}
+	arrivals := make(map[string]*Arrival)
+	assigned := make(map[string]time.Time)
+	completed := make(map[string]time.Time)
+	idles := make(map[string]*Idle)
+	for _, e := range events {
+		t := tutils.Timestamp(e.Time)
+		if id := e.GetBotId(); id != "" {
+			if idle, ok := idles[id]; !ok || t.Before(idle.Time) {
+				idles[id] = &Idle{
+					Time:     t,
+					WorkerID: scheduler.WorkerID(id),
+					Labels:   e.BotDimensions,
+				}
+			}
+		}
+		switch e.EventType {
+		case metrics.TaskEvent_SWARMING_ENQUEUED:
+			if _, ok := arrivals[e.TaskId]; ok {
+				continue
+			}
+			arrivals[e.TaskId] = &Arrival{
+				Time:                t,
+				RequestID:           scheduler.RequestID(e.TaskId),
+				AccountID:           scheduler.AccountID(e.AccountId),
+				ProvisionableLabels: e.ProvisionableLabels,
+				BaseLabels:          e.BaseLabels,
+			}
+		case metrics.TaskEvent_QSCHEDULER_ASSIGNED:
+			if t.After(assigned[e.TaskId]) {
+				assigned[e.TaskId] = t
+			}
+		case metrics.TaskEvent_SWARMING_COMPLETED:
+			completed[e.TaskId] = t
+		}
+	}
+
+	for id, a := range arrivals {
+		a.Duration = defaultDuration
+		start, ok1 := assigned[id]
+		end, ok2 := completed[id]
+		if ok1 && ok2 && end.After(start) {
+			a.Duration = end.Sub(start)
+		}
+		tr.Arrivals = append(tr.Arrivals, a)
+	}
+	for _, idle := range idles {
+		tr.Idles = append(tr.Idles, idle)
+	}
+	// Break ties deterministically, before sorting by time.
+	sort.Slice(tr.Arrivals, func(i, j int) bool {
+		return tr.Arrivals[i].RequestID < tr.Arrivals[j].RequestID
+	})
+	sort.Slice(tr.Idles, func(i, j int) bool {
+		return tr.Idles[i].WorkerID < tr.Idles[j].WorkerID
+	})
+	tr.sort()
+	return tr
+}
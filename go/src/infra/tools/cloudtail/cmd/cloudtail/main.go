@@ -11,9 +11,12 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"os/exec"
 	"os/signal"
 	"runtime"
 	"strings"
+	"sync/atomic"
+	"syscall"
 	"time"
 
 	"cloud.google.com/go/compute/metadata"
@@ -24,6 +27,7 @@ import (
 	"go.chromium.org/luci/client/versioncli"
 	"go.chromium.org/luci/common/cli"
 	"go.chromium.org/luci/common/clock"
+	"go.chromium.org/luci/common/flag/stringlistflag"
 	"go.chromium.org/luci/common/logging"
 	"go.chromium.org/luci/common/logging/gologger"
 	"go.chromium.org/luci/common/tsmon"
@@ -529,6 +533,150 @@ func (c *tailRun) Run(a subcommands.Application, args []string, env subcommands.
 	return service.Run(s)
 }
 
+////////////////////////////////////////////////////////////////////////////////
+// 'journal' subcommand: sends each systemd journal record as a log entry.
+
+func cmdJournal(defaultAuthOpts auth.Options) *subcommands.Command {
+	return &subcommands.Command{
+		UsageLine: "journal [options] [-unit UNIT]...",
+		ShortDesc: "sends each systemd journal record as a log entry",
+		LongDesc: "Runs 'journalctl -o export --follow' and sends each journal record as a log entry. " +
+			"With -stdin, reads records in the journal export format from stdin instead.",
+		CommandRun: func() subcommands.CommandRun {
+			c := &journalRun{}
+			c.commonOptions.registerFlags(&c.Flags, defaultAuthOpts, true)
+			c.Flags.Var(&c.units, "unit", "Systemd unit to send the records of, can be repeated. Default is all units")
+			c.Flags.StringVar(&c.cursorFile, "cursor-file", "",
+				"If set, journalctl keeps the position in the journal in this file, to resume from it after a restart")
+			c.Flags.StringVar(&c.journalctl, "journalctl", "journalctl", "Path to the journalctl binary")
+			c.Flags.BoolVar(&c.stdin, "stdin", false, "Read journal records from stdin instead of running journalctl")
+			return c
+		},
+	}
+}
+
+type journalRun struct {
+	subcommands.CommandRunBase
+	commonOptions
+
+	units      stringlistflag.Flag
+	cursorFile string
+	journalctl string
+	stdin      bool
+}
+
+// journalctlArgs returns the arguments of journalctl.
+func (c *journalRun) journalctlArgs() []string {
+	args := []string{"--output=export", "--follow"}
+	if c.cursorFile != "" {
+		args = append(args, "--cursor-file="+c.cursorFile)
+	} else {
+		// Only send the records written from now on.
+		args = append(args, "--lines=0")
+	}
+	for _, u := range c.units {
+		args = append(args, "--unit="+u)
+	}
+	return args
+}
+
+func (c *journalRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
+	if len(args) != 0 {
+		fmt.Fprintf(os.Stderr, "Cloudtail journal doesn't accept positional command line arguments %q\n", args)
+		return 1
+	}
+	if c.stdin && (len(c.units) != 0 || c.cursorFile != "") {
+		fmt.Fprintln(os.Stderr, "-unit and -cursor-file can't be used with -stdin")
+		return 1
+	}
+
+	ctx, state, err := c.commonOptions.processFlags(cli.GetContext(a, c, env))
+	defer state.cleanup()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	var input io.Reader
+	var journalctl *exec.Cmd
+	// stopped is set when journalctl is stopped on SIGINT, so the record it
+	// was writing may be truncated.
+	var stopped atomic.Bool
+	if c.stdin {
+		// See 'pipe' subcommand for why stdin is wrapped in io.Pipe.
+		pipeR, pipeW := io.Pipe()
+		go func() {
+			defer pipeW.Close()
+			io.Copy(pipeW, os.Stdin)
+		}()
+		catchCtrlC(pipeW.Close)
+		input = pipeR
+	} else {
+		journalctl = exec.Command(c.journalctl, c.journalctlArgs()...)
+		journalctl.Stderr = os.Stderr
+		stdout, err := journalctl.StdoutPipe()
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+		if err := journalctl.Start(); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+		catchCtrlC(func() error {
+			stopped.Store(true)
+			return journalctl.Process.Signal(syscall.SIGTERM)
+		})
+		input = stdout
+	}
+	if c.teeToStdout {
+		input = io.TeeReader(input, os.Stdout)
+	}
+
+	journalReader := cloudtail.JournalReader{
+		Source:     input,
+		PushBuffer: state.buffer,
+	}
+
+	// On EOF (which also happens on SIGINT) start a countdown that will abort
+	// the context and unblock everything even if some data wasn't sent.
+	ctx, abort := context.WithCancel(ctx)
+	journalReader.OnEOF = func() {
+		logging.Debugf(ctx, "EOF detected, aborting in %s", c.flushTimeout)
+		go func() {
+			time.Sleep(c.flushTimeout)
+			abort()
+		}()
+	}
+
+	state.buffer.Start(ctx)
+
+	err1 := journalReader.Run(ctx)
+	if journalctl != nil {
+		if err1 != nil {
+			// journalctl doesn't stop on its own, it follows the journal.
+			journalctl.Process.Kill()
+			journalctl.Wait()
+		} else if err := journalctl.Wait(); err != nil {
+			err1 = fmt.Errorf("journalctl: %s", err)
+		}
+	}
+	if err1 != nil && !stopped.Load() {
+		fmt.Fprintln(os.Stderr, err1)
+	} else {
+		err1 = nil
+	}
+	err2 := state.buffer.Stop(ctx)
+	if err2 != nil {
+		fmt.Fprintln(os.Stderr, err2)
+	}
+
+	if err1 != nil || err2 != nil {
+		return 1
+	}
+	return 0
+}
+
 ////////////////////////////////////////////////////////////////////////////////
 
 func getApplication(defaultAuthOpts auth.Options) *cli.Application {
@@ -553,6 +701,7 @@ func getApplication(defaultAuthOpts auth.Options) *cli.Application {
 			cmdSend(defaultAuthOpts),
 			cmdPipe(defaultAuthOpts),
 			cmdTail(defaultAuthOpts),
+			cmdJournal(defaultAuthOpts),
 
 			// Authentication related commands.
 			authcli.SubcommandInfo(defaultAuthOpts, "whoami", false),
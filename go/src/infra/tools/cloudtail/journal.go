@@ -0,0 +1,176 @@
+// Copyright 2024 The Chromium Authors
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package cloudtail
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxJournalFieldSize limits the size of a binary field of a journal record.
+const maxJournalFieldSize = 16 * 1024 * 1024
+
+// journalSeverities maps syslog priorities (the PRIORITY field) to severities.
+var journalSeverities = []Severity{
+	Emergency,
+	Alert,
+	Critical,
+	Error,
+	Warning,
+	Notice,
+	Info,
+	Debug,
+}
+
+// journalLabels maps journal fields to the labels of the log entry.
+var journalLabels = map[string]string{
+	"_SYSTEMD_UNIT":     "unit",
+	"SYSLOG_IDENTIFIER": "syslogIdentifier",
+	"_PID":              "processID",
+	"_HOSTNAME":         "hostname",
+}
+
+// JournalReader reads systemd journal records in the export format and
+// pushes them to the buffer.
+//
+// The export format is written by `journalctl -o export`, see
+// https://systemd.io/JOURNAL_EXPORT_FORMATS/.
+type JournalReader struct {
+	// Source is a reader to read journal records from.
+	Source io.Reader
+
+	// PushBuffer knows how to forward log entries to the client.
+	PushBuffer PushBuffer
+
+	// OnEOF is called immediately when EOF (or reading error) is encountered.
+	OnEOF func()
+}
+
+// Run reads records from the reader until EOF or until the context is closed.
+//
+// Returns error only if reading from io.Reader fails or the data is not in the
+// export format. On EOF or on context cancellation returns nil.
+func (r *JournalReader) Run(ctx context.Context) error {
+	if r.OnEOF != nil {
+		defer r.OnEOF()
+	}
+	src := bufio.NewReader(r.Source)
+	for ctx.Err() == nil {
+		record, err := readJournalRecord(src)
+		if entry := journalRecordToEntry(record); entry != nil {
+			r.PushBuffer.Send(ctx, *entry)
+		}
+		switch {
+		case err == io.EOF:
+			return nil
+		case err != nil:
+			return err
+		}
+	}
+	return nil
+}
+
+// readJournalRecord reads fields until the empty line ending the record.
+//
+// Returns the fields read so far and io.EOF at the end of the data.
+func readJournalRecord(r *bufio.Reader) (map[string]string, error) {
+	record := map[string]string{}
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			if err == io.EOF && line != "" {
+				err = fmt.Errorf("journal record: truncated field %q", line)
+			}
+			return record, err
+		}
+		line = strings.TrimSuffix(line, "\n")
+		if line == "" {
+			if len(record) == 0 {
+				continue
+			}
+			return record, nil
+		}
+		if i := strings.IndexByte(line, '='); i >= 0 {
+			record[line[:i]] = line[i+1:]
+			continue
+		}
+		// A field with binary data: the name is followed by the size of the
+		// data as a little-endian uint64, the data and a newline.
+		value, err := readJournalBinaryField(r)
+		if err != nil {
+			return record, fmt.Errorf("journal record: field %q: %s", line, err)
+		}
+		record[line] = value
+	}
+}
+
+// readJournalBinaryField reads the size, the data and the trailing newline of
+// a binary field.
+func readJournalBinaryField(r *bufio.Reader) (string, error) {
+	var size uint64
+	if err := binary.Read(r, binary.LittleEndian, &size); err != nil {
+		return "", err
+	}
+	if size > maxJournalFieldSize {
+		return "", fmt.Errorf("size %d is over the limit of %d", size, maxJournalFieldSize)
+	}
+	data := make([]byte, size+1)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return "", err
+	}
+	if data[size] != '\n' {
+		return "", fmt.Errorf("missing newline after data")
+	}
+	return string(data[:size]), nil
+}
+
+// journalRecordToEntry converts a journal record to a log entry.
+//
+// Returns nil if the record has no message.
+func journalRecordToEntry(record map[string]string) *Entry {
+	message, ok := record["MESSAGE"]
+	if !ok {
+		return nil
+	}
+	entry := &Entry{
+		Timestamp:   time.Now(),
+		Severity:    Default,
+		TextPayload: message,
+	}
+	// The source timestamp is when the message was logged, the other one is
+	// when the journal received it.
+	for _, k := range []string{"_SOURCE_REALTIME_TIMESTAMP", "__REALTIME_TIMESTAMP"} {
+		if usec, err := strconv.ParseInt(record[k], 10, 64); err == nil {
+			entry.Timestamp = time.UnixMicro(usec)
+			break
+		}
+	}
+	if p, err := strconv.Atoi(record["PRIORITY"]); err == nil && p >= 0 && p < len(journalSeverities) {
+		entry.Severity = journalSeverities[p]
+	}
+	for field, label := range journalLabels {
+		if v, ok := record[field]; ok {
+			if entry.Labels == nil {
+				entry.Labels = map[string]string{}
+			}
+			entry.Labels[label] = v
+		}
+	}
+	if cursor := record["__CURSOR"]; cursor != "" {
+		// The cursor identifies the record in the journal, so reading it again
+		// (e.g. after a restart) produces the same insert ID.
+		sum := sha1.Sum([]byte(cursor))
+		entry.InsertID = fmt.Sprintf("%d:%s", entry.Timestamp.UnixNano(), base64.StdEncoding.EncodeToString(sum[:12]))
+	}
+	return entry
+}
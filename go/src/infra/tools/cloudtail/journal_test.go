@@ -0,0 +1,111 @@
+// Copyright 2024 The Chromium Authors
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package cloudtail
+
+import (
+	"bytes"
+	"encoding/binary"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// journalExport builds journal records in the export format. Values with a
+// newline are written as binary fields, as journalctl does.
+func journalExport(records ...[][2]string) []byte {
+	var b bytes.Buffer
+	for _, r := range records {
+		for _, f := range r {
+			if bytes.ContainsRune([]byte(f[1]), '\n') {
+				b.WriteString(f[0] + "\n")
+				binary.Write(&b, binary.LittleEndian, uint64(len(f[1])))
+				b.WriteString(f[1] + "\n")
+			} else {
+				b.WriteString(f[0] + "=" + f[1] + "\n")
+			}
+		}
+		b.WriteString("\n")
+	}
+	return b.Bytes()
+}
+
+func TestJournalReader(t *testing.T) {
+	ctx := testContext()
+	data := journalExport(
+		[][2]string{
+			{"__CURSOR", "s=1;i=1"},
+			{"__REALTIME_TIMESTAMP", "1616120058237000"},
+			{"PRIORITY", "3"},
+			{"_SYSTEMD_UNIT", "foo.service"},
+			{"_PID", "42"},
+			{"MESSAGE", "first\nsecond"},
+		},
+		[][2]string{
+			// No message, skipped.
+			{"__CURSOR", "s=1;i=2"},
+		},
+		[][2]string{
+			{"__CURSOR", "s=1;i=3"},
+			{"__REALTIME_TIMESTAMP", "1616120059000000"},
+			{"_SOURCE_REALTIME_TIMESTAMP", "1616120058999000"},
+			{"SYSLOG_IDENTIFIER", "foo"},
+			{"MESSAGE", "third"},
+		},
+	)
+	buf := &blockingPushBuffer{}
+	r := JournalReader{
+		Source:     bytes.NewReader(data),
+		PushBuffer: buf,
+	}
+	if err := r.Run(ctx); err != nil {
+		t.Fatalf("Run() -> %s, want success", err)
+	}
+	entries := buf.getEntries()
+	if len(entries) != 2 {
+		t.Fatalf("Run() sent %d entries, want 2", len(entries))
+	}
+
+	first := entries[0]
+	if want := time.UnixMicro(1616120058237000); !first.Timestamp.Equal(want) {
+		t.Errorf("first entry: Timestamp -> %v, want %v", first.Timestamp, want)
+	}
+	if first.Severity != Error {
+		t.Errorf("first entry: Severity -> %v, want %v", first.Severity, Error)
+	}
+	if first.TextPayload != "first\nsecond" {
+		t.Errorf("first entry: TextPayload -> %q, want %q", first.TextPayload, "first\nsecond")
+	}
+	if want := map[string]string{"unit": "foo.service", "processID": "42"}; !reflect.DeepEqual(first.Labels, want) {
+		t.Errorf("first entry: Labels -> %v, want %v", first.Labels, want)
+	}
+
+	second := entries[1]
+	if want := time.UnixMicro(1616120058999000); !second.Timestamp.Equal(want) {
+		t.Errorf("second entry: Timestamp -> %v, want %v", second.Timestamp, want)
+	}
+	if second.Severity != Default {
+		t.Errorf("second entry: Severity -> %v, want %v", second.Severity, Default)
+	}
+	if first.InsertID == "" || first.InsertID == second.InsertID {
+		t.Errorf("InsertIDs %q and %q, want distinct and non-empty", first.InsertID, second.InsertID)
+	}
+}
+
+func TestJournalReaderTruncated(t *testing.T) {
+	ctx := testContext()
+	data := journalExport([][2]string{{"MESSAGE", "a\nb"}})
+	buf := &blockingPushBuffer{}
+	r := JournalReader{
+		// Cut in the middle of the binary data.
+		Source:     bytes.NewReader(data[:len(data)-4]),
+		PushBuffer: buf,
+	}
+	if err := r.Run(ctx); err == nil {
+		t.Errorf("Run() -> nil, want error")
+	}
+	if entries := buf.getEntries(); len(entries) != 0 {
+		t.Errorf("Run() sent %v, want no entries", entries)
+	}
+}
@@ -5,9 +5,11 @@
 package cloudtail
 
 import (
+	"encoding/json"
 	"fmt"
 	"regexp"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -48,6 +50,7 @@ func (c LogParserChain) MergeLogLine(line string, e *Entry) bool {
 // StdParser returns a parser that recognizes common types of logs.
 func StdParser() LogParser {
 	return LogParserChain{
+		&jsonLogsParser{},
 		&infraLogsParser{},
 		&twistedLogsParser{},
 		&puppetLogsParser{},
@@ -424,6 +427,129 @@ func (p *lucipyLogsParser) MergeLogLine(line string, e *Entry) bool {
 
 ////////////////////////////////////////////////////////////////////////////////
 
+var (
+	// Keys used by structured loggers (zap, logrus, slog) for the common fields.
+	jsonLogLevelKeys   = []string{"level", "severity", "lvl"}
+	jsonLogTimeKeys    = []string{"time", "ts", "timestamp"}
+	jsonLogMessageKeys = []string{"msg", "message"}
+
+	jsonLogSeverities = map[string]Severity{
+		"trace":     Debug,
+		"debug":     Debug,
+		"info":      Info,
+		"notice":    Notice,
+		"warn":      Warning,
+		"warning":   Warning,
+		"err":       Error,
+		"error":     Error,
+		"crit":      Critical,
+		"critical":  Critical,
+		"dpanic":    Critical,
+		"panic":     Critical,
+		"fatal":     Critical,
+		"alert":     Alert,
+		"emerg":     Emergency,
+		"emergency": Emergency,
+	}
+)
+
+// jsonLogsParser parses lines with a single JSON object, as written by
+// structured loggers. The message becomes the text payload and all other
+// fields, except the level and the time, become labels.
+type jsonLogsParser struct{}
+
+func (p *jsonLogsParser) ParseLogLine(line string) *Entry {
+	if !strings.HasPrefix(line, "{") {
+		return nil
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal([]byte(line), &fields); err != nil {
+		return nil
+	}
+	message, ok := popJSONLogField(fields, jsonLogMessageKeys).(string)
+	if !ok {
+		return nil
+	}
+	entry := &Entry{
+		Timestamp:   time.Now(),
+		Severity:    Default,
+		TextPayload: message,
+		ParsedBy:    p,
+	}
+	if level, ok := popJSONLogField(fields, jsonLogLevelKeys).(string); ok {
+		entry.Severity = jsonLogSeverity(level)
+	}
+	if ts, ok := jsonLogTimestamp(popJSONLogField(fields, jsonLogTimeKeys)); ok {
+		entry.Timestamp = ts
+	}
+	if len(fields) > 0 {
+		entry.Labels = make(map[string]string, len(fields))
+		for k, v := range fields {
+			entry.Labels[k] = jsonLogLabel(v)
+		}
+	}
+	return entry
+}
+
+func (p *jsonLogsParser) MergeLogLine(line string, e *Entry) bool {
+	return false
+}
+
+// popJSONLogField removes the first of the keys present in the fields and
+// returns its value, or nil if none of the keys is present.
+func popJSONLogField(fields map[string]interface{}, keys []string) interface{} {
+	for _, k := range keys {
+		if v, ok := fields[k]; ok {
+			delete(fields, k)
+			return v
+		}
+	}
+	return nil
+}
+
+// jsonLogSeverity converts a level name to a severity. slog levels between
+// the named ones, e.g. "INFO+2", get the severity of the named level.
+func jsonLogSeverity(level string) Severity {
+	level = strings.ToLower(strings.TrimSpace(level))
+	if i := strings.IndexAny(level, "+-"); i > 0 {
+		level = level[:i]
+	}
+	if sev, ok := jsonLogSeverities[level]; ok {
+		return sev
+	}
+	return Default
+}
+
+// jsonLogTimestamp converts an RFC 3339 string or a number of seconds since
+// the epoch (zap's default) to a time.
+func jsonLogTimestamp(v interface{}) (time.Time, bool) {
+	switch ts := v.(type) {
+	case string:
+		t, err := time.Parse(time.RFC3339Nano, ts)
+		return t, err == nil
+	case float64:
+		sec := int64(ts)
+		nsec := int64((ts - float64(sec)) * 1e9)
+		return time.Unix(sec, nsec), true
+	}
+	return time.Time{}, false
+}
+
+// jsonLogLabel converts a field value to a label value. Strings are kept as
+// is, other values are JSON encoded.
+func jsonLogLabel(v interface{}) string {
+	if s, ok := v.(string); ok {
+		return s
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return string(b)
+}
+
+////////////////////////////////////////////////////////////////////////////////
+
 type nullParser struct{}
 
 func (p *nullParser) ParseLogLine(line string) *Entry { return lineToEntry(line) }
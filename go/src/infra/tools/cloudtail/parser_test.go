@@ -233,6 +233,70 @@ func TestLucipyLogsParser(t *testing.T) {
 	})
 }
 
+func TestJSONLogsParser(t *testing.T) {
+	testTextLogsParser(t, &jsonLogsParser{}, []textTestCase{
+		{
+			line:        "not a valid log line",
+			wantSuccess: false,
+		},
+		{
+			line:        `{"level": "info", "count": 3}`,
+			wantSuccess: false,
+		},
+		{
+			line:        `{"msg": "truncated`,
+			wantSuccess: false,
+		},
+		{
+			// logrus
+			line:          `{"level":"warning","msg":"Disk is almost full","time":"2021-03-19T02:14:18.237Z","disk":"/dev/sda1"}`,
+			wantSuccess:   true,
+			wantTimestamp: "2021-03-19T02:14:18.237000+00:00",
+			wantSeverity:  Warning,
+			wantPayload:   "Disk is almost full",
+			wantLabels: map[string]string{
+				"disk": "/dev/sda1",
+			},
+		},
+		{
+			// slog
+			line:          `{"time":"2021-03-19T02:14:18.237-07:00","level":"ERROR+2","msg":"Request failed","attempt":2,"req":{"id":"a1"}}`,
+			wantSuccess:   true,
+			wantTimestamp: "2021-03-19T02:14:18.237000-07:00",
+			wantSeverity:  Error,
+			wantPayload:   "Request failed",
+			wantLabels: map[string]string{
+				"attempt": "2",
+				"req":     `{"id":"a1"}`,
+			},
+		},
+		{
+			line:          `{"severity":"bogus","message":"Hello World","timestamp":"2021-03-19T02:14:18Z"}`,
+			wantSuccess:   true,
+			wantTimestamp: "2021-03-19T02:14:18.000000+00:00",
+			wantSeverity:  Default,
+			wantPayload:   "Hello World",
+		},
+	})
+}
+
+func TestJSONLogsParserEpochTime(t *testing.T) {
+	// zap writes the time as seconds since the epoch.
+	got := (&jsonLogsParser{}).ParseLogLine(`{"level":"dpanic","ts":1616120058.5,"caller":"main.go:12","msg":"Oops"}`)
+	if got == nil {
+		t.Fatalf("ParseLogLine() -> nil, want success")
+	}
+	if want := time.Unix(1616120058, 500000000); !got.Timestamp.Equal(want) {
+		t.Errorf("ParseLogLine().Timestamp -> %v, want %v", got.Timestamp, want)
+	}
+	if got.Severity != Critical {
+		t.Errorf("ParseLogLine().Severity -> %v, want %v", got.Severity, Critical)
+	}
+	if want := map[string]string{"caller": "main.go:12"}; !reflect.DeepEqual(got.Labels, want) {
+		t.Errorf("ParseLogLine().Labels -> %v, want %v", got.Labels, want)
+	}
+}
+
 type callbackParser struct {
 	cb      func(line string) *Entry
 	mergeCb func(line string, e *Entry) bool
@@ -50,6 +50,8 @@ func application(p Params) *cli.Application {
 			cmdMigrateMonorail(),
 			cmdLocationTags(),
 			cmdParse(),
+			cmdCoverage(),
+			cmdResolve(),
 
 			{},
 			authcli.SubcommandLogin(p.Auth, "auth-login", false),
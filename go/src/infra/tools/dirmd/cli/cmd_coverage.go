@@ -0,0 +1,144 @@
+// Copyright 2024 The Chromium Authors
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/maruel/subcommands"
+
+	"go.chromium.org/luci/common/cli"
+	"go.chromium.org/luci/common/data/text"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/system/signals"
+
+	"infra/tools/dirmd"
+	dirmdpb "infra/tools/dirmd/proto"
+)
+
+func cmdCoverage() *subcommands.Command {
+	return &subcommands.Command{
+		UsageLine: `coverage [DIR1 [DIR2]...]`,
+		ShortDesc: "report directories without owning team or component",
+		LongDesc: text.Doc(`
+			Report directories without owning team or component.
+
+			Reads metadata of the specified directories and their descendants in the
+			full form, i.e. with inherited metadata and mixins applied, and reports
+			directories missing any of the required fields.
+			Directories are weighted by the number of files, the number of changes
+			to the files, or equally. See -weight.
+
+			The output format is JSON with the total and orphaned weights, the ratio
+			of the owned weight, and the orphaned directories ordered by weight.
+
+			The subcommand returns a non-zero exit code if the ratio is below
+			-min-ratio, so that it can be used in PRESUBMIT.
+		`),
+		CommandRun: func() subcommands.CommandRun {
+			r := &coverageRun{}
+			r.RegisterBaseFlags()
+			r.Flags.StringVar(&r.weighting, "weight", string(dirmd.WeightingFiles), text.Doc(`
+				How to weight directories.
+				Valid values: "files" (number of files in git), "churn" (number of
+				changes to the files in git log), "dirs" (each directory equally).
+			`))
+			r.Flags.DurationVar(&r.since, "since", 90*24*time.Hour, text.Doc(`
+				With -weight churn, count only changes in this duration.
+				0 means all changes.
+			`))
+			r.Flags.StringVar(&r.fields, "fields", strings.Join(dirmd.DefaultCoverageFields, ","), text.Doc(`
+				Comma-separated fields required for ownership.
+				Valid values: "buganizer", "team_email", "monorail", "os".
+			`))
+			r.Flags.Float64Var(&r.minRatio, "min-ratio", 0, text.Doc(`
+				Fail if the ratio of the owned weight to the total weight is below
+				this value.
+			`))
+			return r
+		},
+	}
+}
+
+type coverageRun struct {
+	baseCommandRun
+	weighting string
+	since     time.Duration
+	fields    string
+	minRatio  float64
+}
+
+func (r *coverageRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
+	ctx := cli.GetContext(a, r, env)
+	return r.done(ctx, r.run(ctx, args))
+}
+
+func (r *coverageRun) run(ctx context.Context, dirs []string) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	defer signals.HandleInterrupt(cancel)()
+
+	if len(dirs) == 0 {
+		dirs = []string{"."}
+	}
+	for i, d := range dirs {
+		fileInfo, err := os.Stat(d)
+		if err != nil {
+			return errors.Annotate(err, "failed to get info of %q", d).Err()
+		}
+		if !fileInfo.IsDir() {
+			return errors.Reason("%q is not a directory", d).Err()
+		}
+		if dirs[i], err = canonicalFSPath(d); err != nil {
+			return errors.Annotate(err, "failed to canonicalize %q", d).Err()
+		}
+	}
+
+	var fields []string
+	for _, f := range strings.Split(r.fields, ",") {
+		if f = strings.TrimSpace(f); f != "" {
+			fields = append(fields, f)
+		}
+	}
+	if len(fields) == 0 {
+		return errors.Reason("-fields is empty").Err()
+	}
+
+	// ReadMapping and ReadWeights may modify dirs.
+	mapping, err := dirmd.ReadMapping(ctx, dirmdpb.MappingForm_FULL, r.onlyDirmd, append([]string(nil), dirs...)...)
+	if err != nil {
+		return err
+	}
+
+	weighting := dirmd.Weighting(r.weighting)
+	var since time.Time
+	if r.since > 0 {
+		since = time.Now().Add(-r.since)
+	}
+	weights, err := dirmd.ReadWeights(ctx, weighting, since, dirs...)
+	if err != nil {
+		return err
+	}
+
+	coverage, err := dirmd.ComputeCoverage(mapping, fields, weighting, weights)
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(coverage, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := r.writeTextOutput(data); err != nil {
+		return err
+	}
+	if coverage.Ratio < r.minRatio {
+		return errors.Reason("ownership coverage %.4f is below -min-ratio %.4f; %d directories are orphaned", coverage.Ratio, r.minRatio, coverage.OrphanedDirs).Err()
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+// Copyright 2024 The Chromium Authors
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package cli
+
+import (
+	"context"
+
+	"github.com/maruel/subcommands"
+
+	"go.chromium.org/luci/common/cli"
+	"go.chromium.org/luci/common/data/text"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/system/signals"
+
+	"infra/tools/dirmd"
+)
+
+func cmdResolve() *subcommands.Command {
+	return &subcommands.Command{
+		UsageLine: `resolve FILE1 [FILE2]...`,
+		ShortDesc: "resolve effective metadata of the specified files",
+		LongDesc: text.Doc(`
+			Resolve effective metadata of the specified files.
+
+			The effective metadata of a file is the metadata of its directory,
+			including inherited metadata and mixins, with the metadata overrides
+			of the directory matching the file name applied.
+
+			The files do not have to exist, but their directories must reside in a
+			git checkout. See "dirmd read" for the requirements on the repos.
+
+			The output format is JSON form of chrome.dir_metadata.Mapping protobuf
+			message, with the effective metadata in "files".
+		`),
+		CommandRun: func() subcommands.CommandRun {
+			r := &resolveRun{}
+			r.RegisterBaseFlags()
+			return r
+		},
+	}
+}
+
+type resolveRun struct {
+	baseCommandRun
+}
+
+func (r *resolveRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
+	ctx := cli.GetContext(a, r, env)
+	return r.done(ctx, r.run(ctx, args))
+}
+
+func (r *resolveRun) run(ctx context.Context, files []string) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	defer signals.HandleInterrupt(cancel)()
+
+	if len(files) == 0 {
+		return errors.Reason("no files specified").Err()
+	}
+
+	mapping, err := dirmd.ResolveFiles(ctx, r.onlyDirmd, files...)
+	if err != nil {
+		return err
+	}
+	return r.writeMapping(mapping)
+}
@@ -0,0 +1,236 @@
+// Copyright 2024 The Chromium Authors
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package dirmd
+
+import (
+	"bufio"
+	"context"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"go.chromium.org/luci/common/errors"
+
+	dirmdpb "infra/tools/dirmd/proto"
+)
+
+// Weighting is how directories are weighted in the coverage report.
+type Weighting string
+
+const (
+	// WeightingDirs weights each directory equally.
+	WeightingDirs Weighting = "dirs"
+	// WeightingFiles weights each directory by the number of files in git
+	// directly in it.
+	WeightingFiles Weighting = "files"
+	// WeightingChurn weights each directory by the number of changes to
+	// files directly in it, according to git log.
+	WeightingChurn Weighting = "churn"
+)
+
+// coverageFields maps the names of fields required for ownership to
+// functions reporting whether the metadata has the field.
+var coverageFields = map[string]func(md *dirmdpb.Metadata) bool{
+	"team_email": func(md *dirmdpb.Metadata) bool {
+		return md.GetTeamEmail() != ""
+	},
+	"buganizer": func(md *dirmdpb.Metadata) bool {
+		return md.GetBuganizer().GetComponentId() != 0 || md.GetBuganizerPublic().GetComponentId() != 0
+	},
+	"monorail": func(md *dirmdpb.Metadata) bool {
+		return md.GetMonorail().GetComponent() != ""
+	},
+	"os": func(md *dirmdpb.Metadata) bool {
+		return md.GetOs() != dirmdpb.OS_OS_UNSPECIFIED
+	},
+}
+
+// DefaultCoverageFields are the fields required for ownership by default.
+var DefaultCoverageFields = []string{"buganizer", "team_email"}
+
+// Coverage is a report of the ownership coverage of directories.
+type Coverage struct {
+	// Fields are the fields required for ownership.
+	Fields []string `json:"fields"`
+	// Weighting is how directories are weighted.
+	Weighting Weighting `json:"weighting"`
+
+	TotalDirs   int   `json:"total_dirs"`
+	TotalWeight int64 `json:"total_weight"`
+
+	OrphanedDirs   int   `json:"orphaned_dirs"`
+	OrphanedWeight int64 `json:"orphaned_weight"`
+
+	// Ratio is the ratio of the weight of owned directories to the total
+	// weight. It is 1 if the total weight is 0.
+	Ratio float64 `json:"ratio"`
+
+	// Orphaned are directories missing any of the fields, ordered by weight
+	// descending.
+	Orphaned []*OrphanedDir `json:"orphaned"`
+}
+
+// OrphanedDir is a directory missing some of the fields required for
+// ownership.
+type OrphanedDir struct {
+	Dir     string   `json:"dir"`
+	Weight  int64    `json:"weight"`
+	Missing []string `json:"missing"`
+}
+
+// ComputeCoverage computes the ownership coverage of the directories in m.
+//
+// m must be in the computed or full form, i.e. metadata of each directory
+// must include inherited metadata and mixins.
+// The full form is preferred, because it includes directories without
+// metadata files.
+//
+// fields are the names of the fields required for ownership. See
+// coverageFields for the supported names.
+// weights maps directory keys to their weights, e.g. the result of
+// ReadWeights. If weights is nil, each directory has weight 1.
+func ComputeCoverage(m *Mapping, fields []string, weighting Weighting, weights map[string]int64) (*Coverage, error) {
+	for _, f := range fields {
+		if _, ok := coverageFields[f]; !ok {
+			return nil, errors.Reason("unsupported field %q", f).Err()
+		}
+	}
+	ret := &Coverage{
+		Fields:    fields,
+		Weighting: weighting,
+		Orphaned:  []*OrphanedDir{},
+	}
+	for dir, md := range m.Dirs {
+		weight := int64(1)
+		if weights != nil {
+			weight = weights[dir]
+		}
+		ret.TotalDirs++
+		ret.TotalWeight += weight
+
+		var missing []string
+		for _, f := range fields {
+			if !coverageFields[f](md) {
+				missing = append(missing, f)
+			}
+		}
+		if len(missing) == 0 {
+			continue
+		}
+		ret.OrphanedDirs++
+		ret.OrphanedWeight += weight
+		ret.Orphaned = append(ret.Orphaned, &OrphanedDir{
+			Dir:     dir,
+			Weight:  weight,
+			Missing: missing,
+		})
+	}
+	sort.Slice(ret.Orphaned, func(i, j int) bool {
+		if ret.Orphaned[i].Weight != ret.Orphaned[j].Weight {
+			return ret.Orphaned[i].Weight > ret.Orphaned[j].Weight
+		}
+		return ret.Orphaned[i].Dir < ret.Orphaned[j].Dir
+	})
+
+	ret.Ratio = 1
+	if ret.TotalWeight > 0 {
+		ret.Ratio = float64(ret.TotalWeight-ret.OrphanedWeight) / float64(ret.TotalWeight)
+	}
+	return ret, nil
+}
+
+// ReadWeights returns weights of the directories in git in the given
+// directories, keyed the same way as Mapping.Dirs returned by ReadMapping
+// for the same directories.
+//
+// With WeightingChurn, only changes since the given time are counted,
+// unless since is zero. since is ignored with other weightings.
+// With WeightingDirs, it returns nil.
+func ReadWeights(ctx context.Context, weighting Weighting, since time.Time, dirs ...string) (map[string]int64, error) {
+	switch weighting {
+	case WeightingDirs:
+		return nil, nil
+	case WeightingFiles, WeightingChurn:
+	default:
+		return nil, errors.Reason("unsupported weighting %q", weighting).Err()
+	}
+	if len(dirs) == 0 {
+		return nil, nil
+	}
+
+	for i, d := range dirs {
+		var err error
+		if dirs[i], err = filepath.Abs(d); err != nil {
+			return nil, errors.Annotate(err, "%q", d).Err()
+		}
+	}
+	repos, err := dirsByRepoRoot(ctx, dirs)
+	if err != nil {
+		return nil, err
+	}
+	root, err := findMetadataRoot(repos)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := map[string]int64{}
+	for _, repo := range repos {
+		keyPrefixNative, err := filepath.Rel(root, repo.absRoot)
+		if err != nil {
+			return nil, err
+		}
+		keyPrefix := filepath.ToSlash(keyPrefixNative)
+
+		for _, dir := range removeRedundantDirs(repo.dirs...) {
+			args := []string{"-C", repo.absRoot}
+			if weighting == WeightingFiles {
+				args = append(args, "ls-files", "--full-name", "--", dir)
+			} else {
+				args = append(args, "log", "--format=", "--name-only")
+				if !since.IsZero() {
+					args = append(args, "--since="+since.Format(time.RFC3339))
+				}
+				args = append(args, "--", dir)
+			}
+			if err := countGitFiles(ctx, args, keyPrefix, ret); err != nil {
+				return nil, errors.Annotate(err, "failed to process %q", dir).Err()
+			}
+		}
+	}
+	return ret, nil
+}
+
+// countGitFiles runs git with args, which prints slash-separated file names
+// relative to the repo root, and increments counts of the directories
+// of the files.
+func countGitFiles(ctx context.Context, args []string, keyPrefix string, counts map[string]int64) error {
+	cmd := exec.CommandContext(ctx, gitBinary, args...)
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	if err := cmd.Start(); err != nil {
+		return errors.Annotate(err, "failed to start %q", cmd.Args).Err()
+	}
+
+	scan := bufio.NewScanner(stdout)
+	for scan.Scan() {
+		relFileName := scan.Text()
+		if relFileName == "" {
+			continue
+		}
+		counts[path.Join(keyPrefix, path.Dir(relFileName))]++
+	}
+	if err := scan.Err(); err != nil {
+		cmd.Wait()
+		return err
+	}
+	if err := cmd.Wait(); err != nil {
+		return errors.Annotate(err, "failed to call %q", cmd.Args).Err()
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+// Copyright 2024 The Chromium Authors
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package dirmd
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	. "go.chromium.org/luci/common/testing/assertions"
+
+	dirmdpb "infra/tools/dirmd/proto"
+)
+
+func TestCoverage(t *testing.T) {
+	t.Parallel()
+
+	rootKey := "go/src/infra/tools/dirmd/testdata/root"
+
+	Convey(`ReadWeights`, t, func() {
+		ctx := context.Background()
+
+		Convey(`Files`, func() {
+			weights, err := ReadWeights(ctx, WeightingFiles, time.Time{}, "testdata/root")
+			So(err, ShouldBeNil)
+			So(weights, ShouldResemble, map[string]int64{
+				rootKey:                        1,
+				rootKey + "/subdir":            1,
+				rootKey + "/subdir_with_files": 3,
+				rootKey + "/subdir_with_files/nested_dir":    1,
+				rootKey + "/subdir_with_owners":              1,
+				rootKey + "/subdir_with_owners/empty_subdir": 1,
+			})
+		})
+
+		Convey(`Dirs`, func() {
+			weights, err := ReadWeights(ctx, WeightingDirs, time.Time{}, "testdata/root")
+			So(err, ShouldBeNil)
+			So(weights, ShouldBeNil)
+		})
+
+		Convey(`Unsupported`, func() {
+			_, err := ReadWeights(ctx, "lines", time.Time{}, "testdata/root")
+			So(err, ShouldErrLike, `unsupported weighting "lines"`)
+		})
+	})
+
+	Convey(`ComputeCoverage`, t, func() {
+		m := &Mapping{
+			Dirs: map[string]*dirmdpb.Metadata{
+				"a": {
+					TeamEmail: "team@chromium.org",
+					Buganizer: &dirmdpb.Buganizer{ComponentId: 1},
+				},
+				"a/b": {
+					TeamEmail:       "team@chromium.org",
+					BuganizerPublic: &dirmdpb.Buganizer{ComponentId: 2},
+				},
+				"a/c": {
+					TeamEmail: "team@chromium.org",
+				},
+				"d": {},
+			},
+		}
+
+		Convey(`Weighted`, func() {
+			weights := map[string]int64{
+				"a":   1,
+				"a/b": 2,
+				"a/c": 3,
+				"d":   10,
+			}
+			cov, err := ComputeCoverage(m, DefaultCoverageFields, WeightingFiles, weights)
+			So(err, ShouldBeNil)
+			So(cov, ShouldResemble, &Coverage{
+				Fields:         DefaultCoverageFields,
+				Weighting:      WeightingFiles,
+				TotalDirs:      4,
+				TotalWeight:    16,
+				OrphanedDirs:   2,
+				OrphanedWeight: 13,
+				Ratio:          3.0 / 16,
+				Orphaned: []*OrphanedDir{
+					{Dir: "d", Weight: 10, Missing: []string{"buganizer", "team_email"}},
+					{Dir: "a/c", Weight: 3, Missing: []string{"buganizer"}},
+				},
+			})
+		})
+
+		Convey(`Unweighted`, func() {
+			cov, err := ComputeCoverage(m, []string{"team_email"}, WeightingDirs, nil)
+			So(err, ShouldBeNil)
+			So(cov.TotalWeight, ShouldEqual, 4)
+			So(cov.Ratio, ShouldEqual, 0.75)
+			So(cov.Orphaned, ShouldResemble, []*OrphanedDir{
+				{Dir: "d", Weight: 1, Missing: []string{"team_email"}},
+			})
+		})
+
+		Convey(`Empty`, func() {
+			cov, err := ComputeCoverage(&Mapping{}, DefaultCoverageFields, WeightingDirs, nil)
+			So(err, ShouldBeNil)
+			So(cov.Ratio, ShouldEqual, 1)
+			So(cov.Orphaned, ShouldBeEmpty)
+		})
+
+		Convey(`Unsupported field`, func() {
+			_, err := ComputeCoverage(m, []string{"owners"}, WeightingDirs, nil)
+			So(err, ShouldErrLike, `unsupported field "owners"`)
+		})
+	})
+}
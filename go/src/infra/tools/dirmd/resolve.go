@@ -0,0 +1,167 @@
+// Copyright 2024 The Chromium Authors
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package dirmd
+
+import (
+	"context"
+	"path"
+	"path/filepath"
+	"runtime"
+
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/sync/semaphore"
+
+	"go.chromium.org/luci/common/data/stringset"
+	"go.chromium.org/luci/common/errors"
+
+	dirmdpb "infra/tools/dirmd/proto"
+)
+
+// ResolveFiles reads the effective metadata of the given files.
+//
+// The effective metadata of a file is the metadata of its directory,
+// including inherited metadata and mixins, with the last matching
+// MetadataOverride of the directory applied, including its mixins.
+//
+// The files do not have to exist, or be tracked by git, but their
+// directories must exist and reside in a git checkout. See ReadMapping for
+// the requirements on the repos.
+//
+// The returned mapping contains the effective metadata in Files, keyed by
+// the slash-separated file path relative to the metadata root.
+func ResolveFiles(ctx context.Context, onlyDirmd bool, files ...string) (*Mapping, error) {
+	if len(files) == 0 {
+		return nil, nil
+	}
+
+	// Resolve the directories of the files, which are read.
+	absFiles := make([]string, len(files))
+	dirs := make([]string, 0, len(files))
+	seenDirs := stringset.New(len(files))
+	for i, f := range files {
+		absFile, err := filepath.Abs(f)
+		if err != nil {
+			return nil, errors.Annotate(err, "%q", f).Err()
+		}
+		dir, err := filepath.EvalSymlinks(filepath.Dir(absFile))
+		if err != nil {
+			return nil, errors.Annotate(err, "%q", f).Err()
+		}
+		absFiles[i] = filepath.Join(dir, filepath.Base(absFile))
+		if seenDirs.Add(dir) {
+			dirs = append(dirs, dir)
+		}
+	}
+
+	repos, err := dirsByRepoRoot(ctx, dirs)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &mappingReader{
+		Mapping:         *NewMapping(0),
+		semReadMetadata: semaphore.NewWeighted(int64(runtime.NumCPU())),
+	}
+	r.eg, ctx = errgroup.WithContext(ctx)
+	defer r.eg.Wait()
+
+	if r.Root, err = findMetadataRoot(repos); err != nil {
+		return nil, err
+	}
+
+	// Read the metadata of the directories and their ancestors.
+	for _, repo := range repos {
+		repo := repo
+
+		relRepoPath, err := filepath.Rel(r.Root, repo.absRoot)
+		if err != nil {
+			return nil, err
+		}
+		r.Repos[filepath.ToSlash(relRepoPath)] = repo.Repo
+
+		for _, dir := range repo.dirs {
+			dir := dir
+			r.eg.Go(func() error {
+				err := r.readUpMissing(ctx, repo, dir, onlyDirmd)
+				return errors.Annotate(err, "failed to process %q", dir).Err()
+			})
+		}
+	}
+	if err := r.eg.Wait(); err != nil {
+		return nil, err
+	}
+
+	// Remember overrides of the directories before they are cleared by
+	// ComputeAll, and read mixins they import. Unlike ReadMapping, it is done
+	// even if no file in git matches the file patterns.
+	overrides := make(map[string][]*dirmdpb.MetadataOverride, len(dirs))
+	for _, repo := range repos {
+		for _, dir := range repo.dirs {
+			key, err := r.DirKey(dir)
+			if err != nil {
+				return nil, err
+			}
+			md := r.Dirs[key]
+			if len(md.GetOverrides()) == 0 {
+				continue
+			}
+			overrides[key] = md.Overrides
+			for _, omd := range md.Overrides {
+				r.mu.Lock()
+				err := r.handleMixins(repo, dir, omd.GetMetadata().GetMixins())
+				r.mu.Unlock()
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+	if err := r.eg.Wait(); err != nil {
+		return nil, err
+	}
+
+	if err := r.Mapping.ComputeAll(); err != nil {
+		return nil, err
+	}
+
+	ret := NewMapping(len(files))
+	ret.Repos = r.Repos
+	for _, absFile := range absFiles {
+		dirKey, err := r.DirKey(filepath.Dir(absFile))
+		if err != nil {
+			panic(err) // Impossible: we have just used these paths above.
+		}
+		md := cloneMD(r.Dirs[dirKey])
+		if omd := matchOverride(overrides[dirKey], filepath.Base(absFile)); omd != nil {
+			if err := r.Mapping.applyMixins(md, omd.Metadata, dirKey); err != nil {
+				return nil, errors.Annotate(err, "file %q", absFile).Err()
+			}
+			Merge(md, omd.Metadata)
+			md.Mixins = nil
+			md.Overrides = nil
+		}
+		ret.Files[path.Join(dirKey, filepath.Base(absFile))] = md
+	}
+	return ret, nil
+}
+
+// matchOverride returns the last override with a file pattern matching the
+// file name, or nil if none matches.
+//
+// Overrides apply only to files directly in the directory, for which
+// matching the file name with path.Match agrees with the git pathspec used
+// by ReadMapping.
+func matchOverride(overrides []*dirmdpb.MetadataOverride, fileName string) *dirmdpb.MetadataOverride {
+	var ret *dirmdpb.MetadataOverride
+	for _, omd := range overrides {
+		for _, fp := range omd.FilePatterns {
+			if ok, _ := path.Match(fp, fileName); ok {
+				ret = omd
+				break
+			}
+		}
+	}
+	return ret
+}
@@ -0,0 +1,111 @@
+// Copyright 2024 The Chromium Authors
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package dirmd
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	. "go.chromium.org/luci/common/testing/assertions"
+
+	dirmdpb "infra/tools/dirmd/proto"
+)
+
+func TestResolve(t *testing.T) {
+	t.Parallel()
+
+	rootKey := "go/src/infra/tools/dirmd/testdata/root"
+	mxKey := "go/src/infra/tools/dirmd/testdata/mixins"
+
+	Convey(`ResolveFiles`, t, func() {
+		ctx := context.Background()
+
+		Convey(`Works`, func() {
+			m, err := ResolveFiles(ctx, false,
+				"testdata/root/subdir_with_files/dummy.txt",
+				"testdata/root/subdir_with_files/dummy.json",
+				// Not in git, but matches the file pattern.
+				"testdata/root/subdir_with_files/new.txt",
+				// Overrides are not inherited.
+				"testdata/root/subdir_with_files/nested_dir/dummy2.txt",
+				"testdata/root/subdir/foo.txt",
+			)
+			So(err, ShouldBeNil)
+			overridden := &dirmdpb.Metadata{
+				TeamEmail: "chromium-review@chromium.org",
+				Os:        dirmdpb.OS_LINUX,
+				Monorail: &dirmdpb.Monorail{
+					Project:   "chromium",
+					Component: "Some>Other>Component",
+				},
+			}
+			So(m.Proto(), ShouldResembleProto, &dirmdpb.Mapping{
+				Files: map[string]*dirmdpb.Metadata{
+					rootKey + "/subdir_with_files/dummy.txt": overridden,
+					rootKey + "/subdir_with_files/new.txt":   overridden,
+					rootKey + "/subdir_with_files/dummy.json": {
+						TeamEmail: "chromium-review@chromium.org",
+						Os:        dirmdpb.OS_LINUX,
+						Monorail: &dirmdpb.Monorail{
+							Project:   "chromium",
+							Component: "foo", // from FOO_METADATA
+						},
+					},
+					rootKey + "/subdir_with_files/nested_dir/dummy2.txt": {
+						TeamEmail: "chromium-review@chromium.org",
+						Os:        dirmdpb.OS_LINUX,
+						Monorail: &dirmdpb.Monorail{
+							Project:   "chromium",
+							Component: "Some>Component",
+						},
+					},
+					rootKey + "/subdir/foo.txt": {
+						TeamEmail: "team-email@chromium.org",
+						Os:        dirmdpb.OS_LINUX,
+						Monorail: &dirmdpb.Monorail{
+							Project:   "chromium",
+							Component: "Some>Component",
+						},
+						Resultdb: &dirmdpb.ResultDB{
+							Tags: []string{
+								"feature:read-later",
+								"feature:another-one",
+							},
+						},
+					},
+				},
+				Repos: map[string]*dirmdpb.Repo{
+					".": {Mixins: map[string]*dirmdpb.Metadata{
+						"//" + mxKey + "/FOO_METADATA": {
+							Monorail: &dirmdpb.Monorail{
+								Project:   "chromium",
+								Component: "foo",
+							},
+						},
+					}},
+				},
+			})
+		})
+
+		Convey(`Directory does not exist`, func() {
+			_, err := ResolveFiles(ctx, false, "testdata/root/no_such_dir/foo.txt")
+			So(err, ShouldNotBeNil)
+		})
+	})
+
+	Convey(`matchOverride`, t, func() {
+		txt := &dirmdpb.MetadataOverride{FilePatterns: []string{"*.txt"}}
+		foo := &dirmdpb.MetadataOverride{FilePatterns: []string{"*.json", "foo*"}}
+		overrides := []*dirmdpb.MetadataOverride{txt, foo}
+
+		So(matchOverride(overrides, "a.txt"), ShouldEqual, txt)
+		So(matchOverride(overrides, "a.json"), ShouldEqual, foo)
+		So(matchOverride(overrides, "foo.txt"), ShouldEqual, foo)
+		So(matchOverride(overrides, "a.cc"), ShouldBeNil)
+		So(matchOverride(nil, "a.txt"), ShouldBeNil)
+	})
+}
@@ -10,6 +10,7 @@ import (
 	"os"
 	"os/exec"
 	"strings"
+	"time"
 
 	"github.com/maruel/subcommands"
 	"google.golang.org/grpc/metadata"
@@ -47,6 +48,10 @@ type baseRun struct {
 
 type converter func(ctx context.Context, data []byte) ([]*sinkpb.TestResult, error)
 
+// streamConverter is like converter, but returns only the test results
+// not returned before. final is true once the test command finishes.
+type streamConverter func(ctx context.Context, data []byte, final bool) ([]*sinkpb.TestResult, error)
+
 func (r *baseRun) RegisterGlobalFlags() {
 	r.Flags.StringVar(&r.artifactDir, "artifact-directory", "", text.Doc(`
 				Directory of the artifacts. Required.
@@ -159,7 +164,107 @@ func (r *baseRun) run(ctx context.Context, args []string, f converter) (ret int)
 	}
 
 	ctx = metadata.AppendToOutgoingContext(ctx, "Authorization", "ResultSink "+r.sinkCtx.AuthToken)
+	r.reportInvocationArtifacts(ctx, trs)
+	if _, err := r.sinkC.ReportTestResults(ctx, &sinkpb.ReportTestResultsRequest{TestResults: trs}); err != nil {
+		return r.done(err)
+	}
+	return ec
+}
+
+// runStreaming is like run, but reports test results to ResultSink while
+// the test command is running, so that test results finished before
+// a crash or a timeout of the test command are still uploaded.
+//
+// f is called every interval while the test command is running, and once
+// after the test command finishes.
+func (r *baseRun) runStreaming(ctx context.Context, args []string, f streamConverter, interval time.Duration) (ret int) {
+	if err := r.initSinkClient(ctx); err != nil {
+		return r.done(err)
+	}
+	sinkCtx := metadata.AppendToOutgoingContext(ctx, "Authorization", "ResultSink "+r.sinkCtx.AuthToken)
+
+	// all is all test results converted, and pending is test results
+	// failed to be reported, which will be retried later.
+	var all, pending []*sinkpb.TestResult
+	streamCtx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-streamCtx.Done():
+				return
+			case <-ticker.C:
+			}
+			trs, err := f(ctx, nil, false)
+			if err != nil {
+				logging.Warningf(ctx, "Warning: failed to convert partial test results: %v", err)
+				continue
+			}
+			all = append(all, trs...)
+			pending = append(pending, trs...)
+			if pending, err = r.reportTestResults(sinkCtx, pending); err != nil {
+				logging.Warningf(ctx, "Warning: failed to report %d test results, will retry: %v", len(pending), err)
+			}
+		}
+	}()
+
+	out, err := r.runTestCmd(ctx, args)
+	cancel()
+	<-done
+	ec, ok := exitcode.Get(err)
+	if !ok {
+		if pending, rerr := r.reportTestResults(sinkCtx, pending); rerr != nil {
+			logging.Warningf(ctx, "Warning: failed to report %d test results: %v", len(pending), rerr)
+		}
+		return r.done(errors.Annotate(err, "test command failed").Err())
+	}
 
+	trs, err := f(ctx, out, true)
+	if err != nil {
+		// Report test results converted so far, and fail.
+		if pending, rerr := r.reportTestResults(sinkCtx, pending); rerr != nil {
+			logging.Warningf(ctx, "Warning: failed to report %d test results: %v", len(pending), rerr)
+		}
+		return r.done(err)
+	}
+	all = append(all, trs...)
+	pending = append(pending, trs...)
+	if len(all) == 0 {
+		return ec
+	}
+
+	r.reportInvocationArtifacts(sinkCtx, all)
+	if _, err := r.reportTestResults(sinkCtx, pending); err != nil {
+		return r.done(err)
+	}
+	return ec
+}
+
+// streamBatchSize is the maximum number of test results in
+// a ReportTestResults request while streaming.
+const streamBatchSize = 500
+
+// reportTestResults reports test results to ResultSink in batches.
+// It returns the test results not reported due to the error.
+func (r *baseRun) reportTestResults(ctx context.Context, trs []*sinkpb.TestResult) ([]*sinkpb.TestResult, error) {
+	for len(trs) > 0 {
+		n := min(len(trs), streamBatchSize)
+		if _, err := r.sinkC.ReportTestResults(ctx, &sinkpb.ReportTestResultsRequest{TestResults: trs[:n]}); err != nil {
+			return trs, err
+		}
+		trs = trs[n:]
+	}
+	return nil, nil
+}
+
+// reportInvocationArtifacts reports invocation link artifacts, and
+// invocation level artifacts if enabled.
+// It doesn't fail, as we still want to upload the test results even if
+// we can't upload the artifacts.
+func (r *baseRun) reportInvocationArtifacts(ctx context.Context, trs []*sinkpb.TestResult) {
 	// Try to upload invocation link artifacts.
 	// Upload before test results so that the links are present even if something goes wrong in the test result upload.
 	// We do not abort on error here, as we still want to upload the test results even if we can't upload the links.
@@ -191,9 +296,4 @@ func (r *baseRun) run(ctx context.Context, args []string, f converter) (ret int)
 			}
 		}
 	}
-
-	if _, err := r.sinkC.ReportTestResults(ctx, &sinkpb.ReportTestResultsRequest{TestResults: trs}); err != nil {
-		return r.done(err)
-	}
-	return ec
 }
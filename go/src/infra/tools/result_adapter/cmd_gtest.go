@@ -6,7 +6,9 @@ package main
 
 import (
 	"context"
+	"io/fs"
 	"os"
+	"time"
 
 	"github.com/maruel/subcommands"
 
@@ -24,10 +26,22 @@ func cmdGtest() *subcommands.Command {
 		LongDesc: text.Doc(`
 			Runs the test command and waits for it to finish, then converts the gtest
 			test results to ResultSink native format and uploads them to ResultDB via ResultSink.
+
+			With -stream, the results file is read periodically while the test
+			command is running, and finished test results are uploaded in batches,
+			so that a crash or a timeout of the test command still leaves the
+			completed test results in ResultDB.
 		`),
 		CommandRun: func() subcommands.CommandRun {
 			r := &gtestRun{}
 			r.baseRun.RegisterGlobalFlags()
+			r.Flags.BoolVar(&r.stream, "stream", false, text.Doc(`
+				Upload test results while the test command is running, by reading
+				the partially written results file. Optional.
+			`))
+			r.Flags.DurationVar(&r.streamInterval, "stream-interval", 30*time.Second, text.Doc(`
+				Interval to read the results file with -stream.
+			`))
 			return r
 		},
 	}
@@ -35,6 +49,12 @@ func cmdGtest() *subcommands.Command {
 
 type gtestRun struct {
 	baseRun
+
+	stream         bool
+	streamInterval time.Duration
+
+	// reported is the set of test results reported with -stream.
+	reported map[string]bool
 }
 
 func (r *gtestRun) Run(a subcommands.Application, args []string, env subcommands.Env) (ret int) {
@@ -43,6 +63,13 @@ func (r *gtestRun) Run(a subcommands.Application, args []string, env subcommands
 	}
 
 	ctx := cli.GetContext(a, r, env)
+	if r.stream {
+		if r.streamInterval <= 0 {
+			return r.done(errors.Reason("-stream-interval must be positive").Err())
+		}
+		r.reported = make(map[string]bool)
+		return r.runStreaming(ctx, args, r.generateNewTestResults, r.streamInterval)
+	}
 	return r.run(ctx, args, r.generateTestResults)
 }
 
@@ -71,3 +98,28 @@ func (r *gtestRun) generateTestResults(ctx context.Context, _ []byte) ([]*sinkpb
 	}
 	return trs, nil
 }
+
+// generateNewTestResults converts test results from the results file,
+// which may be partially written, to sinkpb.TestResult, skipping the test
+// results already returned.
+func (r *gtestRun) generateNewTestResults(ctx context.Context, _ []byte, final bool) ([]*sinkpb.TestResult, error) {
+	f, err := os.Open(r.resultFile)
+	switch {
+	case errors.Is(err, fs.ErrNotExist) && !final:
+		// The test launcher has not written the results file yet.
+		return nil, nil
+	case err != nil:
+		return nil, errors.Annotate(err, "open result file").Err()
+	}
+	defer f.Close()
+
+	gtestFormat := &GTestResults{}
+	if err = gtestFormat.ConvertFromPartialJSON(f); err != nil {
+		return nil, errors.Annotate(err, "did not recognize as GTest").Err()
+	}
+	trs, err := gtestFormat.ToProtosSince(ctx, r.reported, final)
+	if err != nil {
+		return nil, errors.Annotate(err, "converting as GTest results format").Err()
+	}
+	return trs, nil
+}
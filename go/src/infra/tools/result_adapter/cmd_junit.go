@@ -0,0 +1,89 @@
+// Copyright 2024 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"os"
+
+	"github.com/maruel/subcommands"
+
+	"go.chromium.org/luci/common/cli"
+	"go.chromium.org/luci/common/data/text"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	sinkpb "go.chromium.org/luci/resultdb/sink/proto/v1"
+)
+
+func cmdJUnit() *subcommands.Command {
+	return &subcommands.Command{
+		UsageLine: `junit [flags] TEST_CMD [TEST_ARG]...`,
+		ShortDesc: "Batch upload results of the JUnit XML format to ResultSink",
+		LongDesc: text.Doc(`
+			Runs the test command and waits for it to finish, then converts the JUnit XML
+			test results (e.g. emitted by pytest, Gradle, Jest, cargo-nextest) to ResultSink
+			native format and uploads them to ResultDB via ResultSink.
+		`),
+		CommandRun: func() subcommands.CommandRun {
+			r := &junitRun{}
+			r.baseRun.RegisterGlobalFlags()
+			r.Flags.StringVar(&r.locationRepo, "location-repo", chromiumSrcRepo, text.Doc(`
+				Gitiles URL of the repository used for the test locations.
+			`))
+			return r
+		},
+	}
+}
+
+type junitRun struct {
+	baseRun
+
+	locationRepo string
+}
+
+func (r *junitRun) Run(a subcommands.Application, args []string, env subcommands.Env) (ret int) {
+	if err := r.validate(); err != nil {
+		return r.done(err)
+	}
+
+	ctx := cli.GetContext(a, r, env)
+	return r.run(ctx, args, r.generateTestResults)
+}
+
+// generateTestResults converts test results from results file to sinkpb.TestResult.
+func (r *junitRun) generateTestResults(ctx context.Context, _ []byte) ([]*sinkpb.TestResult, error) {
+	// Get artifacts to resolve attachments.
+	var normPathToFullPath map[string]string
+	if r.artifactDir != "" {
+		var err error
+		normPathToFullPath, err = processArtifacts(r.artifactDir)
+		if err != nil {
+			return nil, errors.Annotate(err, "open artifact directory").Err()
+		}
+	}
+
+	f, err := os.Open(r.resultFile)
+	if err != nil {
+		return nil, errors.Annotate(err, "open result file").Err()
+	}
+	defer f.Close()
+
+	// convert the results to ResultSink native format.
+	junitFormat := &JUnitResults{}
+	if err = junitFormat.ConvertFromXML(f); err != nil {
+		return nil, errors.Annotate(err, "did not recognize as JUnit XML").Err()
+	}
+
+	if len(junitFormat.Suites) == 0 {
+		logging.Warningf(ctx, `no <testsuite> in JUnit XML`)
+		return nil, nil
+	}
+
+	trs, err := junitFormat.ToProtos(ctx, normPathToFullPath, r.locationRepo)
+	if err != nil {
+		return nil, errors.Annotate(err, "converting as JUnit XML format").Err()
+	}
+	return trs, nil
+}
@@ -0,0 +1,92 @@
+// Copyright 2024 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+
+	"github.com/maruel/subcommands"
+
+	"go.chromium.org/luci/common/cli"
+	"go.chromium.org/luci/common/data/text"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	sinkpb "go.chromium.org/luci/resultdb/sink/proto/v1"
+)
+
+func cmdTAP() *subcommands.Command {
+	return &subcommands.Command{
+		UsageLine: `tap [flags] TEST_CMD [TEST_ARG]...`,
+		ShortDesc: "Batch upload results of the Test Anything Protocol format to ResultSink",
+		LongDesc: text.Doc(`
+			Runs the test command and waits for it to finish, then converts the TAP
+			test results to ResultSink native format and uploads them to ResultDB via ResultSink.
+
+			TAP is read from -result-file, or from the standard output of the test command
+			if -result-file is not specified.
+		`),
+		CommandRun: func() subcommands.CommandRun {
+			r := &tapRun{}
+			r.baseRun.RegisterGlobalFlags()
+			r.Flags.StringVar(&r.locationRepo, "location-repo", chromiumSrcRepo, text.Doc(`
+				Gitiles URL of the repository used for the test locations.
+			`))
+			return r
+		},
+	}
+}
+
+type tapRun struct {
+	baseRun
+
+	locationRepo string
+}
+
+func (r *tapRun) Run(a subcommands.Application, args []string, env subcommands.Env) (ret int) {
+	// Read TAP from stdout of the test command, if no result file.
+	r.captureOutput = r.resultFile == ""
+
+	ctx := cli.GetContext(a, r, env)
+	return r.run(ctx, args, r.generateTestResults)
+}
+
+// generateTestResults converts test results from results file or
+// the test output to sinkpb.TestResult.
+func (r *tapRun) generateTestResults(ctx context.Context, output []byte) ([]*sinkpb.TestResult, error) {
+	var in io.Reader
+	if r.captureOutput {
+		// Print the test output to stdout so that
+		// it's also available as a step log.
+		os.Stdout.Write(output)
+		in = bytes.NewReader(output)
+	} else {
+		f, err := os.Open(r.resultFile)
+		if err != nil {
+			return nil, errors.Annotate(err, "open result file").Err()
+		}
+		defer f.Close()
+		in = f
+	}
+
+	// convert the results to ResultSink native format.
+	tapFormat := &TAPResults{}
+	if err := tapFormat.ConvertFromReader(in); err != nil {
+		return nil, errors.Annotate(err, "did not recognize as TAP").Err()
+	}
+
+	if len(tapFormat.Tests) == 0 {
+		logging.Warningf(ctx, `no test lines in TAP`)
+		return nil, nil
+	}
+
+	trs, err := tapFormat.ToProtos(ctx, r.locationRepo)
+	if err != nil {
+		return nil, errors.Annotate(err, "converting as TAP format").Err()
+	}
+	return trs, nil
+}
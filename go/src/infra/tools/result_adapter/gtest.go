@@ -143,6 +143,10 @@ type GTestResults struct {
 
 	// TestLocations maps test names to their location in code.
 	TestLocations map[string]*Location `json:"test_locations"`
+
+	// truncated is whether the JSON read by ConvertFromPartialJSON was
+	// truncated.
+	truncated bool
 }
 
 // GTestRunResult represents the per_iteration_data as described in
@@ -218,8 +222,144 @@ func (r *GTestResults) ConvertFromJSON(reader io.Reader) error {
 	return nil
 }
 
+// ConvertFromPartialJSON reads the provided reader into the receiver,
+// tolerating JSON truncated in the middle, e.g. the summary file of the
+// test launcher that crashed while writing it.
+//
+// Test results decoded before the truncation are kept, and the rest is
+// ignored. Note that "test_locations" follows "per_iteration_data", so it
+// is likely to be missing in the truncated JSON, see ToProtosSince.
+//
+// The receiver is cleared and its fields overwritten.
+func (r *GTestResults) ConvertFromPartialJSON(reader io.Reader) error {
+	*r = GTestResults{}
+	complete, err := r.decodePartial(json.NewDecoder(reader))
+	r.truncated = !complete
+	return err
+}
+
+// decodePartial decodes the JSON object into r.
+// It returns false if the JSON is truncated.
+func (r *GTestResults) decodePartial(d *json.Decoder) (bool, error) {
+	tok, err := d.Token()
+	switch {
+	case err == io.EOF:
+		// The file is created, but not written yet.
+		return false, nil
+	case err != nil:
+		return false, err
+	case tok != json.Delim('{'):
+		return false, errors.Reason("unexpected token %v, want '{'", tok).Err()
+	}
+	for d.More() {
+		tok, err := d.Token()
+		if err != nil {
+			return false, nil
+		}
+		key, _ := tok.(string)
+		var v any
+		switch key {
+		case "all_tests":
+			v = &r.AllTests
+		case "disabled_tests":
+			v = &r.DisabledTests
+		case "global_tags":
+			v = &r.GlobalTags
+		case "test_locations":
+			v = &r.TestLocations
+		case "per_iteration_data":
+			if !r.decodePartialIterations(d) {
+				return false, nil
+			}
+			continue
+		default:
+			v = &json.RawMessage{}
+		}
+		// json.Decoder reads the whole value before unmarshaling it,
+		// so v is not modified if the value is truncated.
+		if err := d.Decode(v); err != nil {
+			return false, nil
+		}
+	}
+	_, err = d.Token()
+	return err == nil, nil
+}
+
+// decodePartialIterations decodes "per_iteration_data" into r.
+// It returns false if the JSON is truncated.
+func (r *GTestResults) decodePartialIterations(d *json.Decoder) bool {
+	if tok, err := d.Token(); err != nil || tok != json.Delim('[') {
+		return false
+	}
+	for d.More() {
+		if tok, err := d.Token(); err != nil || tok != json.Delim('{') {
+			return false
+		}
+		data := make(map[string][]*GTestRunResult)
+		r.PerIterationData = append(r.PerIterationData, data)
+		for d.More() {
+			tok, err := d.Token()
+			if err != nil {
+				return false
+			}
+			name, _ := tok.(string)
+			var results []*GTestRunResult
+			if err := d.Decode(&results); err != nil {
+				return false
+			}
+			data[name] = results
+		}
+		if _, err := d.Token(); err != nil {
+			return false
+		}
+	}
+	_, err := d.Token()
+	return err == nil
+}
+
 // ToProtos converts test results in r to []*sinkpb.TestResult.
 func (r *GTestResults) ToProtos(ctx context.Context) ([]*sinkpb.TestResult, error) {
+	return r.toProtos(ctx, nil)
+}
+
+// ToProtosSince converts test results in r that are not in reported to
+// []*sinkpb.TestResult, and adds the converted test results to reported.
+// It is used to report test results incrementally while the test launcher
+// is still running.
+//
+// Unless final is true, NOTRUN results are not converted, because
+// the test launcher may run the tests later. Nor is anything converted
+// if r was read from JSON truncated before "test_locations", so that
+// the test results are not reported without their location.
+func (r *GTestResults) ToProtosSince(ctx context.Context, reported map[string]bool, final bool) ([]*sinkpb.TestResult, error) {
+	if !final && r.truncated && r.TestLocations == nil {
+		return nil, nil
+	}
+	var keys []string
+	trs, err := r.toProtos(ctx, func(key string, result *GTestRunResult) bool {
+		if reported[key] {
+			return false
+		}
+		if !final && result != nil && result.Status == "NOTRUN" {
+			return false
+		}
+		keys = append(keys, key)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range keys {
+		reported[key] = true
+	}
+	return trs, nil
+}
+
+// toProtos converts test results in r to []*sinkpb.TestResult.
+// If include is not nil, only the test results for which include returns
+// true are converted. key identifies the test result in r, and result is
+// nil for disabled tests.
+func (r *GTestResults) toProtos(ctx context.Context, include func(key string, result *GTestRunResult) bool) ([]*sinkpb.TestResult, error) {
 	var ret []*sinkpb.TestResult
 	var testNames []string
 
@@ -230,6 +370,9 @@ func (r *GTestResults) ToProtos(ctx context.Context) ([]*sinkpb.TestResult, erro
 	globalTags[len(r.GlobalTags)] = pbutil.StringPair(originalFormatTagKey, formatGTest)
 
 	for _, name := range r.DisabledTests {
+		if include != nil && !include("disabled/"+name, nil) {
+			continue
+		}
 		testID, err := extractGTestParameters(name)
 		switch {
 		case syntheticTestTag.In(err):
@@ -255,7 +398,7 @@ func (r *GTestResults) ToProtos(ctx context.Context) ([]*sinkpb.TestResult, erro
 	}
 
 	var buf bytes.Buffer
-	for _, data := range r.PerIterationData {
+	for iter, data := range r.PerIterationData {
 		// Sort the test name to make the output deterministic.
 		testNames = testNames[:0]
 		for name := range data {
@@ -274,6 +417,9 @@ func (r *GTestResults) ToProtos(ctx context.Context) ([]*sinkpb.TestResult, erro
 			}
 
 			for i, result := range data[name] {
+				if include != nil && !include(fmt.Sprintf("%d/%s/%d", iter, name, i), result) {
+					continue
+				}
 				// Store the processed test result into the correct part of the overall map.
 				rpb, err := r.convertTestResult(ctx, &buf, testID, name, result)
 				if err != nil {
@@ -9,6 +9,8 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -201,6 +203,146 @@ func TestGTestConversions(t *testing.T) {
 		So(len(results.AllTests), ShouldEqual, 0)
 	})
 
+	Convey(`From partial JSON works`, t, func() {
+		str := `{
+				"all_tests": ["FooTest.TestDoBar", "FooTest.TestDoBaz", "FooTest.TestDoQux"],
+				"disabled_tests": ["FooTest.TestDoBarDisabled"],
+				"global_tags": ["OS_LINUX"],
+				"per_iteration_data": [{
+					"FooTest.TestDoBar": [
+						{"elapsed_time_ms": 1837, "status": "SUCCESS"}
+					],
+					"FooTest.TestDoBaz": [
+						{"elapsed_time_ms": 10, "status": "FAILURE"},
+						{"elapsed_time_ms": 12, "stat`
+
+		Convey(`truncated`, func() {
+			results := &GTestResults{}
+			err := results.ConvertFromPartialJSON(strings.NewReader(str))
+			So(err, ShouldBeNil)
+			So(results.AllTests, ShouldHaveLength, 3)
+			So(results.DisabledTests, ShouldResemble, []string{"FooTest.TestDoBarDisabled"})
+			So(results.PerIterationData, ShouldResemble, []map[string][]*GTestRunResult{
+				{
+					"FooTest.TestDoBar": {
+						{Status: "SUCCESS", ElapsedTimeMs: 1837},
+					},
+				},
+			})
+		})
+
+		Convey(`complete`, func() {
+			results := &GTestResults{}
+			err := results.ConvertFromPartialJSON(strings.NewReader(str + `atus": "SUCCESS"}]}],
+				"test_locations": {
+					"FooTest.TestDoBar": {"file": "../../chrome/browser/foo/test.cc", "line": 287}
+				}
+			}`))
+			So(err, ShouldBeNil)
+			So(results.PerIterationData[0], ShouldHaveLength, 2)
+			So(results.PerIterationData[0]["FooTest.TestDoBaz"], ShouldHaveLength, 2)
+			So(results.TestLocations, ShouldHaveLength, 1)
+		})
+
+		Convey(`empty`, func() {
+			results := &GTestResults{}
+			err := results.ConvertFromPartialJSON(strings.NewReader(""))
+			So(err, ShouldBeNil)
+			So(results.PerIterationData, ShouldBeEmpty)
+		})
+
+		Convey(`not JSON object`, func() {
+			results := &GTestResults{}
+			err := results.ConvertFromPartialJSON(strings.NewReader(`["FooTest.TestDoBar"]`))
+			So(err, ShouldErrLike, "want '{'")
+		})
+	})
+
+	Convey(`ToProtosSince`, t, func() {
+		reported := map[string]bool{}
+		testIDs := func(trs []*sinkpb.TestResult) []string {
+			var ids []string
+			for _, tr := range trs {
+				ids = append(ids, tr.TestId+":"+tr.Status.String())
+			}
+			return ids
+		}
+
+		results := &GTestResults{
+			DisabledTests: []string{"FooTest.TestDoBarDisabled"},
+			PerIterationData: []map[string][]*GTestRunResult{{
+				"FooTest.TestDoBar": {{Status: "SUCCESS"}},
+				"FooTest.TestDoBaz": {{Status: "NOTRUN"}},
+			}},
+		}
+		trs, err := results.ToProtosSince(ctx, reported, false)
+		So(err, ShouldBeNil)
+		So(testIDs(trs), ShouldResemble, []string{
+			"FooTest.TestDoBarDisabled:SKIP",
+			"FooTest.TestDoBar:PASS",
+		})
+
+		// The test launcher ran FooTest.TestDoBaz, and retried it.
+		results.PerIterationData[0]["FooTest.TestDoBaz"] = []*GTestRunResult{
+			{Status: "FAILURE"},
+			{Status: "SUCCESS"},
+		}
+		results.PerIterationData[0]["FooTest.TestDoQux"] = []*GTestRunResult{{Status: "NOTRUN"}}
+		trs, err = results.ToProtosSince(ctx, reported, false)
+		So(err, ShouldBeNil)
+		So(testIDs(trs), ShouldResemble, []string{
+			"FooTest.TestDoBaz:FAIL",
+			"FooTest.TestDoBaz:PASS",
+		})
+
+		trs, err = results.ToProtosSince(ctx, reported, true)
+		So(err, ShouldBeNil)
+		So(testIDs(trs), ShouldResemble, []string{
+			"FooTest.TestDoQux:SKIP",
+		})
+
+		trs, err = results.ToProtosSince(ctx, reported, true)
+		So(err, ShouldBeNil)
+		So(trs, ShouldBeEmpty)
+	})
+
+	Convey(`Streaming holds back test results until their locations are known`, t, func() {
+		r := &gtestRun{
+			baseRun:  baseRun{resultFile: filepath.Join(t.TempDir(), "output.json")},
+			reported: map[string]bool{},
+		}
+		str := `{
+				"all_tests": ["FooTest.TestDoBar"],
+				"per_iteration_data": [{
+					"FooTest.TestDoBar": [
+						{"elapsed_time_ms": 1837, "status": "SUCCESS"}
+					]
+				}],
+				"test_locations": {
+					"FooTest.TestDoBar": {"file": "../../chrome/browser/foo/test.cc", "line": 287}
+				}
+			}`
+		write := func(s string) {
+			So(os.WriteFile(r.resultFile, []byte(s), 0600), ShouldBeNil)
+		}
+
+		// The test launcher is writing the file, up to "test_locations".
+		write(str[:strings.Index(str, `"test_locations"`)])
+		trs, err := r.generateNewTestResults(ctx, nil, false)
+		So(err, ShouldBeNil)
+		So(trs, ShouldBeEmpty)
+
+		write(str)
+		trs, err = r.generateNewTestResults(ctx, nil, false)
+		So(err, ShouldBeNil)
+		So(trs, ShouldHaveLength, 1)
+		So(trs[0].TestMetadata.Location, ShouldResembleProto, &pb.TestLocation{
+			Repo:     chromiumSrcRepo,
+			FileName: "//chrome/browser/foo/test.cc",
+			Line:     287,
+		})
+	})
+
 	Convey("convertTestResult", t, func() {
 		var buf bytes.Buffer
 		convert := func(result *GTestRunResult) *sinkpb.TestResult {
@@ -0,0 +1,345 @@
+// Copyright 2024 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"io"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/resultdb/pbutil"
+	pb "go.chromium.org/luci/resultdb/proto/v1"
+	sinkpb "go.chromium.org/luci/resultdb/sink/proto/v1"
+)
+
+const (
+	// formatJUnit is JUnit XML format.
+	formatJUnit = "junit"
+)
+
+// junitAttachmentRE matches attachments in system-out/system-err,
+// as the Jenkins JUnit Attachments plugin recognizes.
+// e.g. [[ATTACHMENT|/path/to/screenshot.png]]
+var junitAttachmentRE = regexp.MustCompile(`\[\[ATTACHMENT\|([^\]]+)\]\]`)
+
+// invalidTagKeyCharRE matches characters not allowed in a tag key.
+var invalidTagKeyCharRE = regexp.MustCompile(`[^a-z0-9_]`)
+
+// JUnitResults represents the JUnit XML format, as emitted by
+// pytest, Gradle, Maven surefire, Jest, cargo-nextest etc.
+// https://github.com/testmoapp/junitxml
+//
+// The root element is either <testsuites> or <testsuite>.
+// Fields not used by Test Results are omitted.
+type JUnitResults struct {
+	Suites []*JUnitTestSuite
+}
+
+// JUnitTestSuite represents <testsuite> element.
+type JUnitTestSuite struct {
+	Name       string            `xml:"name,attr"`
+	File       string            `xml:"file,attr"`
+	Properties []*JUnitProperty  `xml:"properties>property"`
+	TestCases  []*JUnitTestCase  `xml:"testcase"`
+	Suites     []*JUnitTestSuite `xml:"testsuite"`
+}
+
+// JUnitTestCase represents <testcase> element.
+type JUnitTestCase struct {
+	Name      string `xml:"name,attr"`
+	ClassName string `xml:"classname,attr"`
+	// Time is the duration of the test in seconds.
+	Time       float64          `xml:"time,attr"`
+	File       string           `xml:"file,attr"`
+	Line       int              `xml:"line,attr"`
+	Properties []*JUnitProperty `xml:"properties>property"`
+
+	Failures []*JUnitFailure `xml:"failure"`
+	Errors   []*JUnitFailure `xml:"error"`
+	Skipped  *JUnitFailure   `xml:"skipped"`
+
+	SystemOut string `xml:"system-out"`
+	SystemErr string `xml:"system-err"`
+}
+
+// JUnitFailure represents <failure>, <error> or <skipped> element.
+type JUnitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// JUnitProperty represents <property> element.
+type JUnitProperty struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value,attr"`
+	Text  string `xml:",chardata"`
+}
+
+// ConvertFromXML reads the provided reader into the receiver.
+//
+// The receiver is cleared and its fields overwritten.
+func (r *JUnitResults) ConvertFromXML(reader io.Reader) error {
+	*r = JUnitResults{}
+	d := xml.NewDecoder(reader)
+	for {
+		tok, err := d.Token()
+		if err == io.EOF {
+			return errors.Reason("no <testsuites> or <testsuite> element").Err()
+		}
+		if err != nil {
+			return err
+		}
+		start, ok := tok.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		switch start.Name.Local {
+		case "testsuites":
+			var suites struct {
+				Suites []*JUnitTestSuite `xml:"testsuite"`
+			}
+			if err := d.DecodeElement(&suites, &start); err != nil {
+				return err
+			}
+			r.Suites = suites.Suites
+		case "testsuite":
+			suite := &JUnitTestSuite{}
+			if err := d.DecodeElement(suite, &start); err != nil {
+				return err
+			}
+			r.Suites = []*JUnitTestSuite{suite}
+		default:
+			return errors.Reason("unexpected root element <%s>", start.Name.Local).Err()
+		}
+		return nil
+	}
+}
+
+// ToProtos converts test results in r to []*sinkpb.TestResult.
+//
+// normPathToFullPath is used to resolve attachments in system-out and
+// system-err. It may be nil if no artifact directory is given.
+// repo is the repository used for the test locations.
+func (r *JUnitResults) ToProtos(ctx context.Context, normPathToFullPath map[string]string, repo string) ([]*sinkpb.TestResult, error) {
+	var ret []*sinkpb.TestResult
+	var buf bytes.Buffer
+	var walk func(suite *JUnitTestSuite, parents []string, tags []*pb.StringPair) error
+	walk = func(suite *JUnitTestSuite, parents []string, tags []*pb.StringPair) error {
+		if suite.Name != "" {
+			parents = append(parents, suite.Name)
+		}
+		tags = append(tags[:len(tags):len(tags)], propertiesToTags(suite.Properties)...)
+		for _, tc := range suite.TestCases {
+			tr, err := tc.toProto(ctx, &buf, suite, normPathToFullPath, repo)
+			if err != nil {
+				return errors.Annotate(err, "test %q in suite %q", tc.Name, strings.Join(parents, "/")).Err()
+			}
+			if len(parents) > 0 {
+				tr.Tags = AppendTags(tr.Tags, "junit_suite", strings.Join(parents, "/"))
+			}
+			tr.Tags = append(tr.Tags, tags...)
+			tr.Tags = append(tr.Tags, pbutil.StringPair(originalFormatTagKey, formatJUnit))
+			ret = append(ret, tr)
+		}
+		for _, s := range suite.Suites {
+			if err := walk(s, parents, tags); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	for _, suite := range r.Suites {
+		if err := walk(suite, nil, nil); err != nil {
+			return nil, err
+		}
+	}
+	return ret, nil
+}
+
+// testID returns test id of the test case.
+// e.g. "tests.test_foo.TestFoo.test_bar" for pytest.
+func (tc *JUnitTestCase) testID() string {
+	if tc.ClassName == "" {
+		return tc.Name
+	}
+	return tc.ClassName + "." + tc.Name
+}
+
+func (tc *JUnitTestCase) toProto(ctx context.Context, buf *bytes.Buffer, suite *JUnitTestSuite, normPathToFullPath map[string]string, repo string) (*sinkpb.TestResult, error) {
+	if tc.Name == "" {
+		return nil, errors.Reason("testcase has no name").Err()
+	}
+	tr := &sinkpb.TestResult{
+		TestId:       tc.testID(),
+		Tags:         AppendTags(nil, "test_name", tc.Name),
+		TestMetadata: &pb.TestMetadata{Name: tc.testID()},
+	}
+	tr.Tags = AppendTags(tr.Tags, "junit_classname", tc.ClassName)
+
+	var failure *JUnitFailure
+	var junitStatus string
+	switch {
+	case len(tc.Errors) > 0:
+		junitStatus = "error"
+		tr.Status = pb.TestStatus_FAIL
+		failure = tc.Errors[0]
+	case len(tc.Failures) > 0:
+		junitStatus = "failure"
+		tr.Status = pb.TestStatus_FAIL
+		failure = tc.Failures[0]
+	case tc.Skipped != nil:
+		junitStatus = "skipped"
+		tr.Status = pb.TestStatus_SKIP
+		tr.Expected = true
+		tr.Tags = AppendTags(tr.Tags, "skip_reason", tc.Skipped.Message)
+	default:
+		junitStatus = "passed"
+		tr.Status = pb.TestStatus_PASS
+		tr.Expected = true
+	}
+	tr.Tags = append(tr.Tags, pbutil.StringPair("junit_status", junitStatus))
+	tr.Tags = append(tr.Tags, propertiesToTags(tc.Properties)...)
+
+	// Do not set duration if it is unknown.
+	if tc.Time > 0 {
+		tr.Duration = msToDuration(tc.Time * 1000)
+	}
+
+	summaryData := map[string]interface{}{}
+	var textArtifacts []string
+	tr.Artifacts = map[string]*sinkpb.Artifact{}
+	if failure != nil {
+		message := strings.TrimSpace(failure.Message)
+		if message == "" {
+			message = strings.TrimSpace(failure.Text)
+		}
+		if message != "" {
+			primaryError := truncateString(message, maxErrorMessageBytes)
+			tr.FailureReason = &pb.FailureReason{
+				PrimaryErrorMessage: primaryError,
+				Errors: []*pb.FailureReason_Error{
+					{Message: primaryError},
+				},
+			}
+		}
+		if text := strings.TrimSpace(failure.Text); text != "" {
+			tr.Artifacts[junitStatus] = &sinkpb.Artifact{
+				Body:        &sinkpb.Artifact_Contents{Contents: []byte(text)},
+				ContentType: "text/plain",
+			}
+			textArtifacts = append(textArtifacts, junitStatus)
+		}
+	}
+	for _, output := range []struct {
+		name, text string
+	}{
+		{name: "stdout", text: tc.SystemOut},
+		{name: "stderr", text: tc.SystemErr},
+	} {
+		if strings.TrimSpace(output.text) == "" {
+			continue
+		}
+		tr.Artifacts[output.name] = &sinkpb.Artifact{
+			Body:        &sinkpb.Artifact_Contents{Contents: []byte(output.text)},
+			ContentType: "text/plain",
+		}
+		textArtifacts = append(textArtifacts, output.name)
+		for _, m := range junitAttachmentRE.FindAllStringSubmatch(output.text, -1) {
+			name, art := resolveJUnitAttachment(ctx, strings.TrimSpace(m[1]), normPathToFullPath)
+			if art == nil {
+				continue
+			}
+			tr.Artifacts[name] = art
+		}
+	}
+	if len(tr.Artifacts) == 0 {
+		tr.Artifacts = nil
+	}
+	if len(textArtifacts) > 0 {
+		summaryData["text_artifacts"] = textArtifacts
+	}
+
+	// Write the summary html
+	if len(summaryData) > 0 {
+		buf.Reset()
+		if err := summaryTmpl.ExecuteTemplate(buf, "gtest", summaryData); err != nil {
+			return nil, err
+		}
+		tr.SummaryHtml = buf.String()
+	}
+
+	// Store the test code location.
+	file := tc.File
+	if file == "" {
+		file = suite.File
+	}
+	if file != "" {
+		file = normalizePath(file)
+		// Paths are often relative to the out directory, e.g. "../../".
+		// Strip the prefix.
+		file = stripRepeatedPrefixes(file, "../")
+		file = ensureLeadingDoubleSlash(file)
+		tr.TestMetadata.Location = &pb.TestLocation{
+			Repo:     repo,
+			FileName: file,
+			Line:     int32(tc.Line),
+		}
+	}
+	return tr, nil
+}
+
+// resolveJUnitAttachment returns artifact name and artifact for the
+// attachment path.
+func resolveJUnitAttachment(ctx context.Context, p string, normPathToFullPath map[string]string) (string, *sinkpb.Artifact) {
+	name := path.Base(normalizePath(p))
+	if fullPath := findArtifactFullPath(normPathToFullPath, normalizePath(p)); fullPath != "" {
+		return name, &sinkpb.Artifact{
+			Body: &sinkpb.Artifact_FilePath{FilePath: fullPath},
+		}
+	}
+	if filepath.IsAbs(p) {
+		return name, &sinkpb.Artifact{
+			Body: &sinkpb.Artifact_FilePath{FilePath: p},
+		}
+	}
+	logging.Warningf(ctx, "failed to resolve attachment %q", p)
+	return "", nil
+}
+
+// propertiesToTags converts JUnit properties to tags.
+func propertiesToTags(props []*JUnitProperty) []*pb.StringPair {
+	var tags []*pb.StringPair
+	for _, p := range props {
+		value := p.Value
+		if value == "" {
+			value = strings.TrimSpace(p.Text)
+		}
+		tags = AppendTags(tags, junitTagKey(p.Name), value)
+	}
+	return tags
+}
+
+// junitTagKey converts JUnit property name to a valid tag key.
+// e.g. "Build.Flavor" -> "build_flavor".
+// The key is truncated to the first 64 bytes.
+func junitTagKey(name string) string {
+	key := invalidTagKeyCharRE.ReplaceAllString(convertTagKey(name), "_")
+	if key == "" || key[0] < 'a' || key[0] > 'z' {
+		key = "property_" + key
+	}
+	// The key only has ASCII characters.
+	if len(key) > maxTagKeyBytes {
+		key = key[:maxTagKeyBytes]
+	}
+	return key
+}
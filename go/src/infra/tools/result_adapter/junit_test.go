@@ -0,0 +1,220 @@
+// Copyright 2024 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes"
+	. "github.com/smartystreets/goconvey/convey"
+
+	. "go.chromium.org/luci/common/testing/assertions"
+	"go.chromium.org/luci/resultdb/pbutil"
+	pb "go.chromium.org/luci/resultdb/proto/v1"
+	sinkpb "go.chromium.org/luci/resultdb/sink/proto/v1"
+)
+
+func TestJUnitConversions(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	Convey(`From XML works`, t, func() {
+		str := `<?xml version="1.0" encoding="utf-8"?>
+			<testsuites>
+				<testsuite name="pytest" tests="2">
+					<properties>
+						<property name="Build.Flavor" value="debug"/>
+					</properties>
+					<testcase classname="tests.test_foo" name="test_pass" time="0.5" file="../../tests/test_foo.py" line="10"/>
+					<testcase classname="tests.test_foo" name="test_fail" time="1.25">
+						<failure message="assert 1 == 2">tests/test_foo.py:20: AssertionError</failure>
+					</testcase>
+				</testsuite>
+			</testsuites>`
+
+		results := &JUnitResults{}
+		err := results.ConvertFromXML(strings.NewReader(str))
+		So(err, ShouldBeNil)
+		So(results.Suites, ShouldHaveLength, 1)
+		So(results.Suites[0].Name, ShouldEqual, "pytest")
+		So(results.Suites[0].Properties, ShouldResemble, []*JUnitProperty{
+			{Name: "Build.Flavor", Value: "debug"},
+		})
+		So(results.Suites[0].TestCases, ShouldResemble, []*JUnitTestCase{
+			{
+				Name:      "test_pass",
+				ClassName: "tests.test_foo",
+				Time:      0.5,
+				File:      "../../tests/test_foo.py",
+				Line:      10,
+			},
+			{
+				Name:      "test_fail",
+				ClassName: "tests.test_foo",
+				Time:      1.25,
+				Failures: []*JUnitFailure{
+					{
+						Message: "assert 1 == 2",
+						Text:    "tests/test_foo.py:20: AssertionError",
+					},
+				},
+			},
+		})
+	})
+
+	Convey(`From XML works with testsuite root`, t, func() {
+		str := `<testsuite name="jest"><testcase name="renders"/></testsuite>`
+
+		results := &JUnitResults{}
+		err := results.ConvertFromXML(strings.NewReader(str))
+		So(err, ShouldBeNil)
+		So(results.Suites, ShouldHaveLength, 1)
+		So(results.Suites[0].TestCases, ShouldHaveLength, 1)
+	})
+
+	Convey(`From XML fails with unknown root`, t, func() {
+		results := &JUnitResults{}
+		err := results.ConvertFromXML(strings.NewReader(`<html></html>`))
+		So(err, ShouldErrLike, "unexpected root element")
+	})
+
+	Convey(`ToProtos`, t, func() {
+		Convey("Works", func() {
+			results := &JUnitResults{
+				Suites: []*JUnitTestSuite{
+					{
+						Name: "pytest",
+						Properties: []*JUnitProperty{
+							{Name: "Build.Flavor", Value: "debug"},
+						},
+						TestCases: []*JUnitTestCase{
+							{
+								Name:      "test_pass",
+								ClassName: "tests.test_foo",
+								Time:      0.5,
+								File:      "../../tests/test_foo.py",
+								Line:      10,
+							},
+							{
+								Name:      "test_fail",
+								ClassName: "tests.test_foo",
+								Failures: []*JUnitFailure{
+									{
+										Message: "assert 1 == 2",
+										Text:    "tests/test_foo.py:20: AssertionError",
+									},
+								},
+							},
+							{
+								Name: "test_skip",
+								Skipped: &JUnitFailure{
+									Message: "no network",
+								},
+							},
+						},
+					},
+				},
+			}
+
+			testResults, err := results.ToProtos(ctx, nil, chromiumSrcRepo)
+			So(err, ShouldBeNil)
+			So(testResults, ShouldHaveLength, 3)
+			So(testResults[0], ShouldResembleProto, &sinkpb.TestResult{
+				TestId:   "tests.test_foo.test_pass",
+				Expected: true,
+				Status:   pb.TestStatus_PASS,
+				Duration: ptypes.DurationProto(500 * 1e6),
+				Tags: pbutil.StringPairs(
+					"test_name", "test_pass",
+					"junit_classname", "tests.test_foo",
+					"junit_status", "passed",
+					"junit_suite", "pytest",
+					"build_flavor", "debug",
+					originalFormatTagKey, formatJUnit,
+				),
+				TestMetadata: &pb.TestMetadata{
+					Name: "tests.test_foo.test_pass",
+					Location: &pb.TestLocation{
+						Repo:     chromiumSrcRepo,
+						FileName: "//tests/test_foo.py",
+						Line:     10,
+					},
+				},
+			})
+			So(testResults[1].Status, ShouldEqual, pb.TestStatus_FAIL)
+			So(testResults[1].Expected, ShouldBeFalse)
+			So(testResults[1].FailureReason, ShouldResembleProto, &pb.FailureReason{
+				PrimaryErrorMessage: "assert 1 == 2",
+				Errors: []*pb.FailureReason_Error{
+					{Message: "assert 1 == 2"},
+				},
+			})
+			So(testResults[1].Artifacts, ShouldContainKey, "failure")
+			So(testResults[1].SummaryHtml, ShouldContainSubstring, `<text-artifact artifact-id="failure" />`)
+			So(testResults[2].TestId, ShouldEqual, "test_skip")
+			So(testResults[2].Status, ShouldEqual, pb.TestStatus_SKIP)
+			So(testResults[2].Expected, ShouldBeTrue)
+			So(testResults[2].Tags[1], ShouldResembleProto, pbutil.StringPair("skip_reason", "no network"))
+		})
+
+		Convey("Attachments", func() {
+			results := &JUnitResults{
+				Suites: []*JUnitTestSuite{
+					{
+						TestCases: []*JUnitTestCase{
+							{
+								Name:      "test_screenshot",
+								SystemOut: "saved\n[[ATTACHMENT|screenshots/a.png]]\n",
+							},
+						},
+					},
+				},
+			}
+			normPathToFullPath := map[string]string{
+				"screenshots/a.png": "/artifacts/screenshots/a.png",
+			}
+
+			testResults, err := results.ToProtos(ctx, normPathToFullPath, chromiumSrcRepo)
+			So(err, ShouldBeNil)
+			So(testResults, ShouldHaveLength, 1)
+			So(testResults[0].Artifacts, ShouldHaveLength, 2)
+			So(testResults[0].Artifacts["stdout"], ShouldResembleProto, &sinkpb.Artifact{
+				Body:        &sinkpb.Artifact_Contents{Contents: []byte("saved\n[[ATTACHMENT|screenshots/a.png]]\n")},
+				ContentType: "text/plain",
+			})
+			So(testResults[0].Artifacts["a.png"], ShouldResembleProto, &sinkpb.Artifact{
+				Body: &sinkpb.Artifact_FilePath{FilePath: "/artifacts/screenshots/a.png"},
+			})
+		})
+
+		Convey("Long tags are truncated", func() {
+			long := strings.Repeat("a", 300)
+			results := &JUnitResults{
+				Suites: []*JUnitTestSuite{
+					{
+						Name:       long,
+						Properties: []*JUnitProperty{{Name: long, Value: "v"}},
+						TestCases:  []*JUnitTestCase{{Name: long, ClassName: long}},
+					},
+				},
+			}
+
+			testResults, err := results.ToProtos(ctx, nil, chromiumSrcRepo)
+			So(err, ShouldBeNil)
+			So(testResults, ShouldHaveLength, 1)
+			truncated := long[:maxTagValueBytes-3] + "..."
+			So(testResults[0].Tags, ShouldResembleProto, pbutil.StringPairs(
+				"test_name", truncated,
+				"junit_classname", truncated,
+				"junit_status", "passed",
+				"junit_suite", truncated,
+				long[:maxTagKeyBytes], "v",
+				originalFormatTagKey, formatJUnit,
+			))
+		})
+	})
+}
@@ -41,8 +41,10 @@ func makeApp(ctxFunc func(context.Context) context.Context) *cli.Application {
 			cmdGtest(),
 			cmdGtestJson(),
 			cmdJSON(),
+			cmdJUnit(),
 			cmdNative(),
 			cmdSingle(),
+			cmdTAP(),
 			cmdTast(),
 			cmdSkylabTestRunner(),
 
@@ -0,0 +1,264 @@
+// Copyright 2024 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"go.chromium.org/luci/common/logging"
+	"go.chromium.org/luci/resultdb/pbutil"
+	pb "go.chromium.org/luci/resultdb/proto/v1"
+	sinkpb "go.chromium.org/luci/resultdb/sink/proto/v1"
+)
+
+const (
+	// formatTAP is Test Anything Protocol format.
+	formatTAP = "tap"
+)
+
+var (
+	// tapVersionRE matches the version line, e.g. "TAP version 14".
+	tapVersionRE = regexp.MustCompile(`^TAP version (\d+)$`)
+
+	// tapPlanRE matches the plan line, e.g. "1..10" or "1..0 # SKIP no tests".
+	tapPlanRE = regexp.MustCompile(`^1\.\.(\d+)`)
+
+	// tapTestRE matches the test line, e.g.
+	// - ok 1 - foo works
+	// - not ok 2 bar works # TODO not implemented yet
+	// - ok 3 # SKIP no network
+	tapTestRE = regexp.MustCompile(`^(not )?ok\b\s*(\d+)?\s*(?:-\s*)?(.*?)\s*(?:#\s*(?i:(skip|todo))\S*\s*(.*))?$`)
+
+	// tapSubtestRE matches the subtest comment in TAP 14, e.g.
+	// "# Subtest: foo".
+	tapSubtestRE = regexp.MustCompile(`^#\s*Subtest:?\s*(.*)$`)
+
+	// tapBailOutRE matches the bail out line, e.g. "Bail out! no database".
+	tapBailOutRE = regexp.MustCompile(`^Bail out!\s*(.*)$`)
+
+	// Keys in the YAML diagnostics block.
+	tapMessageRE = regexp.MustCompile(`(?m)^\s*message:\s*(.+)$`)
+	tapFileRE    = regexp.MustCompile(`(?m)^\s*file:\s*(\S+)\s*$`)
+	tapLineRE    = regexp.MustCompile(`(?m)^\s*line:\s*(\d+)\s*$`)
+)
+
+// TAPResults represents the Test Anything Protocol output.
+// https://testanything.org/tap-version-14-specification.html
+type TAPResults struct {
+	Version int
+	// Plan is the number of planned top level tests, or -1 if no plan.
+	Plan  int
+	Tests []*TAPTest
+	// BailOut is the reason of "Bail out!", if the tests bailed out.
+	BailOut string
+	bailed  bool
+}
+
+// TAPTest represents a test line in TAP.
+type TAPTest struct {
+	OK          bool
+	Number      int
+	Description string
+	// Directive is "SKIP" or "TODO", if any.
+	Directive string
+	Reason    string
+	// Parents are names of the subtests containing this test.
+	Parents []string
+	// Diagnostics is the YAML diagnostics block of the test.
+	Diagnostics string
+}
+
+// ConvertFromReader reads the provided reader into the receiver.
+//
+// The receiver is cleared and its fields overwritten.
+func (r *TAPResults) ConvertFromReader(reader io.Reader) error {
+	*r = TAPResults{Plan: -1}
+	s := bufio.NewScanner(reader)
+	s.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+
+	// subtest names by indent level.
+	var subtests []string
+	var last *TAPTest
+	yamlIndent := -1
+	var yaml strings.Builder
+	for s.Scan() {
+		line := strings.TrimRight(s.Text(), "\r")
+		trimmed := strings.TrimLeft(line, " \t")
+		indent := len(line) - len(trimmed)
+		if yamlIndent >= 0 {
+			if indent == yamlIndent && trimmed == "..." {
+				last.Diagnostics = yaml.String()
+				yamlIndent = -1
+				continue
+			}
+			if len(line) >= yamlIndent {
+				line = line[yamlIndent:]
+			}
+			yaml.WriteString(line)
+			yaml.WriteString("\n")
+			continue
+		}
+		level := indent / 4
+		switch {
+		case last != nil && trimmed == "---" && indent > 0:
+			yamlIndent = indent
+			yaml.Reset()
+		case tapVersionRE.MatchString(trimmed):
+			v, _ := strconv.Atoi(tapVersionRE.FindStringSubmatch(trimmed)[1])
+			r.Version = v
+		case level == 0 && tapPlanRE.MatchString(trimmed):
+			n, _ := strconv.Atoi(tapPlanRE.FindStringSubmatch(trimmed)[1])
+			r.Plan = n
+		case tapBailOutRE.MatchString(trimmed):
+			r.BailOut = tapBailOutRE.FindStringSubmatch(trimmed)[1]
+			r.bailed = true
+		case tapSubtestRE.MatchString(trimmed):
+			for len(subtests) <= level {
+				subtests = append(subtests, "")
+			}
+			subtests = subtests[:level+1]
+			subtests[level] = strings.TrimSpace(tapSubtestRE.FindStringSubmatch(trimmed)[1])
+		case tapTestRE.MatchString(trimmed):
+			m := tapTestRE.FindStringSubmatch(trimmed)
+			t := &TAPTest{
+				OK:          m[1] == "",
+				Description: m[3],
+				Directive:   strings.ToUpper(m[4]),
+				Reason:      m[5],
+			}
+			t.Number, _ = strconv.Atoi(m[2])
+			for l := 1; l <= level && l < len(subtests); l++ {
+				t.Parents = append(t.Parents, subtests[l])
+			}
+			if len(subtests) > level+1 {
+				subtests = subtests[:level+1]
+			}
+			r.Tests = append(r.Tests, t)
+			last = t
+		}
+	}
+	if yamlIndent >= 0 {
+		// unterminated YAML block.
+		last.Diagnostics = yaml.String()
+	}
+	return s.Err()
+}
+
+// testID returns test id of the test.
+// Subtests are prefixed with the names of the parent tests.
+func (t *TAPTest) testID() string {
+	name := t.Description
+	if name == "" {
+		name = fmt.Sprintf("test %d", t.Number)
+	}
+	return strings.Join(append(t.Parents[:len(t.Parents):len(t.Parents)], name), "/")
+}
+
+// ToProtos converts test results in r to []*sinkpb.TestResult.
+// repo is the repository used for the test locations.
+func (r *TAPResults) ToProtos(ctx context.Context, repo string) ([]*sinkpb.TestResult, error) {
+	var ret []*sinkpb.TestResult
+	var buf bytes.Buffer
+	topLevel := 0
+	for _, t := range r.Tests {
+		if len(t.Parents) == 0 {
+			topLevel++
+		}
+		tr, err := t.toProto(ctx, &buf, repo)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, tr)
+	}
+	switch {
+	case r.bailed:
+		logging.Warningf(ctx, "TAP bailed out after %d tests: %s", topLevel, r.BailOut)
+	case r.Plan >= 0 && r.Plan != topLevel:
+		logging.Warningf(ctx, "TAP planned %d tests, but ran %d tests", r.Plan, topLevel)
+	}
+	return ret, nil
+}
+
+func (t *TAPTest) toProto(ctx context.Context, buf *bytes.Buffer, repo string) (*sinkpb.TestResult, error) {
+	testID := t.testID()
+	tr := &sinkpb.TestResult{
+		TestId:       testID,
+		Tags:         AppendTags(nil, "test_name", testID),
+		TestMetadata: &pb.TestMetadata{Name: testID},
+	}
+	tr.Tags = AppendTags(tr.Tags, "tap_number", strconv.Itoa(t.Number))
+	switch {
+	case t.Directive == "SKIP":
+		tr.Status = pb.TestStatus_SKIP
+		tr.Expected = true
+	case t.OK:
+		tr.Status = pb.TestStatus_PASS
+		tr.Expected = true
+	case t.Directive == "TODO":
+		// failing TODO test is expected to fail.
+		tr.Status = pb.TestStatus_FAIL
+		tr.Expected = true
+	default:
+		tr.Status = pb.TestStatus_FAIL
+	}
+	if t.Directive != "" {
+		tr.Tags = AppendTags(tr.Tags, "tap_directive", strings.ToLower(t.Directive))
+		tr.Tags = AppendTags(tr.Tags, "tap_reason", t.Reason)
+	}
+	tr.Tags = append(tr.Tags, pbutil.StringPair(originalFormatTagKey, formatTAP))
+
+	if tr.Status == pb.TestStatus_FAIL {
+		message := t.Description
+		if m := tapMessageRE.FindStringSubmatch(t.Diagnostics); m != nil {
+			message = strings.Trim(strings.TrimSpace(m[1]), `'"`)
+		}
+		if message != "" {
+			primaryError := truncateString(message, maxErrorMessageBytes)
+			tr.FailureReason = &pb.FailureReason{
+				PrimaryErrorMessage: primaryError,
+				Errors: []*pb.FailureReason_Error{
+					{Message: primaryError},
+				},
+			}
+		}
+	}
+
+	if t.Diagnostics != "" {
+		tr.Artifacts = map[string]*sinkpb.Artifact{"diagnostics": {
+			Body:        &sinkpb.Artifact_Contents{Contents: []byte(t.Diagnostics)},
+			ContentType: "text/plain",
+		}}
+		buf.Reset()
+		if err := summaryTmpl.ExecuteTemplate(buf, "gtest", map[string]interface{}{
+			"text_artifacts": []string{"diagnostics"},
+		}); err != nil {
+			return nil, err
+		}
+		tr.SummaryHtml = buf.String()
+
+		// Store the test code location.
+		if m := tapFileRE.FindStringSubmatch(t.Diagnostics); m != nil {
+			file := normalizePath(strings.Trim(m[1], `'"`))
+			file = stripRepeatedPrefixes(file, "../")
+			file = ensureLeadingDoubleSlash(file)
+			tr.TestMetadata.Location = &pb.TestLocation{
+				Repo:     repo,
+				FileName: file,
+			}
+			if m := tapLineRE.FindStringSubmatch(t.Diagnostics); m != nil {
+				line, _ := strconv.Atoi(m[1])
+				tr.TestMetadata.Location.Line = int32(line)
+			}
+		}
+	}
+	return tr, nil
+}
@@ -0,0 +1,122 @@
+// Copyright 2024 The LUCI Authors. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	. "go.chromium.org/luci/common/testing/assertions"
+	"go.chromium.org/luci/resultdb/pbutil"
+	pb "go.chromium.org/luci/resultdb/proto/v1"
+	sinkpb "go.chromium.org/luci/resultdb/sink/proto/v1"
+)
+
+func TestTAPConversions(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	Convey(`From TAP works`, t, func() {
+		str := `TAP version 14
+1..4
+ok 1 - foo works
+not ok 2 - bar works
+  ---
+  message: 'expected 1, got 2'
+  file: ../../test/bar.js
+  line: 12
+  ...
+ok 3 # SKIP no network
+    # Subtest: baz
+    ok 1 - baz a
+    not ok 2 - baz b # TODO not implemented
+    1..2
+ok 4 - baz
+`
+		results := &TAPResults{}
+		err := results.ConvertFromReader(strings.NewReader(str))
+		So(err, ShouldBeNil)
+		So(results.Version, ShouldEqual, 14)
+		So(results.Plan, ShouldEqual, 4)
+		So(results.Tests, ShouldResemble, []*TAPTest{
+			{OK: true, Number: 1, Description: "foo works"},
+			{
+				Number:      2,
+				Description: "bar works",
+				Diagnostics: "message: 'expected 1, got 2'\nfile: ../../test/bar.js\nline: 12\n",
+			},
+			{OK: true, Number: 3, Directive: "SKIP", Reason: "no network"},
+			{OK: true, Number: 1, Description: "baz a", Parents: []string{"baz"}},
+			{Number: 2, Description: "baz b", Directive: "TODO", Reason: "not implemented", Parents: []string{"baz"}},
+			{OK: true, Number: 4, Description: "baz"},
+		})
+	})
+
+	Convey(`ToProtos`, t, func() {
+		Convey("Works", func() {
+			results := &TAPResults{
+				Plan: 3,
+				Tests: []*TAPTest{
+					{OK: true, Number: 1, Description: "foo works"},
+					{
+						Number:      2,
+						Description: "bar works",
+						Diagnostics: "message: 'expected 1, got 2'\nfile: ../../test/bar.js\nline: 12\n",
+					},
+					{OK: true, Number: 3, Directive: "SKIP", Reason: "no network"},
+					{Number: 2, Description: "baz b", Directive: "TODO", Reason: "not implemented", Parents: []string{"baz"}},
+				},
+			}
+
+			testResults, err := results.ToProtos(ctx, chromiumSrcRepo)
+			So(err, ShouldBeNil)
+			So(testResults, ShouldHaveLength, 4)
+			So(testResults[0], ShouldResembleProto, &sinkpb.TestResult{
+				TestId:   "foo works",
+				Expected: true,
+				Status:   pb.TestStatus_PASS,
+				Tags: pbutil.StringPairs(
+					"test_name", "foo works",
+					"tap_number", "1",
+					originalFormatTagKey, formatTAP,
+				),
+				TestMetadata: &pb.TestMetadata{Name: "foo works"},
+			})
+			So(testResults[1].Status, ShouldEqual, pb.TestStatus_FAIL)
+			So(testResults[1].Expected, ShouldBeFalse)
+			So(testResults[1].FailureReason.PrimaryErrorMessage, ShouldEqual, "expected 1, got 2")
+			So(testResults[1].TestMetadata.Location, ShouldResembleProto, &pb.TestLocation{
+				Repo:     chromiumSrcRepo,
+				FileName: "//test/bar.js",
+				Line:     12,
+			})
+			So(testResults[1].Artifacts, ShouldContainKey, "diagnostics")
+			So(testResults[2].TestId, ShouldEqual, "test 3")
+			So(testResults[2].Status, ShouldEqual, pb.TestStatus_SKIP)
+			So(testResults[2].Expected, ShouldBeTrue)
+			So(testResults[3].TestId, ShouldEqual, "baz/baz b")
+			So(testResults[3].Status, ShouldEqual, pb.TestStatus_FAIL)
+			So(testResults[3].Expected, ShouldBeTrue)
+			So(testResults[3].Tags[2], ShouldResembleProto, pbutil.StringPair("tap_directive", "todo"))
+		})
+
+		Convey("Long test names are truncated in tags", func() {
+			long := strings.Repeat("a", 300)
+			results := &TAPResults{
+				Plan:  1,
+				Tests: []*TAPTest{{OK: true, Number: 1, Description: long}},
+			}
+
+			testResults, err := results.ToProtos(ctx, chromiumSrcRepo)
+			So(err, ShouldBeNil)
+			So(testResults, ShouldHaveLength, 1)
+			So(testResults[0].TestId, ShouldEqual, long)
+			So(testResults[0].Tags[0], ShouldResembleProto, pbutil.StringPair("test_name", long[:maxTagValueBytes-3]+"..."))
+		})
+	})
+}
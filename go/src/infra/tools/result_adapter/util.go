@@ -55,6 +55,9 @@ const (
 	// ResultDB limits the total size of the error protos to 3172 bytes.
 	maxErrorsBytes = 3*1024 + 100
 
+	// ResultSink limits a tag's key size to 64 bytes.
+	maxTagKeyBytes = 64
+
 	// ResultSink limits a tag's value size to 256 bytes.
 	maxTagValueBytes = 256
 
@@ -10,6 +10,7 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"strings"
 
 	"github.com/golang/protobuf/jsonpb"
 	proto "github.com/golang/protobuf/proto"
@@ -106,3 +107,33 @@ func FilterFiles(files []*Data_File, filters ...string) ([]*Data_File, error) {
 	}
 	return filteredFiles, nil
 }
+
+// SplitCommand splits a command line, e.g. the value of a flag giving an
+// analyzer command, into arguments at spaces, except the ones escaped with a
+// backslash.
+func SplitCommand(s string) []string {
+	var args []string
+	var arg strings.Builder
+	inArg := false
+	for i := 0; i < len(s); i++ {
+		switch ch := s[i]; {
+		case ch == '\\' && i+1 < len(s) && s[i+1] == ' ':
+			arg.WriteByte(' ')
+			inArg = true
+			i++
+		case ch == ' ' || ch == '\t':
+			if inArg {
+				args = append(args, arg.String())
+				arg.Reset()
+				inArg = false
+			}
+		default:
+			arg.WriteByte(ch)
+			inArg = true
+		}
+	}
+	if inArg {
+		args = append(args, arg.String())
+	}
+	return args
+}
@@ -63,3 +63,11 @@ func TestFilterFiles(t *testing.T) {
 		So(filtered, ShouldResemble, files)
 	})
 }
+
+func TestSplitCommand(t *testing.T) {
+	Convey("Commands are split at unescaped spaces", t, func() {
+		So(SplitCommand(""), ShouldBeEmpty)
+		So(SplitCommand("semgrep --sarif"), ShouldResemble, []string{"semgrep", "--sarif"})
+		So(SplitCommand(`  semgrep  --config=my\ rules.yaml --sarif `), ShouldResemble, []string{"semgrep", "--config=my rules.yaml", "--sarif"})
+	})
+}
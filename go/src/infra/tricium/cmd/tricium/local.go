@@ -0,0 +1,152 @@
+// Copyright 2024 The Chromium Authors
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/maruel/subcommands"
+
+	"go.chromium.org/luci/common/cli"
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/flag/stringlistflag"
+
+	tricium "infra/tricium/api/v1"
+	"infra/tricium/local"
+)
+
+var cmdLocal = &subcommands.Command{
+	UsageLine: "local [-base <ref>] [-fix] -analyzer <command> [-analyzer <command>...]",
+	ShortDesc: "runs analyzers against the local changes",
+	LongDesc: `Runs analyzers against the local changes.
+
+Runs git-file-isolator over the files changed in the working tree, then a chain
+of analyzers, and prints the comments inline with the diff. As in Tricium
+workflows, every analyzer consumes the data type provided by the previous one,
+starting with the FILES of the isolator, and the last one must provide RESULTS.
+
+Without -base, only the uncommitted changes are analyzed. With -base, all
+changes since the merge base of the ref and HEAD are, e.g. -base=origin/main
+for the changes of the current branch. Untracked files are not analyzed.
+
+Analyzers are commands taking -input and -output flags, like the functions in
+tricium/functions, e.g. -analyzer="spacey" or
+-analyzer="sarif_tricium -command=semgrep\ --sarif". They are given with
+repeated -analyzer flags or in a file given with -config, one per line, in the
+order of the chain.
+
+With -fix, the first suggestion of every comment is applied to the working tree.
+`,
+
+	CommandRun: func() subcommands.CommandRun {
+		c := &cmdLocalRun{}
+		c.Flags.StringVar(&c.base, "base", "", "Ref to analyze the changes since, the uncommitted changes are analyzed if empty.")
+		c.Flags.StringVar(&c.isolator, "isolator", "git-file-isolator", "The git-file-isolator command.")
+		c.Flags.Var(&c.analyzers, "analyzer", "Analyzer command (can be repeated multiple times to form a chain).")
+		c.Flags.StringVar(&c.config, "config", "", "File with an analyzer command per line, empty lines and lines starting with # are ignored.")
+		c.Flags.BoolVar(&c.fix, "fix", false, "Apply the suggested fixes to the working tree.")
+		c.Flags.BoolVar(&c.verbose, "verbose", false, "Print the output of the functions.")
+		return c
+	},
+}
+
+type cmdLocalRun struct {
+	subcommands.CommandRunBase
+
+	base      string
+	isolator  string
+	analyzers stringlistflag.Flag
+	config    string
+	fix       bool
+	verbose   bool
+}
+
+func (c *cmdLocalRun) Run(a subcommands.Application, args []string, env subcommands.Env) int {
+	ctx := cli.GetContext(a, c, env)
+	if err := c.exec(ctx, a, args); err != nil {
+		fmt.Fprintf(a.GetErr(), "%s: %s\n", a.GetName(), err)
+		return 1
+	}
+	return 0
+}
+
+func (c *cmdLocalRun) exec(ctx context.Context, a subcommands.Application, args []string) error {
+	if len(args) > 0 {
+		return errors.Reason("local: positional arguments not supported").Err()
+	}
+	analyzers, err := c.analyzerCommands()
+	if err != nil {
+		return errors.Annotate(err, "local").Err()
+	}
+	if len(analyzers) == 0 {
+		return errors.Reason("local: -analyzer or -config is required").Err()
+	}
+	dir, err := os.Getwd()
+	if err != nil {
+		return errors.Annotate(err, "local").Err()
+	}
+
+	r := &local.Runner{
+		Dir:       dir,
+		Base:      c.base,
+		Isolator:  tricium.SplitCommand(c.isolator),
+		Analyzers: analyzers,
+	}
+	if c.verbose {
+		r.Output = a.GetErr()
+	}
+	change, results, err := r.Run(ctx)
+	if err != nil {
+		return errors.Annotate(err, "local").Err()
+	}
+	if err := local.PrintResults(ctx, a.GetOut(), change, results); err != nil {
+		return errors.Annotate(err, "local").Err()
+	}
+	fmt.Fprintf(a.GetOut(), "%d comments on %d changed files.\n", len(results.Comments), len(change.Files))
+
+	if c.fix {
+		n, err := local.ApplyFixes(ctx, change.Root, results.Comments)
+		if err != nil {
+			return errors.Annotate(err, "local").Err()
+		}
+		fmt.Fprintf(a.GetOut(), "Applied %d fixes.\n", n)
+	}
+	return nil
+}
+
+// analyzerCommands returns the analyzer commands given by the flags and the
+// config file.
+func (c *cmdLocalRun) analyzerCommands() ([][]string, error) {
+	var lines []string
+	lines = append(lines, c.analyzers...)
+	if c.config != "" {
+		f, err := os.Open(c.config)
+		if err != nil {
+			return nil, errors.Annotate(err, "analyzer commands").Err()
+		}
+		defer f.Close()
+		s := bufio.NewScanner(f)
+		for s.Scan() {
+			line := strings.TrimSpace(s.Text())
+			if line != "" && !strings.HasPrefix(line, "#") {
+				lines = append(lines, line)
+			}
+		}
+		if err := s.Err(); err != nil {
+			return nil, errors.Annotate(err, "analyzer commands: read %q", c.config).Err()
+		}
+	}
+	var commands [][]string
+	for _, l := range lines {
+		if cmd := tricium.SplitCommand(l); len(cmd) > 0 {
+			commands = append(commands, cmd)
+		}
+	}
+	return commands, nil
+}
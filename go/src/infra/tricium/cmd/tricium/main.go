@@ -0,0 +1,36 @@
+// Copyright 2024 The Chromium Authors
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+// Binary tricium is a command line tool for Tricium analyzers.
+package main
+
+import (
+	"context"
+	"os"
+
+	"github.com/maruel/subcommands"
+
+	"go.chromium.org/luci/common/cli"
+	"go.chromium.org/luci/common/logging/gologger"
+)
+
+func getApplication() *cli.Application {
+	return &cli.Application{
+		Name:  "tricium",
+		Title: "Command line tool for Tricium analyzers",
+
+		Context: func(ctx context.Context) context.Context {
+			return gologger.StdConfig.Use(ctx)
+		},
+
+		Commands: []*subcommands.Command{
+			subcommands.CmdHelp,
+			cmdLocal,
+		},
+	}
+}
+
+func main() {
+	os.Exit(subcommands.Run(getApplication(), nil))
+}
@@ -0,0 +1,377 @@
+// Copyright 2024 The Chromium Authors
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+// Package converter converts between SARIF logs and Tricium results.
+package converter
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	tricium "infra/tricium/api/v1"
+)
+
+const (
+	// commitMessagePath is the path Gerrit uses for the commit message, which
+	// is the empty path in Tricium.
+	commitMessagePath = "/COMMIT_MSG"
+
+	// srcRootBaseID is the conventional base of relative URIs.
+	srcRootBaseID = "%SRCROOT%"
+)
+
+// ToResults converts a SARIF log to Tricium results.
+//
+// Absolute file URIs must be under basePath and are made relative to it;
+// relative URIs are expected to be relative to basePath already. URI base IDs
+// are resolved with the originalUriBaseIds of the run, except %SRCROOT%, which
+// is basePath if the run doesn't define it; other undefined base IDs are
+// rejected. Categories
+// are the category (or the name of the tool if empty) followed by the rule ID,
+// e.g. "ClangTidy/llvm-header-guard".
+//
+// Results without a location in a file are skipped, as are fixes with
+// regions that can't be expressed as Tricium replacements.
+func ToResults(log *Log, basePath, category string) (*tricium.Data_Results, error) {
+	results := &tricium.Data_Results{}
+	for _, run := range log.Runs {
+		prefix := category
+		if prefix == "" {
+			prefix = run.Tool.Driver.Name
+		}
+		for _, result := range run.Results {
+			comment, err := toComment(&run, &result, basePath, prefix)
+			if err != nil {
+				return nil, err
+			}
+			if comment != nil {
+				results.Comments = append(results.Comments, comment)
+			}
+		}
+	}
+	return results, nil
+}
+
+// toComment converts a SARIF result to a Tricium comment.
+func toComment(run *Run, result *Result, basePath, prefix string) (*tricium.Data_Comment, error) {
+	var loc *PhysicalLocation
+	for _, l := range result.Locations {
+		if l.PhysicalLocation != nil {
+			loc = l.PhysicalLocation
+			break
+		}
+	}
+	if loc == nil {
+		return nil, nil
+	}
+	p, err := relativePath(run, loc.ArtifactLocation, basePath)
+	if err != nil {
+		return nil, err
+	}
+
+	rule := findRule(run, result)
+	ruleID := result.RuleID
+	if ruleID == "" && rule != nil {
+		ruleID = rule.ID
+	}
+	category := prefix
+	if ruleID != "" {
+		category += "/" + ruleID
+	}
+	message := messageText(result.Message, rule)
+	if rule != nil && rule.HelpURI != "" {
+		message += "\n\n" + rule.HelpURI
+	}
+
+	comment := &tricium.Data_Comment{
+		Category: category,
+		Message:  message,
+		Path:     p,
+	}
+	if r := loc.Region; r != nil && r.StartLine > 0 {
+		comment.StartLine = r.StartLine
+		comment.EndLine = r.EndLine
+		if comment.EndLine == 0 {
+			comment.EndLine = r.StartLine
+		}
+		// SARIF uses 1-based columns, but Tricium needs 0-based columns.
+		if r.StartColumn > 0 && r.EndColumn > 0 {
+			comment.StartChar = r.StartColumn - 1
+			comment.EndChar = r.EndColumn - 1
+		}
+	}
+
+	for _, fix := range result.Fixes {
+		suggestion, err := toSuggestion(run, fix, basePath)
+		if err != nil {
+			return nil, err
+		}
+		if suggestion != nil {
+			comment.Suggestions = append(comment.Suggestions, suggestion)
+		}
+	}
+	return comment, nil
+}
+
+// toSuggestion converts a SARIF fix to a Tricium suggestion.
+//
+// Returns nil if any of the replacements can't be converted, since applying
+// only a part of a fix could break the code.
+func toSuggestion(run *Run, fix Fix, basePath string) (*tricium.Data_Suggestion, error) {
+	suggestion := &tricium.Data_Suggestion{}
+	if fix.Description != nil {
+		suggestion.Description = fix.Description.Text
+	}
+	for _, change := range fix.ArtifactChanges {
+		p, err := relativePath(run, change.ArtifactLocation, basePath)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range change.Replacements {
+			replacement := toReplacement(p, r)
+			if replacement == nil {
+				return nil, nil
+			}
+			suggestion.Replacements = append(suggestion.Replacements, replacement)
+		}
+	}
+	if len(suggestion.Replacements) == 0 {
+		return nil, nil
+	}
+	return suggestion, nil
+}
+
+// toReplacement converts a SARIF replacement to a Tricium replacement.
+//
+// Returns nil if the region has no lines (e.g. only byte offsets), or has no
+// end column, as it then ends at the end of a line without the line break,
+// which can't be expressed without the file content.
+func toReplacement(p string, r Replacement) *tricium.Data_Replacement {
+	d := r.DeletedRegion
+	if d.StartLine == 0 || d.EndColumn == 0 {
+		return nil
+	}
+	replacement := &tricium.Data_Replacement{
+		Path:      p,
+		StartLine: d.StartLine,
+		EndLine:   d.EndLine,
+		// SARIF uses 1-based columns, but Tricium needs 0-based columns.
+		EndChar: d.EndColumn - 1,
+	}
+	if r.InsertedContent != nil {
+		replacement.Replacement = r.InsertedContent.Text
+	}
+	if replacement.EndLine == 0 {
+		replacement.EndLine = d.StartLine
+	}
+	// A region without a start column starts at the beginning of the line.
+	if d.StartColumn > 0 {
+		replacement.StartChar = d.StartColumn - 1
+	}
+	return replacement
+}
+
+// findRule returns the rule of the result, or nil if the tool doesn't
+// describe it.
+func findRule(run *Run, result *Result) *ReportingDescriptor {
+	rules := run.Tool.Driver.Rules
+	if i := result.RuleIndex; i != nil && *i >= 0 && *i < len(rules) {
+		return &rules[*i]
+	}
+	for i := range rules {
+		if rules[i].ID == result.RuleID {
+			return &rules[i]
+		}
+	}
+	return nil
+}
+
+// messageText returns the text of the message, filling in the message string
+// of the rule if the message refers to one.
+func messageText(m Message, rule *ReportingDescriptor) string {
+	if m.Text != "" || m.ID == "" || rule == nil {
+		return m.Text
+	}
+	text := rule.MessageStrings[m.ID].Text
+	for i, arg := range m.Arguments {
+		text = strings.ReplaceAll(text, "{"+strconv.Itoa(i)+"}", arg)
+	}
+	return text
+}
+
+// relativePath returns the Tricium path of a SARIF artifact location.
+func relativePath(run *Run, loc ArtifactLocation, basePath string) (string, error) {
+	if loc.URI == commitMessagePath {
+		return "", nil
+	}
+	u, err := resolveURI(run, loc, map[string]bool{})
+	if err != nil {
+		return "", err
+	}
+	if u.Scheme != "" && u.Scheme != "file" {
+		return "", fmt.Errorf("unsupported URI %q", u)
+	}
+	rel := path.Clean(u.Path)
+	if u.Scheme == "file" || path.IsAbs(rel) {
+		r, err := filepath.Rel(basePath, filepath.FromSlash(u.Path))
+		if err != nil {
+			return "", fmt.Errorf("failed to get relative path from %q to %q: %v", basePath, u.Path, err)
+		}
+		rel = filepath.ToSlash(r)
+	}
+	if rel == ".." || strings.HasPrefix(rel, "../") {
+		return "", fmt.Errorf("URI %q is outside of %q", u, basePath)
+	}
+	return rel, nil
+}
+
+// resolveURI returns the URI of an artifact location, resolving its URI base
+// ID. seen holds the base IDs being resolved, to detect cycles.
+func resolveURI(run *Run, loc ArtifactLocation, seen map[string]bool) (*url.URL, error) {
+	u, err := url.Parse(loc.URI)
+	if err != nil {
+		return nil, fmt.Errorf("invalid URI %q: %v", loc.URI, err)
+	}
+	if loc.URIBaseID == "" || u.Scheme != "" || path.IsAbs(u.Path) {
+		return u, nil
+	}
+	base, ok := run.OriginalURIBaseIDs[loc.URIBaseID]
+	switch {
+	case !ok && loc.URIBaseID == srcRootBaseID:
+		return u, nil
+	case !ok:
+		return nil, fmt.Errorf("undefined URI base ID %q of %q", loc.URIBaseID, loc.URI)
+	case base.URI == "":
+		return nil, fmt.Errorf("URI base ID %q of %q has no URI", loc.URIBaseID, loc.URI)
+	case seen[loc.URIBaseID]:
+		return nil, fmt.Errorf("URI base ID %q refers to itself", loc.URIBaseID)
+	}
+	seen[loc.URIBaseID] = true
+	b, err := resolveURI(run, base, seen)
+	if err != nil {
+		return nil, err
+	}
+	b.Path = path.Join(b.Path, u.Path)
+	b.RawPath = ""
+	return b, nil
+}
+
+// FromResults converts Tricium results to a SARIF log.
+//
+// Comments are grouped in runs by the root of their categories, which is the
+// analyzer name, and the rest of the category is the rule ID.
+func FromResults(results *tricium.Data_Results) *Log {
+	log := &Log{
+		Schema:  Schema,
+		Version: Version,
+		Runs:    []Run{},
+	}
+	runs := map[string]*Run{}
+	var names []string
+	for _, comment := range results.Comments {
+		name, ruleID := comment.Category, ""
+		if i := strings.IndexByte(name, '/'); i >= 0 {
+			name, ruleID = name[:i], name[i+1:]
+		}
+		run, ok := runs[name]
+		if !ok {
+			run = &Run{
+				Tool:    Tool{Driver: ToolComponent{Name: name}},
+				Results: []Result{},
+			}
+			runs[name] = run
+			names = append(names, name)
+		}
+		run.Results = append(run.Results, fromComment(run, comment, ruleID))
+	}
+	for _, name := range names {
+		log.Runs = append(log.Runs, *runs[name])
+	}
+	return log
+}
+
+// fromComment converts a Tricium comment to a SARIF result, adding its rule
+// to the run.
+func fromComment(run *Run, comment *tricium.Data_Comment, ruleID string) Result {
+	result := Result{
+		RuleID:  ruleID,
+		Message: Message{Text: comment.Message},
+		Locations: []Location{{
+			PhysicalLocation: &PhysicalLocation{
+				ArtifactLocation: artifactLocation(comment.Path),
+			},
+		}},
+	}
+	if ruleID != "" {
+		index := -1
+		for i, rule := range run.Tool.Driver.Rules {
+			if rule.ID == ruleID {
+				index = i
+				break
+			}
+		}
+		if index < 0 {
+			index = len(run.Tool.Driver.Rules)
+			run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, ReportingDescriptor{ID: ruleID})
+		}
+		result.RuleIndex = &index
+	}
+	if comment.StartLine > 0 {
+		r := &Region{
+			StartLine: comment.StartLine,
+			EndLine:   comment.EndLine,
+		}
+		if comment.StartChar != 0 || comment.EndChar != 0 {
+			// Tricium uses 0-based columns, but SARIF needs 1-based columns.
+			r.StartColumn = comment.StartChar + 1
+			r.EndColumn = comment.EndChar + 1
+		}
+		result.Locations[0].PhysicalLocation.Region = r
+	}
+	for _, s := range comment.Suggestions {
+		result.Fixes = append(result.Fixes, fromSuggestion(s))
+	}
+	return result
+}
+
+// fromSuggestion converts a Tricium suggestion to a SARIF fix.
+func fromSuggestion(s *tricium.Data_Suggestion) Fix {
+	fix := Fix{ArtifactChanges: []ArtifactChange{}}
+	if s.Description != "" {
+		fix.Description = &Message{Text: s.Description}
+	}
+	changes := map[string]int{}
+	for _, r := range s.Replacements {
+		i, ok := changes[r.Path]
+		if !ok {
+			i = len(fix.ArtifactChanges)
+			changes[r.Path] = i
+			fix.ArtifactChanges = append(fix.ArtifactChanges, ArtifactChange{
+				ArtifactLocation: artifactLocation(r.Path),
+			})
+		}
+		fix.ArtifactChanges[i].Replacements = append(fix.ArtifactChanges[i].Replacements, Replacement{
+			DeletedRegion: Region{
+				StartLine:   r.StartLine,
+				StartColumn: r.StartChar + 1,
+				EndLine:     r.EndLine,
+				EndColumn:   r.EndChar + 1,
+			},
+			InsertedContent: &ArtifactContent{Text: r.Replacement},
+		})
+	}
+	return fix
+}
+
+// artifactLocation returns the SARIF artifact location of a Tricium path.
+func artifactLocation(p string) ArtifactLocation {
+	if p == "" {
+		return ArtifactLocation{URI: commitMessagePath}
+	}
+	return ArtifactLocation{URI: p, URIBaseID: srcRootBaseID}
+}
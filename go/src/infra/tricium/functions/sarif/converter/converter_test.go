@@ -0,0 +1,255 @@
+// Copyright 2024 The Chromium Authors
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package converter
+
+import (
+	"encoding/json"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	tricium "infra/tricium/api/v1"
+)
+
+func TestToResults(t *testing.T) {
+	t.Parallel()
+
+	location := func(uri string, region *Region) []Location {
+		return []Location{{
+			PhysicalLocation: &PhysicalLocation{
+				ArtifactLocation: ArtifactLocation{URI: uri},
+				Region:           region,
+			},
+		}}
+	}
+	index := func(i int) *int { return &i }
+
+	Convey("ToResults", t, func() {
+		Convey("Results are converted to comments", func() {
+			log := &Log{
+				Version: Version,
+				Runs: []Run{{
+					Tool: Tool{Driver: ToolComponent{
+						Name: "Semgrep",
+						Rules: []ReportingDescriptor{
+							{ID: "go.lang.security.audit", HelpURI: "https://semgrep.dev/r/go.lang.security.audit"},
+							{ID: "unused", MessageStrings: map[string]MultiformatString{"default": {Text: "{0} is unused in {1}"}}},
+						},
+					}},
+					Results: []Result{
+						{
+							RuleID:    "go.lang.security.audit",
+							Message:   Message{Text: "Audit this"},
+							Locations: location("pkg/main.go", &Region{StartLine: 3, StartColumn: 2, EndLine: 4, EndColumn: 10}),
+						},
+						{
+							RuleIndex: index(1),
+							Message:   Message{ID: "default", Arguments: []string{"x", "f"}},
+							Locations: location("./pkg/util.go", &Region{StartLine: 7}),
+						},
+						{
+							Message:   Message{Text: "File-level"},
+							Locations: location("file:///src/checkout/README.md", nil),
+						},
+						{
+							Message: Message{Text: "No location"},
+						},
+					},
+				}},
+			}
+			results, err := ToResults(log, "/src/checkout", "")
+			So(err, ShouldBeNil)
+			So(results, ShouldResemble, &tricium.Data_Results{
+				Comments: []*tricium.Data_Comment{
+					{
+						Category:  "Semgrep/go.lang.security.audit",
+						Message:   "Audit this\n\nhttps://semgrep.dev/r/go.lang.security.audit",
+						Path:      "pkg/main.go",
+						StartLine: 3,
+						EndLine:   4,
+						StartChar: 1,
+						EndChar:   9,
+					},
+					{
+						Category:  "Semgrep/unused",
+						Message:   "x is unused in f",
+						Path:      "pkg/util.go",
+						StartLine: 7,
+						EndLine:   7,
+					},
+					{
+						Category: "Semgrep",
+						Message:  "File-level",
+						Path:     "README.md",
+					},
+				},
+			})
+		})
+
+		Convey("The category overrides the tool name", func() {
+			log := &Log{Runs: []Run{{
+				Tool:    Tool{Driver: ToolComponent{Name: "golangci-lint"}},
+				Results: []Result{{RuleID: "errcheck", Locations: location("a.go", nil)}},
+			}}}
+			results, err := ToResults(log, "/src", "GolangCILint")
+			So(err, ShouldBeNil)
+			So(results.Comments[0].Category, ShouldEqual, "GolangCILint/errcheck")
+		})
+
+		Convey("Paths outside of the base path are rejected", func() {
+			for _, uri := range []string{"file:///elsewhere/a.go", "../a.go", "https://example.com/a.go"} {
+				log := &Log{Runs: []Run{{Results: []Result{{Locations: location(uri, nil)}}}}}
+				_, err := ToResults(log, "/src", "Tool")
+				So(err, ShouldNotBeNil)
+			}
+		})
+
+		Convey("URI base IDs are resolved", func() {
+			baseLocation := func(uri, baseID string) []Location {
+				l := location(uri, nil)
+				l[0].PhysicalLocation.ArtifactLocation.URIBaseID = baseID
+				return l
+			}
+			run := Run{
+				OriginalURIBaseIDs: map[string]ArtifactLocation{
+					"SRCROOT": {URI: "file:///src/checkout/"},
+					"PKG":     {URI: "pkg/", URIBaseID: "SRCROOT"},
+					"UNKNOWN": {},
+					"LOOP":    {URI: "loop/", URIBaseID: "LOOP"},
+				},
+				Results: []Result{
+					{Locations: baseLocation("main.go", "SRCROOT")},
+					{Locations: baseLocation("util.go", "PKG")},
+					{Locations: baseLocation("file:///src/checkout/abs.go", "PKG")},
+					{Locations: baseLocation("root.go", srcRootBaseID)},
+				},
+			}
+			results, err := ToResults(&Log{Runs: []Run{run}}, "/src/checkout", "Tool")
+			So(err, ShouldBeNil)
+			var paths []string
+			for _, c := range results.Comments {
+				paths = append(paths, c.Path)
+			}
+			So(paths, ShouldResemble, []string{"main.go", "pkg/util.go", "abs.go", "root.go"})
+
+			for _, baseID := range []string{"OTHER", "UNKNOWN", "LOOP"} {
+				run.Results = []Result{{Locations: baseLocation("a.go", baseID)}}
+				_, err := ToResults(&Log{Runs: []Run{run}}, "/src/checkout", "Tool")
+				So(err, ShouldNotBeNil)
+			}
+		})
+
+		Convey("Fixes are converted to suggestions", func() {
+			fix := func(regions ...Region) Fix {
+				change := ArtifactChange{ArtifactLocation: ArtifactLocation{URI: "a.cc"}}
+				for _, r := range regions {
+					change.Replacements = append(change.Replacements, Replacement{
+						DeletedRegion:   r,
+						InsertedContent: &ArtifactContent{Text: "new"},
+					})
+				}
+				return Fix{Description: &Message{Text: "Fix it"}, ArtifactChanges: []ArtifactChange{change}}
+			}
+			log := &Log{Runs: []Run{{Results: []Result{{
+				Locations: location("a.cc", &Region{StartLine: 1}),
+				Fixes: []Fix{
+					fix(Region{StartLine: 1, StartColumn: 5, EndColumn: 8}, Region{StartLine: 2, EndLine: 4, EndColumn: 1}),
+					// Ends at the end of the line.
+					fix(Region{StartLine: 1, StartColumn: 5}),
+					// Ends at the end of the line, without the line break.
+					fix(Region{StartLine: 2, EndLine: 3}),
+					// Has only byte offsets.
+					fix(Region{}),
+				},
+			}}}}}
+			results, err := ToResults(log, "/src", "Tool")
+			So(err, ShouldBeNil)
+			So(results.Comments[0].Suggestions, ShouldResemble, []*tricium.Data_Suggestion{{
+				Description: "Fix it",
+				Replacements: []*tricium.Data_Replacement{
+					{Path: "a.cc", Replacement: "new", StartLine: 1, EndLine: 1, StartChar: 4, EndChar: 7},
+					// Whole lines 2 and 3, including the last line break.
+					{Path: "a.cc", Replacement: "new", StartLine: 2, EndLine: 4},
+				},
+			}})
+		})
+	})
+}
+
+func TestFromResults(t *testing.T) {
+	t.Parallel()
+
+	results := &tricium.Data_Results{
+		Comments: []*tricium.Data_Comment{
+			{
+				Category:  "ClangTidy/modernize-use-nullptr",
+				Message:   "use nullptr",
+				Path:      "src/main.cc",
+				StartLine: 12,
+				EndLine:   12,
+				StartChar: 10,
+				EndChar:   14,
+				Suggestions: []*tricium.Data_Suggestion{{
+					Description: "Replace NULL with nullptr",
+					Replacements: []*tricium.Data_Replacement{
+						{Path: "src/main.cc", Replacement: "nullptr", StartLine: 12, EndLine: 12, StartChar: 10, EndChar: 14},
+					},
+				}},
+			},
+			{
+				Category: "Spacey",
+				Message:  "Found tab",
+				Path:     "src/util.py",
+			},
+			{
+				Category:  "ClangTidy/modernize-use-nullptr",
+				Message:   "use nullptr",
+				Path:      "src/main.cc",
+				StartLine: 30,
+				EndLine:   30,
+			},
+			{
+				Category:  "CommitCheck/bug",
+				Message:   "Missing bug",
+				StartLine: 1,
+				EndLine:   1,
+			},
+		},
+	}
+
+	Convey("FromResults groups comments by analyzer", t, func() {
+		log := FromResults(results)
+		So(log.Version, ShouldEqual, Version)
+		So(len(log.Runs), ShouldEqual, 3)
+		So(log.Runs[0].Tool.Driver, ShouldResemble, ToolComponent{
+			Name:  "ClangTidy",
+			Rules: []ReportingDescriptor{{ID: "modernize-use-nullptr"}},
+		})
+		So(len(log.Runs[0].Results), ShouldEqual, 2)
+		So(*log.Runs[0].Results[1].RuleIndex, ShouldEqual, 0)
+		So(log.Runs[0].Results[0].Locations[0].PhysicalLocation, ShouldResemble, &PhysicalLocation{
+			ArtifactLocation: ArtifactLocation{URI: "src/main.cc", URIBaseID: srcRootBaseID},
+			Region:           &Region{StartLine: 12, StartColumn: 11, EndLine: 12, EndColumn: 15},
+		})
+		So(log.Runs[1].Tool.Driver.Name, ShouldEqual, "Spacey")
+		So(log.Runs[1].Results[0].RuleIndex, ShouldBeNil)
+		So(log.Runs[2].Results[0].Locations[0].PhysicalLocation.ArtifactLocation.URI, ShouldEqual, commitMessagePath)
+	})
+
+	Convey("Results survive a round trip through SARIF", t, func() {
+		data, err := json.Marshal(FromResults(results))
+		So(err, ShouldBeNil)
+		log := &Log{}
+		So(json.Unmarshal(data, log), ShouldBeNil)
+		got, err := ToResults(log, "/src", "")
+		So(err, ShouldBeNil)
+		So(got.Comments, ShouldHaveLength, len(results.Comments))
+		// Comments are grouped by analyzer.
+		for _, i := range []int{0, 2, 1, 3} {
+			So(got.Comments[0], ShouldResemble, results.Comments[i])
+			got.Comments = got.Comments[1:]
+		}
+	})
+}
@@ -0,0 +1,129 @@
+// Copyright 2024 The Chromium Authors
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package converter
+
+// The following is the subset of SARIF 2.1.0 used by the converter. See:
+// https://docs.oasis-open.org/sarif/sarif/v2.1.0/sarif-v2.1.0.html
+
+const (
+	// Version is the supported SARIF version.
+	Version = "2.1.0"
+	// Schema is the JSON schema of the supported SARIF version.
+	Schema = "https://json.schemastore.org/sarif-2.1.0.json"
+)
+
+// Log is the top-level SARIF object.
+type Log struct {
+	Schema  string `json:"$schema,omitempty"`
+	Version string `json:"version"`
+	Runs    []Run  `json:"runs"`
+}
+
+// Run is the result of a single run of an analysis tool.
+//
+// OriginalURIBaseIDs maps the URI base IDs used by the artifact locations to
+// the locations of their directories.
+type Run struct {
+	Tool               Tool                        `json:"tool"`
+	OriginalURIBaseIDs map[string]ArtifactLocation `json:"originalUriBaseIds,omitempty"`
+	Results            []Result                    `json:"results"`
+}
+
+// Tool describes the analysis tool.
+type Tool struct {
+	Driver ToolComponent `json:"driver"`
+}
+
+// ToolComponent describes the tool, which defines the rules.
+type ToolComponent struct {
+	Name           string                `json:"name"`
+	InformationURI string                `json:"informationUri,omitempty"`
+	Rules          []ReportingDescriptor `json:"rules,omitempty"`
+}
+
+// ReportingDescriptor describes a rule.
+type ReportingDescriptor struct {
+	ID               string                       `json:"id"`
+	ShortDescription *Message                     `json:"shortDescription,omitempty"`
+	HelpURI          string                       `json:"helpUri,omitempty"`
+	MessageStrings   map[string]MultiformatString `json:"messageStrings,omitempty"`
+}
+
+// MultiformatString is a message string of a rule.
+type MultiformatString struct {
+	Text string `json:"text"`
+}
+
+// Result is a single issue found by the tool.
+type Result struct {
+	RuleID    string     `json:"ruleId,omitempty"`
+	RuleIndex *int       `json:"ruleIndex,omitempty"`
+	Level     string     `json:"level,omitempty"`
+	Message   Message    `json:"message"`
+	Locations []Location `json:"locations,omitempty"`
+	Fixes     []Fix      `json:"fixes,omitempty"`
+}
+
+// Message is either a plain text or a reference to a message string of the
+// rule, with arguments for its "{0}", "{1}", ... placeholders.
+type Message struct {
+	Text      string   `json:"text,omitempty"`
+	ID        string   `json:"id,omitempty"`
+	Arguments []string `json:"arguments,omitempty"`
+}
+
+// Location is where a result was found.
+type Location struct {
+	PhysicalLocation *PhysicalLocation `json:"physicalLocation,omitempty"`
+}
+
+// PhysicalLocation is a region of a file.
+type PhysicalLocation struct {
+	ArtifactLocation ArtifactLocation `json:"artifactLocation"`
+	Region           *Region          `json:"region,omitempty"`
+}
+
+// ArtifactLocation is the location of a file.
+//
+// URI is relative to the directory named by URIBaseID, or absolute.
+type ArtifactLocation struct {
+	URI       string `json:"uri"`
+	URIBaseID string `json:"uriBaseId,omitempty"`
+}
+
+// Region is a region of a file.
+//
+// Lines and columns are 1-based and EndColumn is exclusive. A region without
+// StartColumn starts at the beginning of StartLine, and a region without
+// EndColumn ends at the end of EndLine, excluding the line break.
+type Region struct {
+	StartLine   int32 `json:"startLine,omitempty"`
+	StartColumn int32 `json:"startColumn,omitempty"`
+	EndLine     int32 `json:"endLine,omitempty"`
+	EndColumn   int32 `json:"endColumn,omitempty"`
+}
+
+// Fix is a proposed fix for a result.
+type Fix struct {
+	Description     *Message         `json:"description,omitempty"`
+	ArtifactChanges []ArtifactChange `json:"artifactChanges"`
+}
+
+// ArtifactChange is the change of a single file.
+type ArtifactChange struct {
+	ArtifactLocation ArtifactLocation `json:"artifactLocation"`
+	Replacements     []Replacement    `json:"replacements"`
+}
+
+// Replacement replaces a region of a file with new content.
+type Replacement struct {
+	DeletedRegion   Region           `json:"deletedRegion"`
+	InsertedContent *ArtifactContent `json:"insertedContent,omitempty"`
+}
+
+// ArtifactContent is the content of a file.
+type ArtifactContent struct {
+	Text string `json:"text"`
+}
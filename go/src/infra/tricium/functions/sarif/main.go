@@ -0,0 +1,146 @@
+// Copyright 2024 The Chromium Authors
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io/ioutil"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	tricium "infra/tricium/api/v1"
+	"infra/tricium/functions/sarif/converter"
+)
+
+// exportFileName is the name of the SARIF file written by -export.
+const exportFileName = "results.sarif"
+
+func main() {
+	inputDir := flag.String("input", "", "Path to root of Tricium input")
+	outputDir := flag.String("output", "", "Path to root of Tricium output")
+	command := flag.String("command", "", "Analyzer command writing SARIF to stdout, the paths of the files are appended to it. Spaces in arguments are escaped with a backslash")
+	sarifPath := flag.String("sarif_path", "", "Path to the SARIF file written by the analyzer command, relative to the input, instead of stdout")
+	category := flag.String("category", "", "Category of the comments, defaults to the name of the tool in the SARIF log")
+	pathFilters := flag.String("path_filters", "", "Patterns to filter file list, all files are checked if empty")
+	export := flag.Bool("export", false, "Convert Tricium RESULTS in the input to SARIF in the output instead")
+	flag.Parse()
+	if flag.NArg() != 0 {
+		log.Panicf("Unexpected argument")
+	}
+
+	if *export {
+		exportResults(*inputDir, *outputDir)
+		return
+	}
+	if *command == "" && *sarifPath == "" {
+		log.Panicf("Either -command or -sarif_path is required")
+	}
+	run(*inputDir, *outputDir, tricium.SplitCommand(*command), *sarifPath, *category, *pathFilters)
+}
+
+func run(inputDir, outputDir string, command []string, sarifPath, category, pathFilters string) {
+	// Read Tricium input FILES data.
+	input := &tricium.Data_Files{}
+	if err := tricium.ReadDataType(inputDir, input); err != nil {
+		log.Panicf("Failed to read FILES data: %v", err)
+	}
+	log.Printf("Read FILES data.")
+
+	files := input.Files
+	if pathFilters != "" {
+		var err error
+		if files, err = tricium.FilterFiles(input.Files, strings.Split(pathFilters, ",")...); err != nil {
+			log.Panicf("Failed to filter files: %v", err)
+		}
+	}
+
+	results := &tricium.Data_Results{}
+	if len(files) > 0 {
+		basePath, err := filepath.Abs(inputDir)
+		if err != nil {
+			log.Panicf("Failed to get absolute path of %q: %v", inputDir, err)
+		}
+		sarifLog := readSARIF(inputDir, command, sarifPath, files)
+		if results, err = converter.ToResults(sarifLog, basePath, category); err != nil {
+			log.Panicf("Failed to convert SARIF: %v", err)
+		}
+	} else {
+		log.Printf("No files to check.")
+	}
+
+	// Write Tricium RESULTS data.
+	path, err := tricium.WriteDataType(outputDir, results)
+	if err != nil {
+		log.Panicf("Failed to write RESULTS data: %v", err)
+	}
+	log.Printf("Wrote RESULTS data to path %q.", path)
+}
+
+// readSARIF runs the analyzer command, if any, in the input directory and
+// reads the SARIF log it produced.
+func readSARIF(inputDir string, command []string, sarifPath string, files []*tricium.Data_File) *converter.Log {
+	var stdout bytes.Buffer
+	if len(command) > 0 {
+		args := append([]string{}, command[1:]...)
+		for _, file := range files {
+			args = append(args, file.Path)
+		}
+		cmd := exec.Command(command[0], args...)
+		cmd.Dir = inputDir
+		cmd.Stdout = &stdout
+		cmd.Stderr = os.Stderr
+		log.Printf("Command: %s", cmd.Args)
+		// Analyzers usually exit with an error status when they find issues.
+		var exitErr *exec.ExitError
+		if err := cmd.Run(); err != nil && !errors.As(err, &exitErr) {
+			log.Panicf("Failed to run analyzer: %v", err)
+		}
+	}
+
+	data := stdout.Bytes()
+	if sarifPath != "" {
+		var err error
+		if data, err = ioutil.ReadFile(filepath.Join(inputDir, sarifPath)); err != nil {
+			log.Panicf("Failed to read SARIF file: %v", err)
+		}
+	}
+	sarifLog := &converter.Log{}
+	if err := json.Unmarshal(data, sarifLog); err != nil {
+		log.Panicf("Failed to parse SARIF: %v", err)
+	}
+	if sarifLog.Version != converter.Version {
+		log.Panicf("Unsupported SARIF version %q", sarifLog.Version)
+	}
+	return sarifLog
+}
+
+// exportResults converts Tricium RESULTS data to a SARIF file, so the results
+// can be uploaded to other code scanning tools.
+func exportResults(inputDir, outputDir string) {
+	results := &tricium.Data_Results{}
+	if err := tricium.ReadDataType(inputDir, results); err != nil {
+		log.Panicf("Failed to read RESULTS data: %v", err)
+	}
+	log.Printf("Read RESULTS data.")
+
+	data, err := json.MarshalIndent(converter.FromResults(results), "", "  ")
+	if err != nil {
+		log.Panicf("Failed to marshal SARIF: %v", err)
+	}
+	if err := os.MkdirAll(outputDir, 0777); err != nil {
+		log.Panicf("Failed to create output directory: %v", err)
+	}
+	path := filepath.Join(outputDir, exportFileName)
+	if err := ioutil.WriteFile(path, data, 0644); err != nil {
+		log.Panicf("Failed to write SARIF: %v", err)
+	}
+	log.Printf("Wrote SARIF to path %q.", path)
+}
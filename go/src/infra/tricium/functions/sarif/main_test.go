@@ -0,0 +1,93 @@
+// Copyright 2024 The Chromium Authors
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	. "go.chromium.org/luci/common/testing/assertions"
+
+	tricium "infra/tricium/api/v1"
+	"infra/tricium/functions/sarif/converter"
+)
+
+const testInputDir = "testdata"
+
+func TestRun(t *testing.T) {
+	Convey("SARIF from the analyzer is converted to Tricium results", t, func() {
+		outputDir, err := ioutil.TempDir("", "tricium-sarif-test")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(outputDir)
+
+		run(testInputDir, outputDir, nil, "report.sarif", "", "*.cc")
+
+		results := &tricium.Data_Results{}
+		So(tricium.ReadDataType(outputDir, results), ShouldBeNil)
+		So(results.Comments, ShouldResembleProto, []*tricium.Data_Comment{
+			{
+				Category:  "ClangTidy/modernize-use-nullptr",
+				Message:   "use nullptr\n\nhttps://clang.llvm.org/extra/clang-tidy/checks/modernize/use-nullptr.html",
+				Path:      "src/main.cc",
+				StartLine: 12,
+				EndLine:   12,
+				StartChar: 10,
+				EndChar:   14,
+				Suggestions: []*tricium.Data_Suggestion{{
+					Description: "Replace NULL with nullptr",
+					Replacements: []*tricium.Data_Replacement{{
+						Path:        "src/main.cc",
+						Replacement: "nullptr",
+						StartLine:   12,
+						EndLine:     12,
+						StartChar:   10,
+						EndChar:     14,
+					}},
+				}},
+			},
+			{
+				Category:  "ClangTidy/readability-braces-around-statements",
+				Message:   "statement should be inside braces in main",
+				Path:      "src/main.cc",
+				StartLine: 20,
+				EndLine:   22,
+			},
+		})
+
+		Convey("Tricium results are exported to SARIF", func() {
+			exportDir, err := ioutil.TempDir("", "tricium-sarif-test")
+			So(err, ShouldBeNil)
+			defer os.RemoveAll(exportDir)
+
+			exportResults(outputDir, exportDir)
+
+			data, err := ioutil.ReadFile(filepath.Join(exportDir, exportFileName))
+			So(err, ShouldBeNil)
+			log := &converter.Log{}
+			So(json.Unmarshal(data, log), ShouldBeNil)
+			So(log.Version, ShouldEqual, converter.Version)
+			So(len(log.Runs), ShouldEqual, 1)
+			So(log.Runs[0].Tool.Driver.Name, ShouldEqual, "ClangTidy")
+			So(len(log.Runs[0].Results), ShouldEqual, 2)
+		})
+	})
+
+	Convey("Nothing is reported without files to check", t, func() {
+		outputDir, err := ioutil.TempDir("", "tricium-sarif-test")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(outputDir)
+
+		run(testInputDir, outputDir, nil, "report.sarif", "", "*.js")
+
+		results := &tricium.Data_Results{}
+		So(tricium.ReadDataType(outputDir, results), ShouldBeNil)
+		So(results.Comments, ShouldBeEmpty)
+	})
+}
@@ -0,0 +1,158 @@
+// Copyright 2024 The Chromium Authors
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package local
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+
+	tricium "infra/tricium/api/v1"
+)
+
+// edit is a replacement resolved to byte offsets in a file.
+type edit struct {
+	start, end int
+	text       string
+}
+
+// ApplyFixes applies the first suggestion of every comment to the files of
+// the checkout in root, and returns the number of applied suggestions.
+//
+// Characters are counted in bytes. A suggestion is skipped as a whole if it
+// has a replacement outside of its file or of the checkout, a replacement of
+// a file missing from the checkout or of the commit message, or a replacement
+// overlapping with one of a suggestion applied before.
+func ApplyFixes(ctx context.Context, root string, comments []*tricium.Data_Comment) (int, error) {
+	contents := map[string]string{}
+	edits := map[string][]edit{}
+	applied := 0
+	for _, c := range comments {
+		if len(c.Suggestions) == 0 {
+			continue
+		}
+		s := c.Suggestions[0]
+		pending, err := resolveSuggestion(root, s, contents)
+		if err != nil {
+			return 0, errors.Annotate(err, "apply fixes").Err()
+		}
+		if pending == nil || overlaps(edits, pending) {
+			logging.Warningf(ctx, "Skipping fix %q of %s comment on %s", s.Description, c.Category, c.Path)
+			continue
+		}
+		for p, e := range pending {
+			edits[p] = append(edits[p], e...)
+		}
+		applied++
+	}
+
+	for p, e := range edits {
+		content := contents[p]
+		// Apply the edits from the end of the file, so the offsets of the other
+		// edits stay valid.
+		sort.Slice(e, func(i, j int) bool { return e[i].start > e[j].start })
+		for _, ed := range e {
+			content = content[:ed.start] + ed.text + content[ed.end:]
+		}
+		file := filepath.Join(root, filepath.FromSlash(p))
+		info, err := os.Stat(file)
+		if err != nil {
+			return 0, errors.Annotate(err, "apply fixes").Err()
+		}
+		if err := ioutil.WriteFile(file, []byte(content), info.Mode()); err != nil {
+			return 0, errors.Annotate(err, "apply fixes").Err()
+		}
+	}
+	return applied, nil
+}
+
+// resolveSuggestion resolves the replacements of a suggestion to edits,
+// reading the files into contents as needed.
+//
+// Returns nil if a replacement is invalid.
+func resolveSuggestion(root string, s *tricium.Data_Suggestion, contents map[string]string) (map[string][]edit, error) {
+	ret := map[string][]edit{}
+	for _, r := range s.Replacements {
+		if p := path.Clean(r.Path); r.Path == "" || path.IsAbs(p) || p == ".." || strings.HasPrefix(p, "../") {
+			return nil, nil
+		}
+		content, ok := contents[r.Path]
+		if !ok {
+			file := filepath.Join(root, filepath.FromSlash(r.Path))
+			if info, err := os.Stat(file); os.IsNotExist(err) || (err == nil && !info.Mode().IsRegular()) {
+				return nil, nil
+			}
+			data, err := ioutil.ReadFile(file)
+			if err != nil {
+				return nil, err
+			}
+			content = string(data)
+			contents[r.Path] = content
+		}
+		start, ok := offset(content, r.StartLine, r.StartChar)
+		if !ok {
+			return nil, nil
+		}
+		end, ok := offset(content, r.EndLine, r.EndChar)
+		if !ok || end < start {
+			return nil, nil
+		}
+		ret[r.Path] = append(ret[r.Path], edit{start: start, end: end, text: r.Replacement})
+	}
+	return ret, nil
+}
+
+// offset returns the byte offset of a position in content.
+//
+// The line is 1-based and the character 0-based. The position right after the
+// last line is the end of the content.
+func offset(content string, line, char int32) (int, bool) {
+	if line < 1 || char < 0 {
+		return 0, false
+	}
+	start := 0
+	for l := int32(1); l < line; l++ {
+		i := strings.IndexByte(content[start:], '\n')
+		if i < 0 {
+			// The line after the last one without a trailing newline.
+			if l == line-1 && char == 0 && start < len(content) {
+				return len(content), true
+			}
+			return 0, false
+		}
+		start += i + 1
+	}
+	end := len(content)
+	if i := strings.IndexByte(content[start:], '\n'); i >= 0 {
+		end = start + i + 1
+	}
+	if start+int(char) > end {
+		return 0, false
+	}
+	return start + int(char), true
+}
+
+// overlaps checks whether the pending edits overlap with each other or with
+// the accepted ones. Insertions at the same offset overlap, since their order
+// is ambiguous.
+func overlaps(accepted, pending map[string][]edit) bool {
+	for p, e := range pending {
+		all := append(append([]edit{}, accepted[p]...), e...)
+		sort.Slice(all, func(i, j int) bool { return all[i].start < all[j].start })
+		for i := 1; i < len(all); i++ {
+			if all[i].start < all[i-1].end || all[i].start == all[i-1].start {
+				return true
+			}
+		}
+	}
+	return false
+}
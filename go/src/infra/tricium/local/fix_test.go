@@ -0,0 +1,91 @@
+// Copyright 2024 The Chromium Authors
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package local
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	tricium "infra/tricium/api/v1"
+)
+
+func TestApplyFixes(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	suggestion := func(replacements ...*tricium.Data_Replacement) []*tricium.Data_Suggestion {
+		return []*tricium.Data_Suggestion{{Replacements: replacements}}
+	}
+
+	Convey("ApplyFixes", t, func() {
+		root, err := ioutil.TempDir("", "tricium-local-test")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(root)
+		So(os.Mkdir(filepath.Join(root, "dir"), 0777), ShouldBeNil)
+		file := filepath.Join(root, "dir", "a.cc")
+		So(ioutil.WriteFile(file, []byte("int *p = NULL;\nint\tx;\nint y;"), 0644), ShouldBeNil)
+		read := func() string {
+			data, err := ioutil.ReadFile(file)
+			So(err, ShouldBeNil)
+			return string(data)
+		}
+
+		Convey("Replacements are applied", func() {
+			n, err := ApplyFixes(ctx, root, []*tricium.Data_Comment{
+				{Suggestions: suggestion(&tricium.Data_Replacement{
+					Path: "dir/a.cc", Replacement: "nullptr", StartLine: 1, EndLine: 1, StartChar: 9, EndChar: 13})},
+				{Suggestions: suggestion(&tricium.Data_Replacement{
+					Path: "dir/a.cc", Replacement: " ", StartLine: 2, EndLine: 2, StartChar: 3, EndChar: 4})},
+				{Message: "No suggestion"},
+			})
+			So(err, ShouldBeNil)
+			So(n, ShouldEqual, 2)
+			So(read(), ShouldEqual, "int *p = nullptr;\nint x;\nint y;")
+		})
+
+		Convey("Whole lines are replaced", func() {
+			n, err := ApplyFixes(ctx, root, []*tricium.Data_Comment{
+				{Suggestions: suggestion(&tricium.Data_Replacement{
+					Path: "dir/a.cc", Replacement: "", StartLine: 2, EndLine: 4})},
+			})
+			So(err, ShouldBeNil)
+			So(n, ShouldEqual, 1)
+			So(read(), ShouldEqual, "int *p = NULL;\n")
+		})
+
+		Convey("Overlapping and invalid fixes are skipped", func() {
+			n, err := ApplyFixes(ctx, root, []*tricium.Data_Comment{
+				{Suggestions: suggestion(&tricium.Data_Replacement{
+					Path: "dir/a.cc", Replacement: "q", StartLine: 1, EndLine: 1, StartChar: 5, EndChar: 6})},
+				// Overlaps with the first fix.
+				{Suggestions: suggestion(&tricium.Data_Replacement{
+					Path: "dir/a.cc", Replacement: "*r", StartLine: 1, EndLine: 1, StartChar: 4, EndChar: 6})},
+				// Beyond the end of the file.
+				{Suggestions: suggestion(&tricium.Data_Replacement{
+					Path: "dir/a.cc", Replacement: "z", StartLine: 9, EndLine: 9})},
+				// Outside of the checkout.
+				{Suggestions: suggestion(&tricium.Data_Replacement{
+					Path: "../a.cc", Replacement: "z", StartLine: 1, EndLine: 1})},
+				// On a missing file.
+				{Suggestions: suggestion(&tricium.Data_Replacement{
+					Path: "dir/b.cc", Replacement: "z", StartLine: 1, EndLine: 1})},
+				// On a directory.
+				{Suggestions: suggestion(&tricium.Data_Replacement{
+					Path: "dir", Replacement: "z", StartLine: 1, EndLine: 1})},
+				// On the commit message.
+				{Suggestions: suggestion(&tricium.Data_Replacement{
+					Replacement: "z", StartLine: 1, EndLine: 1})},
+			})
+			So(err, ShouldBeNil)
+			So(n, ShouldEqual, 1)
+			So(read(), ShouldEqual, "int *q = NULL;\nint\tx;\nint y;")
+		})
+	})
+}
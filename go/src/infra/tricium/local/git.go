@@ -0,0 +1,93 @@
+// Copyright 2024 The Chromium Authors
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package local
+
+import (
+	"bytes"
+	"context"
+	"os/exec"
+	"strings"
+
+	"go.chromium.org/luci/common/errors"
+
+	tricium "infra/tricium/api/v1"
+)
+
+// fileStatuses maps the statuses of `git diff --name-status` to Tricium.
+var fileStatuses = map[string]tricium.Data_Status{
+	"A": tricium.Data_ADDED,
+	"M": tricium.Data_MODIFIED,
+	"T": tricium.Data_MODIFIED,
+}
+
+// git runs a git command in dir and returns its trimmed output.
+func git(ctx context.Context, dir string, args ...string) (string, error) {
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Dir = dir
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return "", errors.Annotate(err, "git %s: %s", strings.Join(args, " "), strings.TrimSpace(stderr.String())).Err()
+	}
+	return strings.TrimSpace(stdout.String()), nil
+}
+
+// changedFiles returns the files of the working tree changed since base.
+// Deleted files are left out, since there is nothing to analyze.
+func changedFiles(ctx context.Context, root, base string) ([]*tricium.Data_File, error) {
+	status, err := git(ctx, root, "diff", "--name-status", "--no-renames", "-z", base)
+	if err != nil {
+		return nil, errors.Annotate(err, "changed files").Err()
+	}
+	numstat, err := git(ctx, root, "diff", "--numstat", "--no-renames", "-z", base)
+	if err != nil {
+		return nil, errors.Annotate(err, "changed files").Err()
+	}
+	return parseChangedFiles(status, numstat)
+}
+
+// parseChangedFiles parses the NUL-separated output of
+// `git diff --name-status` and `git diff --numstat`.
+//
+// The binary files are the ones without line counts in numstat.
+func parseChangedFiles(status, numstat string) ([]*tricium.Data_File, error) {
+	binary := map[string]bool{}
+	fields := splitNull(numstat)
+	for _, f := range fields {
+		parts := strings.SplitN(f, "\t", 3)
+		if len(parts) != 3 {
+			return nil, errors.Reason("parse changed files: malformed numstat %q", f).Err()
+		}
+		binary[parts[2]] = parts[0] == "-" && parts[1] == "-"
+	}
+
+	var files []*tricium.Data_File
+	fields = splitNull(status)
+	if len(fields)%2 != 0 {
+		return nil, errors.Reason("parse changed files: malformed name-status %q", status).Err()
+	}
+	for i := 0; i < len(fields); i += 2 {
+		s, ok := fileStatuses[fields[i]]
+		if !ok {
+			continue
+		}
+		files = append(files, &tricium.Data_File{
+			Path:     fields[i+1],
+			Status:   s,
+			IsBinary: binary[fields[i+1]],
+		})
+	}
+	return files, nil
+}
+
+// splitNull splits NUL-separated output, dropping the empty trailing field.
+func splitNull(s string) []string {
+	s = strings.TrimRight(s, "\x00")
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, "\x00")
+}
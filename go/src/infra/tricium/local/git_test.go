@@ -0,0 +1,46 @@
+// Copyright 2024 The Chromium Authors
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package local
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	. "go.chromium.org/luci/common/testing/assertions"
+
+	tricium "infra/tricium/api/v1"
+)
+
+func TestParseChangedFiles(t *testing.T) {
+	t.Parallel()
+
+	Convey("parseChangedFiles", t, func() {
+		Convey("Deleted files are left out", func() {
+			status := "M\x00a.go\x00A\x00dir/b.png\x00D\x00c.go\x00T\x00link\x00"
+			numstat := "1\t2\ta.go\x00-\t-\tdir/b.png\x000\t3\tc.go\x001\t1\tlink\x00"
+			files, err := parseChangedFiles(status, numstat)
+			So(err, ShouldBeNil)
+			So(files, ShouldResembleProto, []*tricium.Data_File{
+				{Path: "a.go", Status: tricium.Data_MODIFIED},
+				{Path: "dir/b.png", Status: tricium.Data_ADDED, IsBinary: true},
+				{Path: "link", Status: tricium.Data_MODIFIED},
+			})
+		})
+
+		Convey("No changes", func() {
+			files, err := parseChangedFiles("", "")
+			So(err, ShouldBeNil)
+			So(files, ShouldBeEmpty)
+		})
+
+		Convey("Malformed output", func() {
+			_, err := parseChangedFiles("M\x00", "")
+			So(err, ShouldNotBeNil)
+			_, err = parseChangedFiles("", "1\ta.go\x00")
+			So(err, ShouldNotBeNil)
+		})
+	})
+}
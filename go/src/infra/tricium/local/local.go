@@ -0,0 +1,213 @@
+// Copyright 2024 The Chromium Authors
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+// Package local runs Tricium functions against a local git checkout.
+package local
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"go.chromium.org/luci/common/errors"
+	"go.chromium.org/luci/common/logging"
+
+	tricium "infra/tricium/api/v1"
+)
+
+// snapshotRef is the ref the snapshot of the working tree is fetched from by
+// the isolator. It only exists while the functions run.
+const snapshotRef = "refs/tricium/local"
+
+// Change is the local change analyzed by the functions.
+type Change struct {
+	// Root is the root of the checkout.
+	Root string
+	// Base is the commit the change is diffed against.
+	Base string
+	// Files are the files changed since the base.
+	Files []*tricium.Data_File
+}
+
+// dataTypePaths are the paths of the data types functions can provide,
+// relative to their output directory.
+var dataTypePaths = []struct {
+	dataType tricium.Data_Type
+	path     string
+}{
+	{tricium.Data_GIT_FILE_DETAILS, tricium.GitFileDetailsPath},
+	{tricium.Data_FILES, tricium.FilesPath},
+	{tricium.Data_RESULTS, tricium.ResultsPath},
+}
+
+// A Runner runs a chain of functions against the local changes of a
+// checkout: the git-file-isolator followed by the analyzers.
+//
+// As in Tricium workflows, every function consumes the data type provided
+// by the previous one. The isolator consumes GIT_FILE_DETAILS and provides
+// FILES, and the last analyzer must provide RESULTS. Analyzers before it
+// provide the data type the next analyzer needs, e.g. FILES.
+//
+// Every function is a command taking -input and -output flags, e.g.
+// "spacey" or "sarif_tricium -sarif_path=report.sarif".
+type Runner struct {
+	// Dir is any directory in the checkout.
+	Dir string
+	// Base is the ref the changes are compared to. If empty, only the
+	// uncommitted changes are analyzed. Otherwise the changes since the
+	// merge base of Base and HEAD are analyzed, including the uncommitted
+	// ones.
+	Base string
+	// Isolator is the git-file-isolator command.
+	Isolator []string
+	// Analyzers are the analyzer commands, in the order of the chain.
+	Analyzers [][]string
+	// Output receives the logs of the functions. May be nil.
+	Output io.Writer
+}
+
+// Run runs the chain of functions and returns the change with the results
+// of the last analyzer.
+//
+// Untracked files are not analyzed.
+func (r *Runner) Run(ctx context.Context) (*Change, *tricium.Data_Results, error) {
+	if len(r.Analyzers) == 0 {
+		return nil, nil, errors.Reason("run: no analyzers").Err()
+	}
+	root, err := git(ctx, r.Dir, "rev-parse", "--show-toplevel")
+	if err != nil {
+		return nil, nil, errors.Annotate(err, "run").Err()
+	}
+	change := &Change{Root: root}
+
+	// The isolator fetches the files from a ref, so the working tree is
+	// snapshotted to a commit. `git stash create` makes the commit without
+	// touching the working tree or the stash, and makes nothing when there
+	// are no uncommitted changes.
+	snapshot, err := git(ctx, root, "stash", "create")
+	if err != nil {
+		return nil, nil, errors.Annotate(err, "run").Err()
+	}
+	if snapshot == "" {
+		if snapshot, err = git(ctx, root, "rev-parse", "HEAD"); err != nil {
+			return nil, nil, errors.Annotate(err, "run").Err()
+		}
+	}
+	commitMessage := ""
+	if r.Base == "" {
+		change.Base, err = git(ctx, root, "rev-parse", "HEAD")
+	} else {
+		change.Base, err = git(ctx, root, "merge-base", r.Base, "HEAD")
+		if err == nil {
+			commitMessage, err = git(ctx, root, "log", "-1", "--format=%B", "HEAD")
+		}
+	}
+	if err != nil {
+		return nil, nil, errors.Annotate(err, "run").Err()
+	}
+	if change.Files, err = changedFiles(ctx, root, change.Base); err != nil {
+		return nil, nil, errors.Annotate(err, "run").Err()
+	}
+	results := &tricium.Data_Results{}
+	if len(change.Files) == 0 {
+		logging.Infof(ctx, "No changed files to analyze.")
+		return change, results, nil
+	}
+
+	if _, err := git(ctx, root, "update-ref", snapshotRef, snapshot); err != nil {
+		return nil, nil, errors.Annotate(err, "run").Err()
+	}
+	defer func() {
+		if _, err := git(ctx, root, "update-ref", "-d", snapshotRef); err != nil {
+			logging.Warningf(ctx, "Failed to delete %s: %s", snapshotRef, err)
+		}
+	}()
+
+	workDir, err := ioutil.TempDir("", "tricium-local")
+	if err != nil {
+		return nil, nil, errors.Annotate(err, "run").Err()
+	}
+	defer os.RemoveAll(workDir)
+
+	input := filepath.Join(workDir, "input")
+	details := &tricium.Data_GitFileDetails{
+		Repository:    "file://" + filepath.ToSlash(root),
+		Ref:           snapshotRef,
+		Files:         change.Files,
+		CommitMessage: commitMessage,
+	}
+	if _, err := tricium.WriteDataType(input, details); err != nil {
+		return nil, nil, errors.Annotate(err, "run").Err()
+	}
+	chain := append([][]string{r.Isolator}, r.Analyzers...)
+	for i, function := range chain {
+		output := filepath.Join(workDir, fmt.Sprintf("function-%d", i))
+		if err := r.runFunction(ctx, function, input, output); err != nil {
+			return nil, nil, errors.Annotate(err, "run").Err()
+		}
+		provides, err := providedDataType(output)
+		if err != nil {
+			return nil, nil, errors.Annotate(err, "run: function %q", function[0]).Err()
+		}
+		last := i == len(chain)-1
+		switch {
+		case last && provides != tricium.Data_RESULTS:
+			return nil, nil, errors.Reason("run: last analyzer %q provides %s, want RESULTS", function[0], provides).Err()
+		case !last && provides == tricium.Data_RESULTS:
+			return nil, nil, errors.Reason("run: function %q provides RESULTS, which no function consumes, it must be the last analyzer", function[0]).Err()
+		}
+		input = output
+	}
+	if err := tricium.ReadDataType(input, results); err != nil {
+		return nil, nil, errors.Annotate(err, "run: analyzer %q", r.Analyzers[len(r.Analyzers)-1][0]).Err()
+	}
+	logging.Infof(ctx, "Analyzers made %d comments.", len(results.Comments))
+	return change, results, nil
+}
+
+// providedDataType returns the data type a function wrote to its output
+// directory.
+func providedDataType(outputDir string) (tricium.Data_Type, error) {
+	var provided []tricium.Data_Type
+	for _, dt := range dataTypePaths {
+		_, err := os.Stat(filepath.Join(outputDir, dt.path))
+		switch {
+		case err == nil:
+			provided = append(provided, dt.dataType)
+		case !os.IsNotExist(err):
+			return tricium.Data_NONE, errors.Annotate(err, "provided data type").Err()
+		}
+	}
+	if len(provided) != 1 {
+		return tricium.Data_NONE, errors.Reason("provided data type: want exactly one data type in the output, got %v", provided).Err()
+	}
+	return provided[0], nil
+}
+
+// runFunction runs a function command with the given input and output
+// directories.
+func (r *Runner) runFunction(ctx context.Context, command []string, inputDir, outputDir string) error {
+	if len(command) == 0 {
+		return errors.Reason("run function: empty command").Err()
+	}
+	args := append(append([]string{}, command[1:]...), "-input="+inputDir, "-output="+outputDir)
+	cmd := exec.CommandContext(ctx, command[0], args...)
+	var out bytes.Buffer
+	cmd.Stdout = &out
+	cmd.Stderr = &out
+	logging.Debugf(ctx, "Running %s", cmd.Args)
+	err := cmd.Run()
+	if r.Output != nil {
+		r.Output.Write(out.Bytes())
+	}
+	if err != nil {
+		return errors.Annotate(err, "run function %q, output:\n%s", command[0], out.String()).Err()
+	}
+	return nil
+}
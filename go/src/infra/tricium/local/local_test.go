@@ -0,0 +1,82 @@
+// Copyright 2024 The Chromium Authors
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package local
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	. "go.chromium.org/luci/common/testing/assertions"
+
+	tricium "infra/tricium/api/v1"
+)
+
+// shFunction returns a function command running the shell script, with the
+// input and output directories in $in and $out.
+func shFunction(script string) []string {
+	return []string{"sh", "-c", `in=${1#-input=}; out=${2#-output=}; ` + script, "sh"}
+}
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	if runtime.GOOS == "windows" {
+		t.Skip("The functions are shell scripts")
+	}
+	ctx := context.Background()
+
+	Convey("Run", t, func() {
+		root, err := ioutil.TempDir("", "tricium-local-test")
+		So(err, ShouldBeNil)
+		defer os.RemoveAll(root)
+		for _, args := range [][]string{
+			{"init", "-q"},
+			{"commit", "-q", "--allow-empty", "-m", "base"},
+		} {
+			cmd := exec.Command("git", append([]string{"-c", "user.name=test", "-c", "user.email=test@example.com"}, args...)...)
+			cmd.Dir = root
+			So(cmd.Run(), ShouldBeNil)
+		}
+		So(ioutil.WriteFile(filepath.Join(root, "a.txt"), []byte("a\n"), 0644), ShouldBeNil)
+		cmd := exec.Command("git", "add", "a.txt")
+		cmd.Dir = root
+		So(cmd.Run(), ShouldBeNil)
+
+		isolator := shFunction(`mkdir -p $out/tricium/data && cp $in/tricium/data/git_file_details.json $out/tricium/data/files.json`)
+		filter := shFunction(`cp -r $in/. $out && echo filtered > $out/marker`)
+		analyzer := shFunction(`mkdir -p $out/tricium/data && echo "{\"comments\": [{\"category\": \"Test\", \"message\": \"$(cat $in/marker)\", \"path\": \"a.txt\"}]}" > $out/tricium/data/results.json`)
+
+		Convey("Every function consumes the output of the previous one", func() {
+			r := &Runner{Dir: root, Isolator: isolator, Analyzers: [][]string{filter, analyzer}}
+			change, results, err := r.Run(ctx)
+			So(err, ShouldBeNil)
+			So(change.Files, ShouldResembleProto, []*tricium.Data_File{
+				{Path: "a.txt", Status: tricium.Data_ADDED},
+			})
+			So(results.Comments, ShouldResembleProto, []*tricium.Data_Comment{
+				{Category: "Test", Message: "filtered", Path: "a.txt"},
+			})
+		})
+
+		Convey("The last analyzer must provide RESULTS", func() {
+			r := &Runner{Dir: root, Isolator: isolator, Analyzers: [][]string{filter}}
+			_, _, err := r.Run(ctx)
+			So(err, ShouldErrLike, "provides FILES, want RESULTS")
+		})
+
+		Convey("Only the last analyzer may provide RESULTS", func() {
+			r := &Runner{Dir: root, Isolator: isolator, Analyzers: [][]string{analyzer, analyzer}}
+			_, _, err := r.Run(ctx)
+			So(err, ShouldErrLike, "must be the last analyzer")
+		})
+	})
+}
@@ -0,0 +1,141 @@
+// Copyright 2024 The Chromium Authors
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package local
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"go.chromium.org/luci/common/errors"
+
+	tricium "infra/tricium/api/v1"
+)
+
+// hunkHeader matches the header of a hunk of a unified diff and captures the
+// first line of the hunk in the new file.
+var hunkHeader = regexp.MustCompile(`^@@ -\d+(?:,\d+)? \+(\d+)(?:,\d+)? @@`)
+
+// PrintResults prints the comments inline with the diff of the change.
+//
+// Comments on the commit message and on whole files come before the diff of
+// the files, and comments on lines outside of the diff come after it.
+func PrintResults(ctx context.Context, w io.Writer, change *Change, results *tricium.Data_Results) error {
+	byPath := map[string][]*tricium.Data_Comment{}
+	for _, c := range results.Comments {
+		byPath[c.Path] = append(byPath[c.Path], c)
+	}
+	if comments := byPath[""]; len(comments) > 0 {
+		fmt.Fprintf(w, "Commit message\n")
+		for _, c := range comments {
+			printComment(w, c)
+		}
+		fmt.Fprintln(w)
+	}
+	var paths []string
+	for p := range byPath {
+		if p != "" {
+			paths = append(paths, p)
+		}
+	}
+	sort.Strings(paths)
+	for _, p := range paths {
+		diff, err := git(ctx, change.Root, "diff", "--no-color", "--no-renames", change.Base, "--", p)
+		if err != nil {
+			return errors.Annotate(err, "print results").Err()
+		}
+		printFile(w, p, diff, byPath[p])
+	}
+	return nil
+}
+
+// printFile prints the comments of a file inline with its unified diff.
+//
+// A comment is printed after the last line of its range.
+func printFile(w io.Writer, path, diff string, comments []*tricium.Data_Comment) {
+	fmt.Fprintf(w, "%s\n", path)
+	byLine := map[int32][]*tricium.Data_Comment{}
+	for _, c := range comments {
+		if c.StartLine == 0 {
+			printComment(w, c)
+			continue
+		}
+		byLine[commentLine(c)] = append(byLine[commentLine(c)], c)
+	}
+
+	var line int32
+	for _, l := range strings.Split(diff, "\n") {
+		if m := hunkHeader.FindStringSubmatch(l); m != nil {
+			n, _ := strconv.Atoi(m[1])
+			line = int32(n)
+			fmt.Fprintln(w, l)
+			continue
+		}
+		if line == 0 {
+			// The header of the diff.
+			continue
+		}
+		fmt.Fprintln(w, l)
+		if strings.HasPrefix(l, " ") || strings.HasPrefix(l, "+") {
+			for _, c := range byLine[line] {
+				printComment(w, c)
+			}
+			delete(byLine, line)
+			line++
+		}
+	}
+
+	if len(byLine) > 0 {
+		var lines []int
+		for l := range byLine {
+			lines = append(lines, int(l))
+		}
+		sort.Ints(lines)
+		fmt.Fprintf(w, "Outside of the diff:\n")
+		for _, l := range lines {
+			for _, c := range byLine[int32(l)] {
+				printComment(w, c)
+			}
+		}
+	}
+	fmt.Fprintln(w)
+}
+
+// commentLine returns the last line of the range of a comment.
+func commentLine(c *tricium.Data_Comment) int32 {
+	if c.EndLine > c.StartLine {
+		return c.EndLine
+	}
+	return c.StartLine
+}
+
+// printComment prints a comment with its position and suggestions.
+func printComment(w io.Writer, c *tricium.Data_Comment) {
+	pos := ""
+	switch {
+	case c.StartLine == 0:
+	case c.StartChar == 0 && c.EndChar == 0 && commentLine(c) == c.StartLine:
+		pos = fmt.Sprintf(" (line %d)", c.StartLine)
+	case c.StartChar == 0 && c.EndChar == 0:
+		pos = fmt.Sprintf(" (lines %d-%d)", c.StartLine, c.EndLine)
+	default:
+		pos = fmt.Sprintf(" (%d:%d-%d:%d)", c.StartLine, c.StartChar, commentLine(c), c.EndChar)
+	}
+	fmt.Fprintf(w, ">>> [%s]%s\n", c.Category, pos)
+	for _, l := range strings.Split(strings.TrimRight(c.Message, "\n"), "\n") {
+		fmt.Fprintf(w, ">>>   %s\n", l)
+	}
+	for _, s := range c.Suggestions {
+		desc := s.Description
+		if desc == "" {
+			desc = "suggested fix"
+		}
+		fmt.Fprintf(w, ">>>   Fix: %s\n", desc)
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright 2024 The Chromium Authors
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package local
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+
+	tricium "infra/tricium/api/v1"
+)
+
+func TestPrintFile(t *testing.T) {
+	t.Parallel()
+
+	diff := strings.Join([]string{
+		"diff --git a/a.go b/a.go",
+		"index 1234567..89abcde 100644",
+		"--- a/a.go",
+		"+++ b/a.go",
+		"@@ -1,3 +1,3 @@",
+		" package a",
+		"-var x = 1",
+		"+var x\t= 2",
+		" var y = 3",
+	}, "\n")
+
+	Convey("Comments are printed after the last line of their range", t, func() {
+		var b strings.Builder
+		printFile(&b, "a.go", diff, []*tricium.Data_Comment{
+			{Category: "Spacey/Tab", Message: "Found tab", Path: "a.go", StartLine: 2, EndLine: 2, StartChar: 5, EndChar: 6,
+				Suggestions: []*tricium.Data_Suggestion{{Description: "Replace the tab"}}},
+			{Category: "Lint", Message: "File-level\ncomment", Path: "a.go"},
+			{Category: "Lint/range", Message: "Two lines", Path: "a.go", StartLine: 2, EndLine: 3},
+			{Category: "Lint/far", Message: "Far away", Path: "a.go", StartLine: 40},
+		})
+		So(b.String(), ShouldEqual, strings.Join([]string{
+			"a.go",
+			">>> [Lint]",
+			">>>   File-level",
+			">>>   comment",
+			"@@ -1,3 +1,3 @@",
+			" package a",
+			"-var x = 1",
+			"+var x\t= 2",
+			">>> [Spacey/Tab] (2:5-2:6)",
+			">>>   Found tab",
+			">>>   Fix: Replace the tab",
+			" var y = 3",
+			">>> [Lint/range] (lines 2-3)",
+			">>>   Two lines",
+			"Outside of the diff:",
+			">>> [Lint/far] (line 40)",
+			">>>   Far away",
+			"",
+			"",
+		}, "\n"))
+	})
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/golang/protobuf/proto"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"go.chromium.org/chromiumos/config/go/payload"
 	"go.chromium.org/luci/common/errors"
@@ -234,6 +235,28 @@ func ValidateListRequest(r ListRequest) error {
 	return validatePageSize(r.GetPageSize())
 }
 
+// AsOfListRequest is a request to list a kind of entity at a point in time.
+type AsOfListRequest interface {
+	ListRequest
+	GetAsOf() *timestamppb.Timestamp
+	GetFull() bool
+}
+
+// ValidateAsOfListRequest validates the request to list a kind of entity
+// at a point in time.
+func ValidateAsOfListRequest(r AsOfListRequest) error {
+	if err := validateAsOf(r.GetAsOf()); err != nil {
+		return err
+	}
+	if r.GetFilter() != "" {
+		return status.Errorf(codes.InvalidArgument, "filter is not supported with as_of")
+	}
+	if r.GetFull() {
+		return status.Errorf(codes.InvalidArgument, "full is not supported with as_of")
+	}
+	return validatePageSize(r.GetPageSize())
+}
+
 // Validate validates input requests of DeleteChromePlatform.
 func (r *DeleteChromePlatformRequest) Validate() error {
 	return validateResourceName(chromePlatformRegex, ChromePlatformNameFormat, r.Name)
@@ -377,6 +400,11 @@ func (r *UpdateMachineRequest) Validate() error {
 
 // Validate validates input requests of GetMachine.
 func (r *GetMachineRequest) Validate() error {
+	if r.AsOf != nil {
+		if err := validateAsOf(r.AsOf); err != nil {
+			return err
+		}
+	}
 	return validateResourceName(machineRegex, MachineNameFormat, r.Name)
 }
 
@@ -482,6 +510,11 @@ func (r *UpdateMachineLSERequest) Validate() error {
 
 // Validate validates input requests of GetMachineLSE.
 func (r *GetMachineLSERequest) Validate() error {
+	if r.AsOf != nil {
+		if err := validateAsOf(r.AsOf); err != nil {
+			return err
+		}
+	}
 	return validateResourceName(machineLSERegex, MachineLSENameFormat, r.Name)
 }
 
@@ -1437,6 +1470,13 @@ func (r *GetOwnershipDataRequest) Validate() error {
 	return nil
 }
 
+func validateAsOf(asOf *timestamppb.Timestamp) error {
+	if err := asOf.CheckValid(); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid as_of: %s", err)
+	}
+	return nil
+}
+
 func validatePageSize(pageSize int32) error {
 	if pageSize < 0 {
 		return status.Errorf(codes.InvalidArgument, InvalidPageSize)
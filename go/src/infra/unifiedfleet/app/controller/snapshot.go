@@ -0,0 +1,80 @@
+// Copyright 2024 The Chromium Authors
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	ufspb "infra/unifiedfleet/api/v1/models"
+	"infra/unifiedfleet/app/model/history"
+	"infra/unifiedfleet/app/util"
+)
+
+// GetMachineAsOf returns the machine as it was at the given time.
+//
+// The machine is reconstructed from the snapshot msgs recorded by HistoryClient.
+func GetMachineAsOf(ctx context.Context, id string, asOf time.Time) (*ufspb.Machine, error) {
+	machine := &ufspb.Machine{}
+	if err := history.GetSnapshotMsgAsOf(ctx, util.AddPrefix(util.MachineCollection, id), asOf, machine); err != nil {
+		return nil, err
+	}
+	return machine, nil
+}
+
+// ListMachinesAsOf lists the machines as they were at the given time.
+//
+// The machines are reconstructed from the snapshot msgs recorded by HistoryClient.
+func ListMachinesAsOf(ctx context.Context, pageSize int32, pageToken string, asOf time.Time, keysOnly bool) ([]*ufspb.Machine, string, error) {
+	res, nextPageToken, err := history.ListSnapshotMsgsAsOf(ctx, util.AddPrefix(util.MachineCollection, ""), asOf, pageSize, pageToken, func() proto.Message {
+		return &ufspb.Machine{}
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	machines := make([]*ufspb.Machine, 0, len(res))
+	for _, pm := range res {
+		machine := pm.(*ufspb.Machine)
+		if keysOnly {
+			machine = &ufspb.Machine{Name: machine.GetName()}
+		}
+		machines = append(machines, machine)
+	}
+	return machines, nextPageToken, nil
+}
+
+// GetMachineLSEAsOf returns the machineLSE as it was at the given time.
+//
+// The machineLSE is reconstructed from the snapshot msgs recorded by HistoryClient.
+func GetMachineLSEAsOf(ctx context.Context, id string, asOf time.Time) (*ufspb.MachineLSE, error) {
+	lse := &ufspb.MachineLSE{}
+	if err := history.GetSnapshotMsgAsOf(ctx, util.AddPrefix(util.HostCollection, id), asOf, lse); err != nil {
+		return nil, err
+	}
+	return lse, nil
+}
+
+// ListMachineLSEsAsOf lists the machineLSEs as they were at the given time.
+//
+// The machineLSEs are reconstructed from the snapshot msgs recorded by HistoryClient.
+func ListMachineLSEsAsOf(ctx context.Context, pageSize int32, pageToken string, asOf time.Time, keysOnly bool) ([]*ufspb.MachineLSE, string, error) {
+	res, nextPageToken, err := history.ListSnapshotMsgsAsOf(ctx, util.AddPrefix(util.HostCollection, ""), asOf, pageSize, pageToken, func() proto.Message {
+		return &ufspb.MachineLSE{}
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	lses := make([]*ufspb.MachineLSE, 0, len(res))
+	for _, pm := range res {
+		lse := pm.(*ufspb.MachineLSE)
+		if keysOnly {
+			lse = &ufspb.MachineLSE{Name: lse.GetName()}
+		}
+		lses = append(lses, lse)
+	}
+	return lses, nextPageToken, nil
+}
@@ -0,0 +1,113 @@
+// Copyright 2024 The Chromium Authors
+// Use of this source code is governed by a BSD-style license that can be
+// found in the LICENSE file.
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	. "github.com/smartystreets/goconvey/convey"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	. "go.chromium.org/luci/common/testing/assertions"
+	"go.chromium.org/luci/gae/service/datastore"
+
+	ufspb "infra/unifiedfleet/api/v1/models"
+	"infra/unifiedfleet/app/model/history"
+)
+
+func TestMachineAsOf(t *testing.T) {
+	t.Parallel()
+	ctx := testingContext()
+	t0 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	snapshot := func(resourceName string, del bool, m *ufspb.Machine, at time.Time) *history.SnapshotMsgEntity {
+		e, err := history.NewSnapshotMsgEntity(resourceName, del, m)
+		So(err, ShouldBeNil)
+		e.UpdateTime = at
+		return e
+	}
+	Convey("Machine as of", t, func() {
+		So(history.BatchUpdateSnapshotMsg(ctx, []*history.SnapshotMsgEntity{
+			snapshot("machines/asof-1", false, &ufspb.Machine{Name: "asof-1", SerialNumber: "s1"}, t0),
+			snapshot("machines/asof-1", false, &ufspb.Machine{Name: "asof-1", SerialNumber: "s2"}, t0.Add(2*time.Hour)),
+			snapshot("machines/asof-2", false, &ufspb.Machine{Name: "asof-2"}, t0.Add(time.Hour)),
+			snapshot("machines/asof-2", true, &ufspb.Machine{Name: "asof-2"}, t0.Add(3*time.Hour)),
+			snapshot("machines/asof-3", false, &ufspb.Machine{Name: "asof-3"}, t0),
+			snapshot("hosts/asof-1", false, &ufspb.Machine{Name: "asof-1"}, t0),
+		}), ShouldBeNil)
+
+		Convey("Get machine before and after update", func() {
+			m, err := GetMachineAsOf(ctx, "asof-1", t0.Add(time.Hour))
+			So(err, ShouldBeNil)
+			So(m.GetSerialNumber(), ShouldEqual, "s1")
+			m, err = GetMachineAsOf(ctx, "asof-1", t0.Add(2*time.Hour))
+			So(err, ShouldBeNil)
+			So(m.GetSerialNumber(), ShouldEqual, "s2")
+		})
+		Convey("Get machine before registration", func() {
+			_, err := GetMachineAsOf(ctx, "asof-1", t0.Add(-time.Second))
+			So(status.Code(err), ShouldEqual, codes.NotFound)
+		})
+		Convey("Get machine after deletion", func() {
+			_, err := GetMachineAsOf(ctx, "asof-2", t0.Add(4*time.Hour))
+			So(status.Code(err), ShouldEqual, codes.NotFound)
+		})
+		Convey("List machines", func() {
+			machines, next, err := ListMachinesAsOf(ctx, 10, "", t0.Add(90*time.Minute), false)
+			So(err, ShouldBeNil)
+			So(next, ShouldBeEmpty)
+			So(machines, ShouldResembleProto, []*ufspb.Machine{
+				{Name: "asof-1", SerialNumber: "s1"},
+				{Name: "asof-2"},
+				{Name: "asof-3"},
+			})
+		})
+		Convey("List machines with pagination", func() {
+			machines, next, err := ListMachinesAsOf(ctx, 1, "", t0.Add(4*time.Hour), true)
+			So(err, ShouldBeNil)
+			So(machines, ShouldResembleProto, []*ufspb.Machine{{Name: "asof-1"}})
+			So(next, ShouldEqual, "machines/asof-1")
+			machines, next, err = ListMachinesAsOf(ctx, 1, next, t0.Add(4*time.Hour), true)
+			So(err, ShouldBeNil)
+			// asof-2 was deleted.
+			So(machines, ShouldResembleProto, []*ufspb.Machine{{Name: "asof-3"}})
+			So(next, ShouldBeEmpty)
+		})
+		Convey("Get machine deleted before update times were recorded", func() {
+			// The deletion records the machine as of its last update, so it is
+			// dated by the change event logged when the machine was retired.
+			m := &ufspb.Machine{Name: "asof-4", UpdateTime: timestamppb.New(t0)}
+			for _, del := range []bool{true, false} {
+				e, err := history.NewSnapshotMsgEntity("machines/asof-4", del, m)
+				So(err, ShouldBeNil)
+				So(datastore.Put(ctx, e), ShouldBeNil)
+			}
+			_, err := GetMachineAsOf(ctx, "asof-4", t0.Add(time.Hour))
+			So(status.Code(err), ShouldEqual, codes.FailedPrecondition)
+
+			change, err := proto.Marshal(&ufspb.ChangeEvent{
+				Name:       "machines/asof-4",
+				EventLabel: "machine",
+				OldValue:   LifeCycleRetire,
+				NewValue:   LifeCycleRetire,
+				UpdateTime: timestamppb.New(t0.Add(2 * time.Hour)),
+			})
+			So(err, ShouldBeNil)
+			So(datastore.Put(ctx, &history.ChangeEventEntity{Name: "machines/asof-4", Change: change}), ShouldBeNil)
+			got, err := GetMachineAsOf(ctx, "asof-4", t0.Add(time.Hour))
+			So(err, ShouldBeNil)
+			So(got.GetName(), ShouldEqual, "asof-4")
+			_, err = GetMachineAsOf(ctx, "asof-4", t0.Add(3*time.Hour))
+			So(status.Code(err), ShouldEqual, codes.NotFound)
+		})
+		Convey("List machines with invalid page token", func() {
+			_, _, err := ListMachinesAsOf(ctx, 1, "hosts/asof-1", t0, false)
+			So(status.Code(err), ShouldEqual, codes.InvalidArgument)
+		})
+	})
+}
@@ -132,6 +132,14 @@ func (fs *FleetServerImpl) GetMachineLSE(ctx context.Context, req *ufsAPI.GetMac
 		return nil, err
 	}
 	name := util.FormatDHCPHostname(util.RemovePrefix(req.Name))
+	if req.GetAsOf() != nil {
+		machineLSE, err := controller.GetMachineLSEAsOf(ctx, name, req.GetAsOf().AsTime())
+		if err != nil {
+			return nil, err
+		}
+		machineLSE.Name = util.AddPrefix(util.MachineLSECollection, machineLSE.Name)
+		return machineLSE, nil
+	}
 	machineLSE, err := controller.GetMachineLSE(ctx, name)
 	if err != nil {
 		return nil, err
@@ -208,6 +216,9 @@ func (fs *FleetServerImpl) ListMachineLSEs(ctx context.Context, req *ufsAPI.List
 	defer func() {
 		err = grpcutil.GRPCifyAndLogErr(ctx, err)
 	}()
+	if req.GetAsOf() != nil {
+		return listMachineLSEsAsOf(ctx, req)
+	}
 	if err := ufsAPI.ValidateListRequest(req); err != nil {
 		return nil, err
 	}
@@ -226,6 +237,26 @@ func (fs *FleetServerImpl) ListMachineLSEs(ctx context.Context, req *ufsAPI.List
 	}, nil
 }
 
+// listMachineLSEsAsOf lists the machineLSEs as they were at req.AsOf.
+func listMachineLSEsAsOf(ctx context.Context, req *ufsAPI.ListMachineLSEsRequest) (*ufsAPI.ListMachineLSEsResponse, error) {
+	if err := ufsAPI.ValidateAsOfListRequest(req); err != nil {
+		return nil, err
+	}
+	pageSize := util.GetPageSize(req.PageSize)
+	result, nextPageToken, err := controller.ListMachineLSEsAsOf(ctx, pageSize, req.PageToken, req.GetAsOf().AsTime(), req.KeysOnly)
+	if err != nil {
+		return nil, err
+	}
+	// https://aip.dev/122 - as per AIP guideline
+	for _, machineLSE := range result {
+		machineLSE.Name = util.AddPrefix(util.MachineLSECollection, machineLSE.Name)
+	}
+	return &ufsAPI.ListMachineLSEsResponse{
+		MachineLSEs:   result,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
 // DeleteMachineLSE deletes the machineLSE from database.
 func (fs *FleetServerImpl) DeleteMachineLSE(ctx context.Context, req *ufsAPI.DeleteMachineLSERequest) (rsp *empty.Empty, err error) {
 	defer func() {
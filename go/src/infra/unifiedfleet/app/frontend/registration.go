@@ -88,6 +88,14 @@ func (fs *FleetServerImpl) GetMachine(ctx context.Context, req *ufsAPI.GetMachin
 		return nil, err
 	}
 	name := util.RemovePrefix(req.Name)
+	if req.GetAsOf() != nil {
+		machine, err := controller.GetMachineAsOf(ctx, name, req.GetAsOf().AsTime())
+		if err != nil {
+			return nil, err
+		}
+		machine.Name = util.AddPrefix(util.MachineCollection, machine.Name)
+		return machine, nil
+	}
 	machine, err := controller.GetMachine(ctx, name)
 	if err != nil {
 		return nil, err
@@ -120,6 +128,9 @@ func (fs *FleetServerImpl) ListMachines(ctx context.Context, req *ufsAPI.ListMac
 	defer func() {
 		err = grpcutil.GRPCifyAndLogErr(ctx, err)
 	}()
+	if req.GetAsOf() != nil {
+		return listMachinesAsOf(ctx, req)
+	}
 	if err := ufsAPI.ValidateListRequest(req); err != nil {
 		return nil, err
 	}
@@ -138,6 +149,26 @@ func (fs *FleetServerImpl) ListMachines(ctx context.Context, req *ufsAPI.ListMac
 	}, nil
 }
 
+// listMachinesAsOf lists the machines as they were at req.AsOf.
+func listMachinesAsOf(ctx context.Context, req *ufsAPI.ListMachinesRequest) (*ufsAPI.ListMachinesResponse, error) {
+	if err := ufsAPI.ValidateAsOfListRequest(req); err != nil {
+		return nil, err
+	}
+	pageSize := util.GetPageSize(req.PageSize)
+	result, nextPageToken, err := controller.ListMachinesAsOf(ctx, pageSize, req.PageToken, req.GetAsOf().AsTime(), req.KeysOnly)
+	if err != nil {
+		return nil, err
+	}
+	// https://aip.dev/122 - as per AIP guideline
+	for _, machine := range result {
+		machine.Name = util.AddPrefix(util.MachineCollection, machine.Name)
+	}
+	return &ufsAPI.ListMachinesResponse{
+		Machines:      result,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
 // DeleteMachine deletes the machine from database.
 func (fs *FleetServerImpl) DeleteMachine(ctx context.Context, req *ufsAPI.DeleteMachineRequest) (rsp *empty.Empty, err error) {
 	defer func() {
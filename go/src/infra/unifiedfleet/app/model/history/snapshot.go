@@ -6,13 +6,20 @@ package history
 
 import (
 	"context"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"go.chromium.org/luci/common/clock"
 	"go.chromium.org/luci/common/logging"
 	"go.chromium.org/luci/gae/service/datastore"
+
+	ufsds "infra/unifiedfleet/app/model/datastore"
 )
 
 // SnapshotMsgKind is the datastore entity kind for storing the snapshot msgs of resources.
@@ -27,6 +34,9 @@ type SnapshotMsgEntity struct {
 	ResourceName string `gae:"resource_name"`
 	Delete       bool   `gae:"delete"`
 	Msg          []byte `gae:",noindex"`
+	// UpdateTime is the time when the snapshot msg is recorded.
+	// It is zero for snapshot msgs recorded before it was introduced.
+	UpdateTime time.Time `gae:"update_time"`
 }
 
 // GetProto returns the unmarshaled Chrome platform.
@@ -52,6 +62,12 @@ func NewSnapshotMsgEntity(resourceName string, delete bool, pm proto.Message) (*
 
 // BatchUpdateSnapshotMsg updates a batch of new snapshot msgs
 func BatchUpdateSnapshotMsg(ctx context.Context, msgs []*SnapshotMsgEntity) error {
+	updateTime := clock.Now(ctx).UTC()
+	for _, m := range msgs {
+		if m.UpdateTime.IsZero() {
+			m.UpdateTime = updateTime
+		}
+	}
 	if err := datastore.Put(ctx, msgs); err != nil {
 		logging.Errorf(ctx, "Failed to put snapshot msg in datastore: %s", err)
 		return status.Errorf(codes.Internal, err.Error())
@@ -84,3 +100,247 @@ func QuerySnapshotMsgByPropertyName(ctx context.Context, propertyName, id string
 	}
 	return entities, nil
 }
+
+// updateTimeGetter is implemented by resources which record their update time.
+type updateTimeGetter interface {
+	GetUpdateTime() *timestamppb.Timestamp
+}
+
+// lifeCycleRetire is the value of the change events logged for deleted
+// resources, see LifeCycleRetire in the controller package.
+const lifeCycleRetire = "RETIREMENT"
+
+// snapshotTime returns the time when the snapshot msg was recorded.
+//
+// Snapshot msgs recorded before UpdateTime was introduced fall back to
+// the update time of the resource in the msg. For deletions, the msg is
+// the deleted resource, so this is the last update of the resource rather
+// than the deletion, see legacyDeletionTime.
+func (e *SnapshotMsgEntity) snapshotTime(pm proto.Message) time.Time {
+	if !e.UpdateTime.IsZero() {
+		return e.UpdateTime
+	}
+	if u, ok := pm.(updateTimeGetter); ok && u.GetUpdateTime() != nil {
+		return u.GetUpdateTime().AsTime()
+	}
+	return time.Time{}
+}
+
+// isLegacyDeletion returns whether e is a deletion recorded before UpdateTime
+// was introduced.
+func (e *SnapshotMsgEntity) isLegacyDeletion() bool {
+	return e.Delete && e.UpdateTime.IsZero()
+}
+
+// deletionTimes returns the sorted times of the change events logged when
+// the resource was deleted, either retired or renamed to another name.
+//
+// They are only queried if entities have legacy deletions.
+func deletionTimes(ctx context.Context, resourceName string, entities []*SnapshotMsgEntity) ([]time.Time, error) {
+	hasLegacyDeletion := false
+	for _, e := range entities {
+		if e.isLegacyDeletion() {
+			hasLegacyDeletion = true
+			break
+		}
+	}
+	if !hasLegacyDeletion {
+		return nil, nil
+	}
+	changes, err := QueryChangesByPropertyName(ctx, "name", resourceName)
+	if err != nil {
+		return nil, err
+	}
+	id := resourceName[strings.Index(resourceName, "/")+1:]
+	var times []time.Time
+	for _, c := range changes {
+		retired := c.GetOldValue() == lifeCycleRetire && c.GetNewValue() == lifeCycleRetire
+		renamed := strings.HasSuffix(c.GetEventLabel(), ".name") && c.GetOldValue() == id && c.GetNewValue() != id
+		if (retired || renamed) && c.GetUpdateTime() != nil {
+			times = append(times, c.GetUpdateTime().AsTime())
+		}
+	}
+	sort.Slice(times, func(i, j int) bool {
+		return times[i].Before(times[j])
+	})
+	return times, nil
+}
+
+// legacyDeletionTime returns the time of the first deletion change event
+// at or after the last update of the deleted resource.
+func legacyDeletionTime(deletions []time.Time, lastUpdate time.Time) (time.Time, bool) {
+	for _, t := range deletions {
+		if !t.Before(lastUpdate) {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// laterOnTie returns whether the snapshot msg e is later than other, when
+// both are recorded at the same time.
+//
+// A deletion is always later, as the resource can't be updated after it is
+// deleted. Otherwise, the entity with the larger ID wins, so that the result
+// doesn't depend on the order of the entities.
+func laterOnTie(e, other *SnapshotMsgEntity) bool {
+	if e.Delete != other.Delete {
+		return e.Delete
+	}
+	return e.ID > other.ID
+}
+
+// latestSnapshotMsg returns the latest snapshot msg in entities recorded at or
+// before asOf and unmarshals it to t.
+//
+// Legacy deletions are dated by deletions, the times of the deletion change
+// events of the resource. It returns FailedPrecondition if a legacy deletion
+// may be at or before asOf, but has no such change event.
+//
+// It returns nil if there is no such snapshot msg.
+func latestSnapshotMsg(ctx context.Context, entities []*SnapshotMsgEntity, deletions []time.Time, asOf time.Time, t proto.Message) (*SnapshotMsgEntity, error) {
+	var latest *SnapshotMsgEntity
+	var latestTime time.Time
+	for _, e := range entities {
+		pm := proto.Clone(t)
+		proto.Reset(pm)
+		if err := e.GetProto(pm); err != nil {
+			logging.Errorf(ctx, "Failed to unmarshal snapshot msg %d of %s: %s", e.ID, e.ResourceName, err)
+			continue
+		}
+		st := e.snapshotTime(pm)
+		if st.IsZero() || st.After(asOf) {
+			continue
+		}
+		if e.isLegacyDeletion() {
+			dt, ok := legacyDeletionTime(deletions, st)
+			if !ok {
+				return nil, status.Errorf(codes.FailedPrecondition, "deletion time of %s is unknown, it was deleted at or after %s", e.ResourceName, st.UTC().Format(time.RFC3339))
+			}
+			if dt.After(asOf) {
+				continue
+			}
+			st = dt
+		}
+		if latest == nil || st.After(latestTime) || (st.Equal(latestTime) && laterOnTie(e, latest)) {
+			latest, latestTime = e, st
+		}
+	}
+	if latest != nil {
+		// Unmarshal already succeeded above.
+		latest.GetProto(t)
+	}
+	return latest, nil
+}
+
+// GetSnapshotMsgAsOf reconstructs the resource as it was at asOf from its
+// snapshot msgs, and unmarshals it to t.
+//
+// It returns NotFound if the resource didn't exist at asOf, and
+// FailedPrecondition if that is unknown.
+func GetSnapshotMsgAsOf(ctx context.Context, resourceName string, asOf time.Time, t proto.Message) error {
+	entities, err := QuerySnapshotMsgByPropertyName(ctx, "resource_name", resourceName)
+	if err != nil {
+		return err
+	}
+	deletions, err := deletionTimes(ctx, resourceName, entities)
+	if err != nil {
+		return err
+	}
+	latest, err := latestSnapshotMsg(ctx, entities, deletions, asOf, t)
+	if err != nil {
+		return err
+	}
+	if latest == nil || latest.Delete {
+		return status.Errorf(codes.NotFound, "%s: %s didn't exist at %s", ufsds.NotFound, resourceName, asOf.UTC().Format(time.RFC3339))
+	}
+	return nil
+}
+
+// ListSnapshotMsgsAsOf reconstructs the resources whose names start with
+// prefix as they were at asOf from their snapshot msgs.
+//
+// newMsg returns a new message to unmarshal the snapshot msgs into.
+// Resources are returned in the order of their resource names, and
+// nextPageToken is the resource name of the last resource if there
+// may be more resources. It returns FailedPrecondition if it is unknown
+// whether a resource existed at asOf.
+func ListSnapshotMsgsAsOf(ctx context.Context, prefix string, asOf time.Time, pageSize int32, pageToken string, newMsg func() proto.Message) (res []proto.Message, nextPageToken string, err error) {
+	q := datastore.NewQuery(SnapshotMsgKind).Order("resource_name")
+	if end := prefixEnd(prefix); end != "" {
+		q = q.Lt("resource_name", end)
+	}
+	if pageToken != "" {
+		if !strings.HasPrefix(pageToken, prefix) {
+			return nil, "", status.Errorf(codes.InvalidArgument, "%s: %q", ufsds.InvalidPageToken, pageToken)
+		}
+		q = q.Gt("resource_name", pageToken)
+	} else {
+		q = q.Gte("resource_name", prefix)
+	}
+	var group []*SnapshotMsgEntity
+	// flush appends the resource of the current group if it existed at asOf.
+	flush := func() error {
+		if len(group) == 0 {
+			return nil
+		}
+		defer func() { group = nil }()
+		deletions, err := deletionTimes(ctx, group[0].ResourceName, group)
+		if err != nil {
+			return err
+		}
+		pm := newMsg()
+		latest, err := latestSnapshotMsg(ctx, group, deletions, asOf, pm)
+		if err != nil {
+			return err
+		}
+		if latest != nil && !latest.Delete {
+			res = append(res, pm)
+			nextPageToken = latest.ResourceName
+		}
+		return nil
+	}
+	more := false
+	// flushErr is returned as is, unlike the errors of the query.
+	var flushErr error
+	err = datastore.Run(ctx, q, func(e *SnapshotMsgEntity) error {
+		if len(group) > 0 && group[0].ResourceName != e.ResourceName {
+			if flushErr = flush(); flushErr != nil {
+				return datastore.Stop
+			}
+			if len(res) >= int(pageSize) {
+				more = true
+				return datastore.Stop
+			}
+		}
+		group = append(group, e)
+		return nil
+	})
+	if flushErr != nil {
+		return nil, "", flushErr
+	}
+	if err != nil {
+		logging.Errorf(ctx, "Failed to list snapshot msgs of %s: %s", prefix, err)
+		return nil, "", status.Errorf(codes.Internal, ufsds.InternalError)
+	}
+	if !more {
+		if err := flush(); err != nil {
+			return nil, "", err
+		}
+		nextPageToken = ""
+	}
+	return res, nextPageToken, nil
+}
+
+// prefixEnd returns the smallest string greater than all the strings with
+// the prefix, or an empty string if there is no such string.
+func prefixEnd(prefix string) string {
+	b := []byte(prefix)
+	for i := len(b) - 1; i >= 0; i-- {
+		if b[i] < 0xff {
+			b[i]++
+			return string(b[:i+1])
+		}
+	}
+	return ""
+}